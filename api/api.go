@@ -7,13 +7,14 @@ package api // import "miniflux.app/api"
 import (
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
+	"miniflux.app/worker"
 
 	"github.com/gorilla/mux"
 )
 
 // Serve declares API routes for the application.
-func Serve(router *mux.Router, store *storage.Storage, feedHandler *feed.Handler) {
-	handler := &handler{store, feedHandler}
+func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHandler *feed.Handler) {
+	handler := &handler{store, pool, feedHandler}
 
 	sr := router.PathPrefix("/v1").Subrouter()
 	sr.Use(newMiddleware(store).serve)
@@ -28,6 +29,12 @@ func Serve(router *mux.Router, store *storage.Storage, feedHandler *feed.Handler
 	sr.HandleFunc("/categories", handler.getCategories).Methods("GET")
 	sr.HandleFunc("/categories/{categoryID}", handler.updateCategory).Methods("PUT")
 	sr.HandleFunc("/categories/{categoryID}", handler.removeCategory).Methods("DELETE")
+	sr.HandleFunc("/searches", handler.createSavedSearch).Methods("POST")
+	sr.HandleFunc("/searches", handler.getSavedSearches).Methods("GET")
+	sr.HandleFunc("/searches/{savedSearchID}", handler.updateSavedSearch).Methods("PUT")
+	sr.HandleFunc("/searches/{savedSearchID}", handler.removeSavedSearch).Methods("DELETE")
+	sr.HandleFunc("/searches/{savedSearchID}/entries", handler.getSavedSearchEntries).Methods("GET")
+	sr.HandleFunc("/searches/{savedSearchID}/mark-all-as-read", handler.markSavedSearchAsRead).Methods("PUT")
 	sr.HandleFunc("/discover", handler.getSubscriptions).Methods("POST")
 	sr.HandleFunc("/feeds", handler.createFeed).Methods("POST")
 	sr.HandleFunc("/feeds", handler.getFeeds).Methods("GET")
@@ -38,10 +45,22 @@ func Serve(router *mux.Router, store *storage.Storage, feedHandler *feed.Handler
 	sr.HandleFunc("/feeds/{feedID}/icon", handler.feedIcon).Methods("GET")
 	sr.HandleFunc("/export", handler.exportFeeds).Methods("GET")
 	sr.HandleFunc("/import", handler.importFeeds).Methods("POST")
+	sr.HandleFunc("/backup", handler.exportBackup).Methods("GET")
+	sr.HandleFunc("/backup", handler.importBackup).Methods("POST")
 	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods("GET")
 	sr.HandleFunc("/feeds/{feedID}/entries/{entryID}", handler.getFeedEntry).Methods("GET")
 	sr.HandleFunc("/entries", handler.getEntries).Methods("GET")
 	sr.HandleFunc("/entries", handler.setEntryStatus).Methods("PUT")
 	sr.HandleFunc("/entries/{entryID}", handler.getEntry).Methods("GET")
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods("PUT")
+	sr.HandleFunc("/entries/{entryID}/snooze", handler.snoozeEntry).Methods("PUT")
+	sr.HandleFunc("/entries/{entryID}/snooze", handler.unsnoozeEntry).Methods("DELETE")
+	sr.HandleFunc("/entries/{entryID}/note", handler.getEntryNote).Methods("GET")
+	sr.HandleFunc("/entries/{entryID}/note", handler.saveEntryNote).Methods("PUT")
+	sr.HandleFunc("/entries/{entryID}/note", handler.removeEntryNote).Methods("DELETE")
+	sr.HandleFunc("/entries/{entryID}/highlights", handler.getEntryHighlights).Methods("GET")
+	sr.HandleFunc("/entries/{entryID}/highlights", handler.createEntryHighlight).Methods("POST")
+	sr.HandleFunc("/entries/{entryID}/highlights/{highlightID}", handler.removeEntryHighlight).Methods("DELETE")
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.getEnclosure).Methods("GET")
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.updateEnclosure).Methods("PUT")
 }
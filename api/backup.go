@@ -0,0 +1,54 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"miniflux.app/backup"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) exportBackup(w http.ResponseWriter, r *http.Request) {
+	var buffer bytes.Buffer
+	if err := backup.Export(h.store, request.UserID(r), &buffer); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	builder := response.New(w, r)
+	builder.WithHeader("Content-Type", "application/zip")
+	builder.WithAttachment("miniflux-backup.zip")
+	builder.WithoutCompression()
+	builder.WithBody(buffer.Bytes())
+	builder.Write()
+}
+
+func (h *handler) importBackup(w http.ResponseWriter, r *http.Request) {
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, backup.MaxArchiveSize))
+	defer r.Body.Close()
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	archive, err := backup.Read(data)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	report, err := backup.Import(h.store, request.UserID(r), archive)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, report)
+}
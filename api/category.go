@@ -40,16 +40,24 @@ func (h *handler) createCategory(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) updateCategory(w http.ResponseWriter, r *http.Request) {
-	categoryID := request.RouteInt64Param(r, "categoryID")
-
-	category, err := decodeCategoryPayload(r.Body)
+	categoryChanges, err := decodeCategoryModificationPayload(r.Body)
 	if err != nil {
 		json.BadRequest(w, r, err)
 		return
 	}
 
-	category.UserID = request.UserID(r)
-	category.ID = categoryID
+	category, err := h.store.Category(request.UserID(r), request.RouteInt64Param(r, "categoryID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	categoryChanges.Update(category)
 	if err := category.ValidateCategoryModification(); err != nil {
 		json.BadRequest(w, r, err)
 		return
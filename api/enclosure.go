@@ -0,0 +1,57 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getEnclosure(w http.ResponseWriter, r *http.Request) {
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+	enclosure, err := h.store.EnclosureByID(request.UserID(r), enclosureID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, enclosure)
+}
+
+func (h *handler) updateEnclosure(w http.ResponseWriter, r *http.Request) {
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+	changes, err := decodeEnclosureModificationPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	enclosure, err := h.store.EnclosureByID(request.UserID(r), enclosureID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	changes.Update(enclosure)
+
+	if err := h.store.UpdateEnclosureProgression(enclosure); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, enclosure)
+}
@@ -11,7 +11,10 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/integration"
+	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/search"
 	"miniflux.app/storage"
 )
 
@@ -93,7 +96,10 @@ func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
 	builder.WithDirection(direction)
 	builder.WithOffset(offset)
 	builder.WithLimit(limit)
-	configureFilters(builder, r)
+	if err := configureFilters(builder, r); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
 
 	entries, err := builder.GetEntries()
 	if err != nil {
@@ -144,7 +150,10 @@ func (h *handler) getEntries(w http.ResponseWriter, r *http.Request) {
 	builder.WithDirection(direction)
 	builder.WithOffset(offset)
 	builder.WithLimit(limit)
-	configureFilters(builder, r)
+	if err := configureFilters(builder, r); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
 
 	entries, err := builder.GetEntries()
 	if err != nil {
@@ -188,10 +197,14 @@ func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := integration.QueueStarredEntry(h.store, request.UserID(r), entryID); err != nil {
+		logger.Error("[API:ToggleBookmark] %v", err)
+	}
+
 	json.NoContent(w, r)
 }
 
-func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
+func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) error {
 	beforeEntryID := request.QueryInt64Param(r, "before_entry_id", 0)
 	if beforeEntryID > 0 {
 		builder.BeforeEntryID(beforeEntryID)
@@ -221,8 +234,18 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 		builder.WithStarred()
 	}
 
+	if request.HasQueryParam(r, "snoozed") {
+		builder.WithSnoozed()
+	}
+
 	searchQuery := request.QueryStringParam(r, "search", "")
 	if searchQuery != "" {
-		builder.WithSearchQuery(searchQuery)
+		query, err := search.Parse(searchQuery, request.UserTimezone(r))
+		if err != nil {
+			return err
+		}
+		builder.WithSearchQuery(query)
 	}
+
+	return nil
 }
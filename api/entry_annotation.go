@@ -0,0 +1,133 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) getEntryNote(w http.ResponseWriter, r *http.Request) {
+	note, err := h.store.EntryNote(request.UserID(r), request.RouteInt64Param(r, "entryID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if note == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, note)
+}
+
+func (h *handler) saveEntryNote(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	note, err := decodeEntryNotePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	note.UserID = userID
+	note.EntryID = entryID
+	note.Content = strings.TrimSpace(note.Content)
+	if err := note.ValidateEntryNote(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.SaveEntryNote(note); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if note.Content == "" {
+		json.NoContent(w, r)
+		return
+	}
+
+	json.Created(w, r, note)
+}
+
+func (h *handler) removeEntryNote(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.RemoveEntryNote(request.UserID(r), request.RouteInt64Param(r, "entryID")); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getEntryHighlights(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	highlights, err := h.store.EntryHighlights(userID, entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, highlights)
+}
+
+func (h *handler) createEntryHighlight(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	highlight, err := decodeEntryHighlightPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	highlight.UserID = userID
+	highlight.EntryID = entryID
+	highlight.Text = strings.TrimSpace(highlight.Text)
+	if err := highlight.ValidateEntryHighlight(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateEntryHighlight(highlight); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, highlight)
+}
+
+func (h *handler) removeEntryHighlight(w http.ResponseWriter, r *http.Request) {
+	err := h.store.RemoveEntryHighlight(
+		request.UserID(r),
+		request.RouteInt64Param(r, "entryID"),
+		request.RouteInt64Param(r, "highlightID"),
+	)
+	if err != nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.NoContent(w, r)
+}
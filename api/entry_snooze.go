@@ -0,0 +1,51 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/timezone"
+)
+
+func (h *handler) snoozeEntry(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	value, err := decodeEntrySnoozePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	until, err := model.SnoozeTime(value, timezone.Now(request.UserTimezone(r)))
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.SnoozeEntries(userID, []int64{entryID}, until); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) unsnoozeEntry(w http.ResponseWriter, r *http.Request) {
+	if err := h.store.UnsnoozeEntries(request.UserID(r), []int64{request.RouteInt64Param(r, "entryID")}); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
@@ -10,6 +10,9 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/reader/selector"
+	"miniflux.app/search"
 )
 
 func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
@@ -41,17 +44,34 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	feed, err := h.feedHandler.CreateFeed(
-		userID,
-		feedInfo.CategoryID,
-		feedInfo.FeedURL,
-		feedInfo.Crawler,
-		feedInfo.UserAgent,
-		feedInfo.Username,
-		feedInfo.Password,
-		feedInfo.ScraperRules,
-		feedInfo.RewriteRules,
-	)
+	var feed *model.Feed
+	if feedInfo.ItemSelector != "" {
+		feed, err = h.feedHandler.CreateScrapedFeed(
+			userID,
+			feedInfo.CategoryID,
+			feedInfo.FeedURL,
+			feedInfo.UserAgent,
+			&selector.Rules{
+				Item:    feedInfo.ItemSelector,
+				Title:   feedInfo.TitleSelector,
+				Link:    feedInfo.LinkSelector,
+				Date:    feedInfo.DateSelector,
+				Content: feedInfo.ContentSelector,
+			},
+		)
+	} else {
+		feed, err = h.feedHandler.CreateFeed(
+			userID,
+			feedInfo.CategoryID,
+			feedInfo.FeedURL,
+			feedInfo.Crawler,
+			feedInfo.UserAgent,
+			feedInfo.Username,
+			feedInfo.Password,
+			feedInfo.ScraperRules,
+			feedInfo.RewriteRules,
+		)
+	}
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
@@ -110,6 +130,16 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if originalFeed.SearchLanguage != "" && !search.IsValidConfiguration(originalFeed.SearchLanguage) {
+		json.BadRequest(w, r, errors.New("This search_language is not supported"))
+		return
+	}
+
+	if err := model.ValidateRetentionPolicy(originalFeed.ArchiveReadDays, originalFeed.MaxEntries, originalFeed.MarkAsReadDays); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
 	if err := h.store.UpdateFeed(originalFeed); err != nil {
 		json.ServerError(w, r, err)
 		return
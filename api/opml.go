@@ -5,11 +5,14 @@
 package api // import "miniflux.app/api"
 
 import (
+	"bytes"
+	"io/ioutil"
 	"net/http"
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
 	"miniflux.app/http/response/xml"
+	"miniflux.app/importer"
 	"miniflux.app/reader/opml"
 )
 
@@ -25,13 +28,38 @@ func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *handler) importFeeds(w http.ResponseWriter, r *http.Request) {
-	opmlHandler := opml.NewHandler(h.store)
-	err := opmlHandler.Import(request.UserID(r), r.Body)
+	data, err := ioutil.ReadAll(r.Body)
 	defer r.Body.Close()
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if importer.IsSupported(data) {
+		report, err := importer.NewHandler(h.store).Import(request.UserID(r), data)
+		if err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+
+		go func() {
+			h.pool.Push(report.Jobs())
+		}()
+
+		json.Created(w, r, &entriesImportResponse{Message: "Entries imported successfully", Report: report})
+		return
+	}
+
+	opmlHandler := opml.NewHandler(h.store)
+	report, err := opmlHandler.Import(request.UserID(r), bytes.NewReader(data))
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	json.Created(w, r, map[string]string{"message": "Feeds imported successfully"})
+	go func() {
+		h.pool.Push(report.Jobs())
+	}()
+
+	json.Created(w, r, &importResponse{Message: "Feeds imported successfully", ImportReport: report})
 }
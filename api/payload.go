@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io"
 
+	"miniflux.app/importer"
 	"miniflux.app/model"
+	"miniflux.app/reader/opml"
 )
 
 type feedIcon struct {
@@ -23,6 +25,16 @@ type entriesResponse struct {
 	Entries model.Entries `json:"entries"`
 }
 
+type importResponse struct {
+	Message string `json:"message"`
+	*opml.ImportReport
+}
+
+type entriesImportResponse struct {
+	Message string `json:"message"`
+	*importer.Report
+}
+
 type feedCreation struct {
 	FeedURL      string `json:"feed_url"`
 	CategoryID   int64  `json:"category_id"`
@@ -32,6 +44,12 @@ type feedCreation struct {
 	Crawler      bool   `json:"crawler"`
 	ScraperRules string `json:"scraper_rules"`
 	RewriteRules string `json:"rewrite_rules"`
+
+	ItemSelector    string `json:"item_selector"`
+	TitleSelector   string `json:"title_selector"`
+	LinkSelector    string `json:"link_selector"`
+	DateSelector    string `json:"date_selector"`
+	ContentSelector string `json:"content_selector"`
 }
 
 type subscriptionDiscovery struct {
@@ -53,6 +71,19 @@ type feedModification struct {
 	Password     *string `json:"password"`
 	CategoryID   *int64  `json:"category_id"`
 	Disabled     *bool   `json:"disabled"`
+	Notify       *bool   `json:"notify"`
+
+	SearchLanguage *string `json:"search_language"`
+
+	ArchiveReadDays *int `json:"archive_read_days"`
+	MaxEntries      *int `json:"max_entries"`
+	MarkAsReadDays  *int `json:"mark_as_read_days"`
+
+	ItemSelector    *string `json:"item_selector"`
+	TitleSelector   *string `json:"title_selector"`
+	LinkSelector    *string `json:"link_selector"`
+	DateSelector    *string `json:"date_selector"`
+	ContentSelector *string `json:"content_selector"`
 }
 
 func (f *feedModification) Update(feed *model.Feed) {
@@ -99,6 +130,61 @@ func (f *feedModification) Update(feed *model.Feed) {
 	if f.Disabled != nil {
 		feed.Disabled = *f.Disabled
 	}
+
+	if f.Notify != nil {
+		feed.Notify = *f.Notify
+	}
+
+	if f.SearchLanguage != nil {
+		feed.SearchLanguage = *f.SearchLanguage
+	}
+
+	if f.ArchiveReadDays != nil {
+		feed.ArchiveReadDays = *f.ArchiveReadDays
+	}
+
+	if f.MaxEntries != nil {
+		feed.MaxEntries = *f.MaxEntries
+	}
+
+	if f.MarkAsReadDays != nil {
+		feed.MarkAsReadDays = *f.MarkAsReadDays
+	}
+
+	if f.ItemSelector != nil {
+		feed.ItemSelector = *f.ItemSelector
+	}
+
+	if f.TitleSelector != nil {
+		feed.TitleSelector = *f.TitleSelector
+	}
+
+	if f.LinkSelector != nil {
+		feed.LinkSelector = *f.LinkSelector
+	}
+
+	if f.DateSelector != nil {
+		feed.DateSelector = *f.DateSelector
+	}
+
+	if f.ContentSelector != nil {
+		feed.ContentSelector = *f.ContentSelector
+	}
+}
+
+type enclosureModification struct {
+	MediaProgression *int64 `json:"media_progression"`
+	Played           *bool  `json:"played"`
+}
+
+func (e *enclosureModification) Update(enclosure *model.Enclosure) {
+	if e.MediaProgression != nil && *e.MediaProgression >= 0 {
+		enclosure.MediaProgression = *e.MediaProgression
+	}
+
+	if e.Played != nil {
+		enclosure.Played = *e.Played
+	}
 }
 
 type userModification struct {
@@ -109,6 +195,7 @@ type userModification struct {
 	Language       *string `json:"language"`
 	Timezone       *string `json:"timezone"`
 	EntryDirection *string `json:"entry_sorting_direction"`
+	SearchLanguage *string `json:"search_language"`
 }
 
 func (u *userModification) Update(user *model.User) {
@@ -139,6 +226,10 @@ func (u *userModification) Update(user *model.User) {
 	if u.EntryDirection != nil {
 		user.EntryDirection = *u.EntryDirection
 	}
+
+	if u.SearchLanguage != nil {
+		user.SearchLanguage = *u.SearchLanguage
+	}
 }
 
 func decodeUserModificationPayload(r io.ReadCloser) (*userModification, error) {
@@ -217,6 +308,61 @@ func decodeFeedModificationPayload(r io.ReadCloser) (*feedModification, error) {
 	return &feed, nil
 }
 
+func decodeEnclosureModificationPayload(r io.ReadCloser) (*enclosureModification, error) {
+	defer r.Close()
+
+	var changes enclosureModification
+	decoder := json.NewDecoder(r)
+	if err := decoder.Decode(&changes); err != nil {
+		return nil, fmt.Errorf("Unable to decode enclosure modification JSON object: %v", err)
+	}
+
+	if changes.MediaProgression != nil {
+		if err := model.ValidateMediaProgression(*changes.MediaProgression); err != nil {
+			return nil, err
+		}
+	}
+
+	return &changes, nil
+}
+
+type categoryModification struct {
+	Title           *string `json:"title"`
+	ArchiveReadDays *int    `json:"archive_read_days"`
+	MaxEntries      *int    `json:"max_entries"`
+	MarkAsReadDays  *int    `json:"mark_as_read_days"`
+}
+
+func (c *categoryModification) Update(category *model.Category) {
+	if c.Title != nil {
+		category.Title = *c.Title
+	}
+
+	if c.ArchiveReadDays != nil {
+		category.ArchiveReadDays = *c.ArchiveReadDays
+	}
+
+	if c.MaxEntries != nil {
+		category.MaxEntries = *c.MaxEntries
+	}
+
+	if c.MarkAsReadDays != nil {
+		category.MarkAsReadDays = *c.MarkAsReadDays
+	}
+}
+
+func decodeCategoryModificationPayload(r io.ReadCloser) (*categoryModification, error) {
+	var changes categoryModification
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&changes); err != nil {
+		return nil, fmt.Errorf("Unable to decode category modification JSON object: %v", err)
+	}
+
+	return &changes, nil
+}
+
 func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 	var category model.Category
 
@@ -228,3 +374,54 @@ func decodeCategoryPayload(r io.ReadCloser) (*model.Category, error) {
 
 	return &category, nil
 }
+
+func decodeSavedSearchPayload(r io.ReadCloser) (*model.SavedSearch, error) {
+	var savedSearch model.SavedSearch
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&savedSearch); err != nil {
+		return nil, fmt.Errorf("Unable to decode saved search JSON object: %v", err)
+	}
+
+	return &savedSearch, nil
+}
+
+func decodeEntryNotePayload(r io.ReadCloser) (*model.EntryNote, error) {
+	var note model.EntryNote
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&note); err != nil {
+		return nil, fmt.Errorf("Unable to decode note JSON object: %v", err)
+	}
+
+	return &note, nil
+}
+
+func decodeEntryHighlightPayload(r io.ReadCloser) (*model.EntryHighlight, error) {
+	var highlight model.EntryHighlight
+
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("Unable to decode highlight JSON object: %v", err)
+	}
+
+	return &highlight, nil
+}
+
+func decodeEntrySnoozePayload(r io.ReadCloser) (string, error) {
+	type payload struct {
+		SnoozedUntil string `json:"snoozed_until"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err := decoder.Decode(&p); err != nil {
+		return "", fmt.Errorf("Unable to decode snooze JSON object: %v", err)
+	}
+
+	return p.SnoozedUntil, nil
+}
@@ -5,6 +5,8 @@
 package api // import "miniflux.app/api"
 
 import (
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"miniflux.app/model"
@@ -217,3 +219,55 @@ func TestUserThemeWhenNotSet(t *testing.T) {
 		t.Fatal(`The user Theme should not be modified`)
 	}
 }
+
+func TestUpdateEnclosureProgression(t *testing.T) {
+	progression := int64(42)
+	played := true
+	changes := &enclosureModification{MediaProgression: &progression, Played: &played}
+	enclosure := &model.Enclosure{MediaProgression: 10}
+	changes.Update(enclosure)
+
+	if enclosure.MediaProgression != progression {
+		t.Fatalf(`Unexpected value, got %d instead of %d`, enclosure.MediaProgression, progression)
+	}
+
+	if !enclosure.Played {
+		t.Fatal(`The enclosure should be marked as played`)
+	}
+}
+
+func TestUpdateEnclosureProgressionWithNegativeValue(t *testing.T) {
+	progression := int64(-1)
+	changes := &enclosureModification{MediaProgression: &progression}
+	enclosure := &model.Enclosure{MediaProgression: 10}
+	changes.Update(enclosure)
+
+	if enclosure.MediaProgression != 10 {
+		t.Fatal(`The media progression should not be modified`)
+	}
+}
+
+func TestDecodeEnclosureModificationPayloadWithNegativeValue(t *testing.T) {
+	if _, err := decodeEnclosureModificationPayload(ioutil.NopCloser(strings.NewReader(`{"media_progression": -1}`))); err == nil {
+		t.Fatal(`Negative media progressions should be rejected`)
+	}
+
+	changes, err := decodeEnclosureModificationPayload(ioutil.NopCloser(strings.NewReader(`{"media_progression": 42}`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *changes.MediaProgression != 42 {
+		t.Fatalf(`Unexpected value, got %d instead of %d`, *changes.MediaProgression, 42)
+	}
+}
+
+func TestUpdateEnclosureProgressionWhenNotSet(t *testing.T) {
+	changes := &enclosureModification{}
+	enclosure := &model.Enclosure{MediaProgression: 10, Played: true}
+	changes.Update(enclosure)
+
+	if enclosure.MediaProgression != 10 || !enclosure.Played {
+		t.Fatal(`The enclosure should not be modified`)
+	}
+}
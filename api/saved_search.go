@@ -0,0 +1,214 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+)
+
+func (h *handler) createSavedSearch(w http.ResponseWriter, r *http.Request) {
+	savedSearch, err := decodeSavedSearchPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	savedSearch.UserID = userID
+	if err := savedSearch.ValidateSavedSearch(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if savedSearch.CategoryID > 0 && !h.store.CategoryExists(userID, savedSearch.CategoryID) {
+		json.BadRequest(w, r, errors.New("This category_id does not exist or does not belong to this user"))
+		return
+	}
+
+	if h.store.SavedSearchTitleExists(userID, savedSearch.Title) {
+		json.BadRequest(w, r, errors.New("This saved search already exists"))
+		return
+	}
+
+	if err := h.store.CreateSavedSearch(savedSearch); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, savedSearch)
+}
+
+func (h *handler) updateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	savedSearchID := request.RouteInt64Param(r, "savedSearchID")
+
+	originalSavedSearch, err := h.store.SavedSearchByID(userID, savedSearchID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if originalSavedSearch == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	savedSearch, err := decodeSavedSearchPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	savedSearch.UserID = userID
+	savedSearch.ID = savedSearchID
+	if err := savedSearch.ValidateSavedSearch(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if savedSearch.CategoryID > 0 && !h.store.CategoryExists(userID, savedSearch.CategoryID) {
+		json.BadRequest(w, r, errors.New("This category_id does not exist or does not belong to this user"))
+		return
+	}
+
+	if h.store.AnotherSavedSearchExists(userID, savedSearchID, savedSearch.Title) {
+		json.BadRequest(w, r, errors.New("This saved search already exists"))
+		return
+	}
+
+	if err := h.store.UpdateSavedSearch(savedSearch); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, savedSearch)
+}
+
+func (h *handler) getSavedSearches(w http.ResponseWriter, r *http.Request) {
+	savedSearches, err := h.store.SavedSearchesWithCounters(request.UserID(r), request.UserTimezone(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, savedSearches)
+}
+
+func (h *handler) removeSavedSearch(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	savedSearchID := request.RouteInt64Param(r, "savedSearchID")
+
+	savedSearch, err := h.store.SavedSearchByID(userID, savedSearchID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveSavedSearch(userID, savedSearchID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getSavedSearchEntries(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	savedSearch, err := h.store.SavedSearchByID(userID, request.RouteInt64Param(r, "savedSearchID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	query, err := savedSearch.SearchQuery(request.UserTimezone(r))
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	order := request.QueryStringParam(r, "order", model.DefaultSortingOrder)
+	if err := model.ValidateEntryOrder(order); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	direction := request.QueryStringParam(r, "direction", model.DefaultSortingDirection)
+	if err := model.ValidateDirection(direction); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := model.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithSavedSearch(savedSearch, query)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithOrder(order)
+	builder.WithDirection(direction)
+	builder.WithOffset(offset)
+	builder.WithLimit(limit)
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	count, err := builder.CountEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
+}
+
+func (h *handler) markSavedSearchAsRead(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	savedSearch, err := h.store.SavedSearchByID(userID, request.RouteInt64Param(r, "savedSearchID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	query, err := savedSearch.SearchQuery(request.UserTimezone(r))
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.MarkSavedSearchAsRead(userID, savedSearch, query, time.Now()); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
@@ -0,0 +1,380 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package backup // import "miniflux.app/backup"
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// Version is the format version of the archives produced by this package.
+const Version = 1
+
+// MaxArchiveSize is the size limit of the archives uploaded to the API or read by the command line.
+const MaxArchiveSize = 512 * 1024 * 1024
+
+const (
+	archiveFilename = "backup.json"
+	opmlFilename    = "feeds.opml"
+)
+
+// Archive contains all the data of a user.
+//
+// Identifiers are the ones of the exported instance, they are only used
+// to link the records together and are remapped during the import.
+type Archive struct {
+	Version          int                `json:"version"`
+	CreatedAt        time.Time          `json:"created_at"`
+	Settings         *Settings          `json:"settings"`
+	Categories       []*Category        `json:"categories"`
+	Feeds            []*Feed            `json:"feeds"`
+	Icons            []*Icon            `json:"icons"`
+	Entries          []*Entry           `json:"entries"`
+	Integration      *Integration       `json:"integration,omitempty"`
+	IntegrationRules []*IntegrationRule `json:"integration_rules"`
+	Digest           *Digest            `json:"digest,omitempty"`
+	SavedSearches    []*SavedSearch     `json:"saved_searches"`
+}
+
+// Settings represents the preferences of the user.
+type Settings struct {
+	Username          string `json:"username"`
+	Theme             string `json:"theme"`
+	Language          string `json:"language"`
+	Timezone          string `json:"timezone"`
+	EntryDirection    string `json:"entry_sorting_direction"`
+	KeyboardShortcuts bool   `json:"keyboard_shortcuts"`
+	SearchLanguage    string `json:"search_language,omitempty"`
+}
+
+// Category represents a category of the user, in the order of the export.
+type Category struct {
+	ID              int64  `json:"id"`
+	Title           string `json:"title"`
+	ArchiveReadDays int    `json:"archive_read_days,omitempty"`
+	MaxEntries      int    `json:"max_entries,omitempty"`
+	MarkAsReadDays  int    `json:"mark_as_read_days,omitempty"`
+}
+
+// Feed represents a subscription with all its options.
+type Feed struct {
+	ID              int64          `json:"id"`
+	CategoryID      int64          `json:"category_id"`
+	IconID          int64          `json:"icon_id,omitempty"`
+	FeedURL         string         `json:"feed_url"`
+	SiteURL         string         `json:"site_url"`
+	Title           string         `json:"title"`
+	ScraperRules    string         `json:"scraper_rules"`
+	RewriteRules    string         `json:"rewrite_rules"`
+	Crawler         bool           `json:"crawler"`
+	UserAgent       string         `json:"user_agent"`
+	Username        string         `json:"username"`
+	Password        string         `json:"password"`
+	Disabled        bool           `json:"disabled"`
+	Notify          bool           `json:"notify"`
+	Language        string         `json:"language,omitempty"`
+	SearchLanguage  string         `json:"search_language,omitempty"`
+	ItemSelector    string         `json:"item_selector"`
+	TitleSelector   string         `json:"title_selector"`
+	LinkSelector    string         `json:"link_selector"`
+	DateSelector    string         `json:"date_selector"`
+	ContentSelector string         `json:"content_selector"`
+	Podcast         *model.Podcast `json:"podcast,omitempty"`
+	ArchiveReadDays int            `json:"archive_read_days,omitempty"`
+	MaxEntries      int            `json:"max_entries,omitempty"`
+	MarkAsReadDays  int            `json:"mark_as_read_days,omitempty"`
+}
+
+// Icon represents a feed icon.
+type Icon struct {
+	ID       int64  `json:"id"`
+	Hash     string `json:"hash"`
+	MimeType string `json:"mime_type"`
+	Content  []byte `json:"content"`
+}
+
+// Entry represents a feed item with its status.
+type Entry struct {
+	FeedID       int64                 `json:"feed_id"`
+	Hash         string                `json:"hash"`
+	Title        string                `json:"title"`
+	URL          string                `json:"url"`
+	CommentsURL  string                `json:"comments_url"`
+	Date         time.Time             `json:"published_at"`
+	Content      string                `json:"content"`
+	Author       string                `json:"author"`
+	Status       string                `json:"status"`
+	Starred      bool                  `json:"starred"`
+	Podcast      *model.PodcastEpisode `json:"podcast,omitempty"`
+	Enclosures   []*Enclosure          `json:"enclosures,omitempty"`
+	Note         string                `json:"note,omitempty"`
+	Highlights   []string              `json:"highlights,omitempty"`
+	SnoozedUntil *time.Time            `json:"snoozed_until,omitempty"`
+}
+
+// Enclosure represents an attachment with its playback position and its podcast elements.
+type Enclosure struct {
+	URL              string                   `json:"url"`
+	MimeType         string                   `json:"mime_type"`
+	Size             int64                    `json:"size"`
+	MediaProgression int64                    `json:"media_progression"`
+	Played           bool                     `json:"played"`
+	ChaptersURL      string                   `json:"chapters_url,omitempty"`
+	Chapters         model.PodcastChapters    `json:"chapters,omitempty"`
+	Transcripts      model.PodcastTranscripts `json:"transcripts,omitempty"`
+}
+
+// Integration represents the settings of the third-party services.
+type Integration struct {
+	PinboardEnabled      bool   `json:"pinboard_enabled"`
+	PinboardToken        string `json:"pinboard_token"`
+	PinboardTags         string `json:"pinboard_tags"`
+	PinboardMarkAsUnread bool   `json:"pinboard_mark_as_unread"`
+	InstapaperEnabled    bool   `json:"instapaper_enabled"`
+	InstapaperUsername   string `json:"instapaper_username"`
+	InstapaperPassword   string `json:"instapaper_password"`
+	FeverEnabled         bool   `json:"fever_enabled"`
+	FeverUsername        string `json:"fever_username"`
+	FeverPassword        string `json:"fever_password"`
+	FeverToken           string `json:"fever_token"`
+	WallabagEnabled      bool   `json:"wallabag_enabled"`
+	WallabagURL          string `json:"wallabag_url"`
+	WallabagClientID     string `json:"wallabag_client_id"`
+	WallabagClientSecret string `json:"wallabag_client_secret"`
+	WallabagUsername     string `json:"wallabag_username"`
+	WallabagPassword     string `json:"wallabag_password"`
+	NunuxKeeperEnabled   bool   `json:"nunux_keeper_enabled"`
+	NunuxKeeperURL       string `json:"nunux_keeper_url"`
+	NunuxKeeperAPIKey    string `json:"nunux_keeper_api_key"`
+	PocketEnabled        bool   `json:"pocket_enabled"`
+	PocketAccessToken    string `json:"pocket_access_token"`
+	PocketConsumerKey    string `json:"pocket_consumer_key"`
+	WebhookEnabled       bool   `json:"webhook_enabled"`
+	WebhookURL           string `json:"webhook_url"`
+	WebhookSecret        string `json:"webhook_secret"`
+	WebhookNewEntries    bool   `json:"webhook_new_entries"`
+	WebhookSaveEntry     bool   `json:"webhook_save_entry"`
+	LinkdingEnabled      bool   `json:"linkding_enabled"`
+	LinkdingURL          string `json:"linkding_url"`
+	LinkdingAPIKey       string `json:"linkding_api_key"`
+	LinkdingTags         string `json:"linkding_tags"`
+	LinkdingMarkAsUnread bool   `json:"linkding_mark_as_unread"`
+	ShaarliEnabled       bool   `json:"shaarli_enabled"`
+	ShaarliURL           string `json:"shaarli_url"`
+	ShaarliAPISecret     string `json:"shaarli_api_secret"`
+	ShaarliTags          string `json:"shaarli_tags"`
+	ShaarliPrivate       bool   `json:"shaarli_private"`
+	MatrixEnabled        bool   `json:"matrix_enabled"`
+	MatrixURL            string `json:"matrix_url"`
+	MatrixAccessToken    string `json:"matrix_access_token"`
+	MatrixRoomID         string `json:"matrix_room_id"`
+	TelegramEnabled      bool   `json:"telegram_enabled"`
+	TelegramBotToken     string `json:"telegram_bot_token"`
+	TelegramChatID       string `json:"telegram_chat_id"`
+}
+
+func newIntegration(integration *model.Integration) *Integration {
+	return &Integration{
+		PinboardEnabled:      integration.PinboardEnabled,
+		PinboardToken:        integration.PinboardToken,
+		PinboardTags:         integration.PinboardTags,
+		PinboardMarkAsUnread: integration.PinboardMarkAsUnread,
+		InstapaperEnabled:    integration.InstapaperEnabled,
+		InstapaperUsername:   integration.InstapaperUsername,
+		InstapaperPassword:   integration.InstapaperPassword,
+		FeverEnabled:         integration.FeverEnabled,
+		FeverUsername:        integration.FeverUsername,
+		FeverPassword:        integration.FeverPassword,
+		FeverToken:           integration.FeverToken,
+		WallabagEnabled:      integration.WallabagEnabled,
+		WallabagURL:          integration.WallabagURL,
+		WallabagClientID:     integration.WallabagClientID,
+		WallabagClientSecret: integration.WallabagClientSecret,
+		WallabagUsername:     integration.WallabagUsername,
+		WallabagPassword:     integration.WallabagPassword,
+		NunuxKeeperEnabled:   integration.NunuxKeeperEnabled,
+		NunuxKeeperURL:       integration.NunuxKeeperURL,
+		NunuxKeeperAPIKey:    integration.NunuxKeeperAPIKey,
+		PocketEnabled:        integration.PocketEnabled,
+		PocketAccessToken:    integration.PocketAccessToken,
+		PocketConsumerKey:    integration.PocketConsumerKey,
+		WebhookEnabled:       integration.WebhookEnabled,
+		WebhookURL:           integration.WebhookURL,
+		WebhookSecret:        integration.WebhookSecret,
+		WebhookNewEntries:    integration.WebhookNewEntries,
+		WebhookSaveEntry:     integration.WebhookSaveEntry,
+		LinkdingEnabled:      integration.LinkdingEnabled,
+		LinkdingURL:          integration.LinkdingURL,
+		LinkdingAPIKey:       integration.LinkdingAPIKey,
+		LinkdingTags:         integration.LinkdingTags,
+		LinkdingMarkAsUnread: integration.LinkdingMarkAsUnread,
+		ShaarliEnabled:       integration.ShaarliEnabled,
+		ShaarliURL:           integration.ShaarliURL,
+		ShaarliAPISecret:     integration.ShaarliAPISecret,
+		ShaarliTags:          integration.ShaarliTags,
+		ShaarliPrivate:       integration.ShaarliPrivate,
+		MatrixEnabled:        integration.MatrixEnabled,
+		MatrixURL:            integration.MatrixURL,
+		MatrixAccessToken:    integration.MatrixAccessToken,
+		MatrixRoomID:         integration.MatrixRoomID,
+		TelegramEnabled:      integration.TelegramEnabled,
+		TelegramBotToken:     integration.TelegramBotToken,
+		TelegramChatID:       integration.TelegramChatID,
+	}
+}
+
+func (i *Integration) model(userID int64) *model.Integration {
+	return &model.Integration{
+		UserID:               userID,
+		PinboardEnabled:      i.PinboardEnabled,
+		PinboardToken:        i.PinboardToken,
+		PinboardTags:         i.PinboardTags,
+		PinboardMarkAsUnread: i.PinboardMarkAsUnread,
+		InstapaperEnabled:    i.InstapaperEnabled,
+		InstapaperUsername:   i.InstapaperUsername,
+		InstapaperPassword:   i.InstapaperPassword,
+		FeverEnabled:         i.FeverEnabled,
+		FeverUsername:        i.FeverUsername,
+		FeverPassword:        i.FeverPassword,
+		FeverToken:           i.FeverToken,
+		WallabagEnabled:      i.WallabagEnabled,
+		WallabagURL:          i.WallabagURL,
+		WallabagClientID:     i.WallabagClientID,
+		WallabagClientSecret: i.WallabagClientSecret,
+		WallabagUsername:     i.WallabagUsername,
+		WallabagPassword:     i.WallabagPassword,
+		NunuxKeeperEnabled:   i.NunuxKeeperEnabled,
+		NunuxKeeperURL:       i.NunuxKeeperURL,
+		NunuxKeeperAPIKey:    i.NunuxKeeperAPIKey,
+		PocketEnabled:        i.PocketEnabled,
+		PocketAccessToken:    i.PocketAccessToken,
+		PocketConsumerKey:    i.PocketConsumerKey,
+		WebhookEnabled:       i.WebhookEnabled,
+		WebhookURL:           i.WebhookURL,
+		WebhookSecret:        i.WebhookSecret,
+		WebhookNewEntries:    i.WebhookNewEntries,
+		WebhookSaveEntry:     i.WebhookSaveEntry,
+		LinkdingEnabled:      i.LinkdingEnabled,
+		LinkdingURL:          i.LinkdingURL,
+		LinkdingAPIKey:       i.LinkdingAPIKey,
+		LinkdingTags:         i.LinkdingTags,
+		LinkdingMarkAsUnread: i.LinkdingMarkAsUnread,
+		ShaarliEnabled:       i.ShaarliEnabled,
+		ShaarliURL:           i.ShaarliURL,
+		ShaarliAPISecret:     i.ShaarliAPISecret,
+		ShaarliTags:          i.ShaarliTags,
+		ShaarliPrivate:       i.ShaarliPrivate,
+		MatrixEnabled:        i.MatrixEnabled,
+		MatrixURL:            i.MatrixURL,
+		MatrixAccessToken:    i.MatrixAccessToken,
+		MatrixRoomID:         i.MatrixRoomID,
+		TelegramEnabled:      i.TelegramEnabled,
+		TelegramBotToken:     i.TelegramBotToken,
+		TelegramChatID:       i.TelegramChatID,
+	}
+}
+
+// IntegrationRule represents the automatic delivery settings of a third-party service.
+type IntegrationRule struct {
+	Service     string  `json:"service"`
+	OnBookmark  bool    `json:"on_bookmark"`
+	FeedIDs     []int64 `json:"feed_ids"`
+	CategoryIDs []int64 `json:"category_ids"`
+	Keywords    string  `json:"keywords"`
+}
+
+// Digest represents the email digest settings.
+type Digest struct {
+	Enabled     bool    `json:"enabled"`
+	Email       string  `json:"email"`
+	Frequency   string  `json:"frequency"`
+	Hour        int     `json:"hour"`
+	Weekday     int     `json:"weekday"`
+	CategoryIDs []int64 `json:"category_ids"`
+	MarkAsRead  bool    `json:"mark_as_read"`
+}
+
+// SavedSearch represents a search query saved by the user.
+type SavedSearch struct {
+	Title      string `json:"title"`
+	Query      string `json:"query"`
+	Status     string `json:"status"`
+	CategoryID int64  `json:"category_id,omitempty"`
+}
+
+// Write writes the archive as a zip file, the OPML file is added for other feed readers.
+func Write(w io.Writer, archive *Archive, opml string) error {
+	zipWriter := zip.NewWriter(w)
+
+	file, err := zipWriter.Create(archiveFilename)
+	if err != nil {
+		return fmt.Errorf("backup: unable to create archive: %v", err)
+	}
+
+	if err := json.NewEncoder(file).Encode(archive); err != nil {
+		return fmt.Errorf("backup: unable to encode archive: %v", err)
+	}
+
+	file, err = zipWriter.Create(opmlFilename)
+	if err != nil {
+		return fmt.Errorf("backup: unable to create archive: %v", err)
+	}
+
+	if _, err := io.WriteString(file, opml); err != nil {
+		return fmt.Errorf("backup: unable to write OPML file: %v", err)
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("backup: unable to write archive: %v", err)
+	}
+
+	return nil
+}
+
+// Read reads an archive produced by Write.
+func Read(data []byte) (*Archive, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("backup: invalid archive: %v", err)
+	}
+
+	for _, file := range zipReader.File {
+		if file.Name != archiveFilename {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return nil, fmt.Errorf("backup: unable to open archive: %v", err)
+		}
+		defer reader.Close()
+
+		content, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("backup: unable to read archive: %v", err)
+		}
+
+		var archive Archive
+		if err := json.Unmarshal(content, &archive); err != nil {
+			return nil, fmt.Errorf("backup: unable to decode archive: %v", err)
+		}
+
+		if archive.Version < 1 || archive.Version > Version {
+			return nil, fmt.Errorf("backup: unsupported archive version: %d", archive.Version)
+		}
+
+		return &archive, nil
+	}
+
+	return nil, errors.New("backup: invalid archive: " + archiveFilename + " is missing")
+}
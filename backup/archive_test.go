@@ -0,0 +1,142 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package backup // import "miniflux.app/backup"
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestWriteAndReadArchive(t *testing.T) {
+	snoozedUntil := time.Date(2019, 6, 2, 8, 0, 0, 0, time.UTC)
+	archive := &Archive{
+		Version:    Version,
+		CreatedAt:  time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC),
+		Settings:   &Settings{Username: "alice", Theme: "dark_serif", Language: "fr_FR", Timezone: "Europe/Paris", EntryDirection: "desc"},
+		Categories: []*Category{{ID: 3, Title: "News"}, {ID: 1, Title: "All"}},
+		Feeds:      []*Feed{{ID: 7, CategoryID: 3, IconID: 2, FeedURL: "https://example.org/feed.xml", Title: "Example", Crawler: true, Notify: true}},
+		Icons:      []*Icon{{ID: 2, Hash: "abc", MimeType: "image/png", Content: []byte{0x89, 0x50}}},
+		Entries: []*Entry{{
+			FeedID:  7,
+			Hash:    "h1",
+			Title:   "Entry",
+			Date:    time.Date(2019, 5, 1, 8, 0, 0, 0, time.UTC),
+			Status:  model.EntryStatusRead,
+			Starred: true,
+			Enclosures: []*Enclosure{{
+				URL:              "https://example.org/a.mp3",
+				MimeType:         "audio/mpeg",
+				MediaProgression: 42,
+				Played:           true,
+				ChaptersURL:      "https://example.org/chapters.json",
+				Chapters:         model.PodcastChapters{{StartTime: 90, Title: "Sponsor"}},
+				Transcripts:      model.PodcastTranscripts{{URL: "https://example.org/a.srt", MimeType: "application/x-subrip"}},
+			}},
+			Note:       "To read again",
+			Highlights: []string{"First passage", "Second passage"},
+		}, {
+			FeedID:       7,
+			Hash:         "h2",
+			Title:        "Snoozed entry",
+			Date:         time.Date(2019, 5, 2, 8, 0, 0, 0, time.UTC),
+			Status:       model.EntryStatusUnread,
+			SnoozedUntil: &snoozedUntil,
+		}},
+		Integration:      &Integration{PinboardEnabled: true, PinboardToken: "token"},
+		IntegrationRules: []*IntegrationRule{{Service: "pinboard", FeedIDs: []int64{7}}},
+		Digest:           &Digest{Enabled: true, Email: "alice@example.org", Frequency: "daily", Hour: 8, CategoryIDs: []int64{3}},
+		SavedSearches:    []*SavedSearch{{Title: "Go", Query: "golang", Status: model.EntryStatusUnread, CategoryID: 3}},
+	}
+
+	var buffer bytes.Buffer
+	if err := Write(&buffer, archive, "<opml/>"); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Read(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(archive, result) {
+		t.Errorf(`The archive is different after a round trip: %+v`, result)
+	}
+
+	zipReader, _ := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	var opmlContent []byte
+	for _, file := range zipReader.File {
+		if file.Name == opmlFilename {
+			reader, _ := file.Open()
+			opmlContent, _ = ioutil.ReadAll(reader)
+			reader.Close()
+		}
+	}
+
+	if string(opmlContent) != "<opml/>" {
+		t.Errorf(`Unexpected OPML file: %q`, opmlContent)
+	}
+}
+
+func TestReadArchiveWithUnsupportedVersion(t *testing.T) {
+	var buffer bytes.Buffer
+	if err := Write(&buffer, &Archive{Version: Version + 1}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Read(buffer.Bytes()); err == nil {
+		t.Error(`An archive produced by a newer version should be rejected`)
+	}
+}
+
+func TestReadInvalidArchive(t *testing.T) {
+	if _, err := Read([]byte("not a zip file")); err == nil {
+		t.Error(`An invalid archive should be rejected`)
+	}
+
+	var buffer bytes.Buffer
+	zipWriter := zip.NewWriter(&buffer)
+	zipWriter.Create("other.json")
+	zipWriter.Close()
+
+	if _, err := Read(buffer.Bytes()); err == nil {
+		t.Error(`An archive without backup.json should be rejected`)
+	}
+}
+
+func TestRemapIDs(t *testing.T) {
+	result := remapIDs([]int64{1, 2, 3}, map[int64]int64{1: 10, 3: 30})
+	expected := []int64{10, 30}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf(`Unexpected result, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestIntegrationArchiveFormat(t *testing.T) {
+	integration := newIntegration(&model.Integration{UserID: 1, WallabagClientID: "client", NunuxKeeperAPIKey: "key", TelegramChatID: "42"})
+
+	data, err := json.Marshal(integration)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, field := range []string{`"wallabag_client_id":"client"`, `"nunux_keeper_api_key":"key"`, `"telegram_chat_id":"42"`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf(`The field %s is missing: %s`, field, data)
+		}
+	}
+
+	result := integration.model(5)
+	if result.UserID != 5 || result.WallabagClientID != "client" || result.TelegramChatID != "42" {
+		t.Errorf(`Unexpected integration: %+v`, result)
+	}
+}
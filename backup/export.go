@@ -0,0 +1,249 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package backup // import "miniflux.app/backup"
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"miniflux.app/reader/opml"
+	"miniflux.app/storage"
+)
+
+// Export writes the archive of the given user.
+func Export(store *storage.Storage, userID int64, w io.Writer) error {
+	archive, err := NewArchive(store, userID)
+	if err != nil {
+		return err
+	}
+
+	subscriptions, err := opml.NewHandler(store).Export(userID)
+	if err != nil {
+		return err
+	}
+
+	return Write(w, archive, subscriptions)
+}
+
+// NewArchive collects all the data of the given user.
+func NewArchive(store *storage.Storage, userID int64) (*Archive, error) {
+	user, err := store.UserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("backup: user not found")
+	}
+
+	archive := &Archive{
+		Version:   Version,
+		CreatedAt: time.Now(),
+		Settings: &Settings{
+			Username:          user.Username,
+			Theme:             user.Theme,
+			Language:          user.Language,
+			Timezone:          user.Timezone,
+			EntryDirection:    user.EntryDirection,
+			KeyboardShortcuts: user.KeyboardShortcuts,
+			SearchLanguage:    user.SearchLanguage,
+		},
+		Categories:       make([]*Category, 0),
+		Feeds:            make([]*Feed, 0),
+		Icons:            make([]*Icon, 0),
+		Entries:          make([]*Entry, 0),
+		IntegrationRules: make([]*IntegrationRule, 0),
+		SavedSearches:    make([]*SavedSearch, 0),
+	}
+
+	categories, err := store.Categories(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, category := range categories {
+		archive.Categories = append(archive.Categories, &Category{
+			ID:              category.ID,
+			Title:           category.Title,
+			ArchiveReadDays: category.ArchiveReadDays,
+			MaxEntries:      category.MaxEntries,
+			MarkAsReadDays:  category.MarkAsReadDays,
+		})
+	}
+
+	feeds, err := store.Feeds(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feed := range feeds {
+		archiveFeed := &Feed{
+			ID:              feed.ID,
+			CategoryID:      feed.Category.ID,
+			FeedURL:         feed.FeedURL,
+			SiteURL:         feed.SiteURL,
+			Title:           feed.Title,
+			ScraperRules:    feed.ScraperRules,
+			RewriteRules:    feed.RewriteRules,
+			Crawler:         feed.Crawler,
+			UserAgent:       feed.UserAgent,
+			Username:        feed.Username,
+			Password:        feed.Password,
+			Disabled:        feed.Disabled,
+			Notify:          feed.Notify,
+			Language:        feed.Language,
+			SearchLanguage:  feed.SearchLanguage,
+			ItemSelector:    feed.ItemSelector,
+			TitleSelector:   feed.TitleSelector,
+			LinkSelector:    feed.LinkSelector,
+			DateSelector:    feed.DateSelector,
+			ContentSelector: feed.ContentSelector,
+			Podcast:         feed.Podcast,
+			ArchiveReadDays: feed.ArchiveReadDays,
+			MaxEntries:      feed.MaxEntries,
+			MarkAsReadDays:  feed.MarkAsReadDays,
+		}
+
+		if feed.Icon != nil {
+			archiveFeed.IconID = feed.Icon.IconID
+		}
+
+		archive.Feeds = append(archive.Feeds, archiveFeed)
+	}
+
+	icons, err := store.Icons(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	seenIcons := make(map[int64]bool)
+	for _, icon := range icons {
+		if seenIcons[icon.ID] {
+			continue
+		}
+
+		seenIcons[icon.ID] = true
+		archive.Icons = append(archive.Icons, &Icon{ID: icon.ID, Hash: icon.Hash, MimeType: icon.MimeType, Content: icon.Content})
+	}
+
+	builder := store.NewEntryQueryBuilder(userID)
+	builder.WithOrder("id")
+	builder.WithDirection("asc")
+	entries, err := builder.GetEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := store.UserEntryNotes(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	highlights, err := store.UserEntryHighlights(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		enclosures, err := store.GetEnclosures(entry.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		archiveEntry := &Entry{
+			FeedID:      entry.FeedID,
+			Hash:        entry.Hash,
+			Title:       entry.Title,
+			URL:         entry.URL,
+			CommentsURL: entry.CommentsURL,
+			Date:        entry.Date,
+			Content:     entry.Content,
+			Author:      entry.Author,
+			Status:      entry.Status,
+			Starred:     entry.Starred,
+			Podcast:     entry.Podcast,
+		}
+
+		if entry.SnoozedUntil != nil && entry.SnoozedUntil.After(time.Now()) {
+			archiveEntry.SnoozedUntil = entry.SnoozedUntil
+		}
+
+		for _, enclosure := range enclosures {
+			archiveEntry.Enclosures = append(archiveEntry.Enclosures, &Enclosure{
+				URL:              enclosure.URL,
+				MimeType:         enclosure.MimeType,
+				Size:             enclosure.Size,
+				MediaProgression: enclosure.MediaProgression,
+				Played:           enclosure.Played,
+				ChaptersURL:      enclosure.ChaptersURL,
+				Chapters:         enclosure.Chapters,
+				Transcripts:      enclosure.Transcripts,
+			})
+		}
+
+		if note, found := notes[entry.ID]; found {
+			archiveEntry.Note = note.Content
+		}
+
+		for _, highlight := range highlights[entry.ID] {
+			archiveEntry.Highlights = append(archiveEntry.Highlights, highlight.Text)
+		}
+
+		archive.Entries = append(archive.Entries, archiveEntry)
+	}
+
+	integration, err := store.Integration(userID)
+	if err != nil {
+		return nil, err
+	}
+	archive.Integration = newIntegration(integration)
+
+	rules, err := store.IntegrationRules(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		archive.IntegrationRules = append(archive.IntegrationRules, &IntegrationRule{
+			Service:     rule.Service,
+			OnBookmark:  rule.OnBookmark,
+			FeedIDs:     rule.FeedIDs,
+			CategoryIDs: rule.CategoryIDs,
+			Keywords:    rule.Keywords,
+		})
+	}
+
+	digest, err := store.Digest(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	archive.Digest = &Digest{
+		Enabled:     digest.Enabled,
+		Email:       digest.Email,
+		Frequency:   digest.Frequency,
+		Hour:        digest.Hour,
+		Weekday:     digest.Weekday,
+		CategoryIDs: digest.CategoryIDs,
+		MarkAsRead:  digest.MarkAsRead,
+	}
+
+	savedSearches, err := store.SavedSearches(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, savedSearch := range savedSearches {
+		archive.SavedSearches = append(archive.SavedSearches, &SavedSearch{
+			Title:      savedSearch.Title,
+			Query:      savedSearch.Query,
+			Status:     savedSearch.Status,
+			CategoryID: savedSearch.CategoryID,
+		})
+	}
+
+	return archive, nil
+}
@@ -0,0 +1,449 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package backup // import "miniflux.app/backup"
+
+import (
+	"errors"
+	"time"
+
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/search"
+	"miniflux.app/storage"
+)
+
+// ImportReport summarizes what an import changed.
+//
+// Records are matched by category title, feed URL, entry hash and saved search title, so
+// importing the same archive twice creates nothing the second time.
+type ImportReport struct {
+	CategoriesCreated int `json:"categories_created"`
+	FeedsCreated      int `json:"feeds_created"`
+	FeedsSkipped      int `json:"feeds_skipped"`
+	EntriesCreated    int `json:"entries_created"`
+	EntriesUpdated    int `json:"entries_updated"`
+}
+
+// Import restores an archive into the account of the given user, in a single transaction.
+func Import(store *storage.Storage, userID int64, archive *Archive) (*ImportReport, error) {
+	user, err := store.UserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("backup: user not found")
+	}
+
+	i := &importer{
+		store:       store,
+		user:        user,
+		report:      &ImportReport{},
+		categoryIDs: make(map[int64]int64),
+		feedIDs:     make(map[int64]int64),
+	}
+
+	steps := []func(*Archive) error{
+		i.importSettings,
+		i.importCategories,
+		i.importFeeds,
+		i.importEntries,
+		i.importIntegrations,
+		i.importDigest,
+		i.importSavedSearches,
+	}
+
+	// The archive is restored entirely or not at all.
+	err = store.WithTransaction(func(tx *storage.Storage) error {
+		i.store = tx
+		for _, step := range steps {
+			if err := step(archive); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return i.report, nil
+}
+
+type importer struct {
+	store       *storage.Storage
+	user        *model.User
+	report      *ImportReport
+	categoryIDs map[int64]int64
+	feedIDs     map[int64]int64
+}
+
+func (i *importer) importSettings(archive *Archive) error {
+	settings := archive.Settings
+	if settings == nil {
+		return nil
+	}
+
+	if model.ValidateTheme(settings.Theme) == nil {
+		i.user.Theme = settings.Theme
+	}
+
+	if _, found := locale.AvailableLanguages()[settings.Language]; found {
+		i.user.Language = settings.Language
+	}
+
+	timezones, err := i.store.Timezones()
+	if err != nil {
+		return err
+	}
+
+	if _, found := timezones[settings.Timezone]; found {
+		i.user.Timezone = settings.Timezone
+	}
+
+	if settings.EntryDirection == "asc" || settings.EntryDirection == "desc" {
+		i.user.EntryDirection = settings.EntryDirection
+	}
+
+	if search.IsValidConfiguration(settings.SearchLanguage) {
+		i.user.SearchLanguage = settings.SearchLanguage
+	}
+
+	i.user.KeyboardShortcuts = settings.KeyboardShortcuts
+	i.user.Password = ""
+
+	return i.store.UpdateUser(i.user)
+}
+
+func (i *importer) importCategories(archive *Archive) error {
+	for _, archiveCategory := range archive.Categories {
+		category, err := i.store.CategoryByTitle(i.user.ID, archiveCategory.Title)
+		if err != nil {
+			return err
+		}
+
+		if category == nil {
+			category = &model.Category{UserID: i.user.ID, Title: archiveCategory.Title}
+			if model.ValidateRetentionPolicy(archiveCategory.ArchiveReadDays, archiveCategory.MaxEntries, archiveCategory.MarkAsReadDays) == nil {
+				category.ArchiveReadDays = archiveCategory.ArchiveReadDays
+				category.MaxEntries = archiveCategory.MaxEntries
+				category.MarkAsReadDays = archiveCategory.MarkAsReadDays
+			}
+
+			if err := i.store.CreateCategory(category); err != nil {
+				return err
+			}
+
+			i.report.CategoriesCreated++
+		}
+
+		i.categoryIDs[archiveCategory.ID] = category.ID
+	}
+
+	return nil
+}
+
+func (i *importer) importFeeds(archive *Archive) error {
+	feeds, err := i.store.Feeds(i.user.ID)
+	if err != nil {
+		return err
+	}
+
+	existingFeeds := make(map[string]int64, len(feeds))
+	for _, feed := range feeds {
+		existingFeeds[feed.FeedURL] = feed.ID
+	}
+
+	icons := make(map[int64]*Icon, len(archive.Icons))
+	for _, icon := range archive.Icons {
+		icons[icon.ID] = icon
+	}
+
+	for _, archiveFeed := range archive.Feeds {
+		if feedID, found := existingFeeds[archiveFeed.FeedURL]; found {
+			i.feedIDs[archiveFeed.ID] = feedID
+			i.report.FeedsSkipped++
+			continue
+		}
+
+		category, err := i.category(archiveFeed.CategoryID)
+		if err != nil {
+			return err
+		}
+
+		feed := &model.Feed{
+			UserID:          i.user.ID,
+			FeedURL:         archiveFeed.FeedURL,
+			SiteURL:         archiveFeed.SiteURL,
+			Title:           archiveFeed.Title,
+			ScraperRules:    archiveFeed.ScraperRules,
+			RewriteRules:    archiveFeed.RewriteRules,
+			Crawler:         archiveFeed.Crawler,
+			UserAgent:       archiveFeed.UserAgent,
+			Username:        archiveFeed.Username,
+			Password:        archiveFeed.Password,
+			Disabled:        archiveFeed.Disabled,
+			Notify:          archiveFeed.Notify,
+			Language:        archiveFeed.Language,
+			ItemSelector:    archiveFeed.ItemSelector,
+			TitleSelector:   archiveFeed.TitleSelector,
+			LinkSelector:    archiveFeed.LinkSelector,
+			DateSelector:    archiveFeed.DateSelector,
+			ContentSelector: archiveFeed.ContentSelector,
+			Podcast:         archiveFeed.Podcast,
+			Category:        category,
+		}
+
+		if search.IsValidConfiguration(archiveFeed.SearchLanguage) {
+			feed.SearchLanguage = archiveFeed.SearchLanguage
+		}
+
+		if model.ValidateRetentionPolicy(archiveFeed.ArchiveReadDays, archiveFeed.MaxEntries, archiveFeed.MarkAsReadDays) == nil {
+			feed.ArchiveReadDays = archiveFeed.ArchiveReadDays
+			feed.MaxEntries = archiveFeed.MaxEntries
+			feed.MarkAsReadDays = archiveFeed.MarkAsReadDays
+		}
+
+		if err := i.store.CreateFeed(feed); err != nil {
+			return err
+		}
+
+		if icon, found := icons[archiveFeed.IconID]; found {
+			feedIcon := &model.Icon{Hash: icon.Hash, MimeType: icon.MimeType, Content: icon.Content}
+			if err := i.store.CreateFeedIcon(feed.ID, feedIcon); err != nil {
+				return err
+			}
+		}
+
+		existingFeeds[feed.FeedURL] = feed.ID
+		i.feedIDs[archiveFeed.ID] = feed.ID
+		i.report.FeedsCreated++
+	}
+
+	return nil
+}
+
+func (i *importer) importEntries(archive *Archive) error {
+	for _, archiveEntry := range archive.Entries {
+		feedID, found := i.feedIDs[archiveEntry.FeedID]
+		if !found {
+			continue
+		}
+
+		entry := &model.Entry{
+			UserID:      i.user.ID,
+			FeedID:      feedID,
+			Hash:        archiveEntry.Hash,
+			Title:       archiveEntry.Title,
+			URL:         archiveEntry.URL,
+			CommentsURL: archiveEntry.CommentsURL,
+			Date:        archiveEntry.Date,
+			Content:     archiveEntry.Content,
+			Author:      archiveEntry.Author,
+			Status:      archiveEntry.Status,
+			Starred:     archiveEntry.Starred,
+			Podcast:     archiveEntry.Podcast,
+		}
+
+		if model.ValidateEntryStatus(entry.Status) != nil {
+			entry.Status = model.EntryStatusUnread
+		}
+
+		for _, enclosure := range archiveEntry.Enclosures {
+			entry.Enclosures = append(entry.Enclosures, &model.Enclosure{
+				URL:              enclosure.URL,
+				MimeType:         enclosure.MimeType,
+				Size:             enclosure.Size,
+				MediaProgression: enclosure.MediaProgression,
+				Played:           enclosure.Played,
+				ChaptersURL:      enclosure.ChaptersURL,
+				Chapters:         enclosure.Chapters,
+				Transcripts:      enclosure.Transcripts,
+			})
+		}
+
+		created, err := i.store.RestoreEntry(entry)
+		if err != nil {
+			return err
+		}
+
+		if created {
+			i.report.EntriesCreated++
+		} else {
+			i.report.EntriesUpdated++
+		}
+
+		if err := i.importAnnotations(entry.ID, archiveEntry); err != nil {
+			return err
+		}
+
+		if archiveEntry.SnoozedUntil != nil && archiveEntry.SnoozedUntil.After(time.Now()) && entry.Status == model.EntryStatusUnread {
+			if err := i.store.SnoozeEntries(i.user.ID, []int64{entry.ID}, *archiveEntry.SnoozedUntil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// importAnnotations restores the note and the highlights of an entry,
+// an existing note is kept and existing highlights are not duplicated.
+func (i *importer) importAnnotations(entryID int64, archiveEntry *Entry) error {
+	if archiveEntry.Note != "" {
+		note, err := i.store.EntryNote(i.user.ID, entryID)
+		if err != nil {
+			return err
+		}
+
+		if note == nil {
+			note = &model.EntryNote{UserID: i.user.ID, EntryID: entryID, Content: archiveEntry.Note}
+			if err := note.ValidateEntryNote(); err != nil {
+				logger.Info("[Backup:Import] The note of entry #%d is not restored: %v", entryID, err)
+			} else if err := i.store.SaveEntryNote(note); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(archiveEntry.Highlights) == 0 {
+		return nil
+	}
+
+	highlights, err := i.store.EntryHighlights(i.user.ID, entryID)
+	if err != nil {
+		return err
+	}
+
+	existingHighlights := make(map[string]bool, len(highlights))
+	for _, highlight := range highlights {
+		existingHighlights[highlight.Text] = true
+	}
+
+	for _, text := range archiveEntry.Highlights {
+		highlight := &model.EntryHighlight{UserID: i.user.ID, EntryID: entryID, Text: text}
+		if existingHighlights[text] || highlight.ValidateEntryHighlight() != nil {
+			continue
+		}
+
+		if err := i.store.CreateEntryHighlight(highlight); err != nil {
+			return err
+		}
+
+		existingHighlights[text] = true
+	}
+
+	return nil
+}
+
+func (i *importer) importIntegrations(archive *Archive) error {
+	if archive.Integration != nil {
+		integration := archive.Integration.model(i.user.ID)
+		if integration.FeverUsername != "" && i.store.HasDuplicateFeverUsername(i.user.ID, integration.FeverUsername) {
+			logger.Info("[Backup:Import] Fever username %q is already used, the Fever integration is not restored", integration.FeverUsername)
+			integration.FeverEnabled = false
+			integration.FeverUsername = ""
+			integration.FeverPassword = ""
+			integration.FeverToken = ""
+		}
+
+		if err := i.store.UpdateIntegration(integration); err != nil {
+			return err
+		}
+	}
+
+	var rules model.IntegrationRules
+	for _, rule := range archive.IntegrationRules {
+		rules = append(rules, &model.IntegrationRule{
+			UserID:      i.user.ID,
+			Service:     rule.Service,
+			OnBookmark:  rule.OnBookmark,
+			FeedIDs:     remapIDs(rule.FeedIDs, i.feedIDs),
+			CategoryIDs: remapIDs(rule.CategoryIDs, i.categoryIDs),
+			Keywords:    rule.Keywords,
+		})
+	}
+
+	return i.store.UpdateIntegrationRules(i.user.ID, rules)
+}
+
+func (i *importer) importDigest(archive *Archive) error {
+	digest := archive.Digest
+	if digest == nil {
+		return nil
+	}
+
+	return i.store.UpdateDigest(&model.Digest{
+		UserID:      i.user.ID,
+		Enabled:     digest.Enabled,
+		Email:       digest.Email,
+		Frequency:   digest.Frequency,
+		Hour:        digest.Hour,
+		Weekday:     digest.Weekday,
+		CategoryIDs: remapIDs(digest.CategoryIDs, i.categoryIDs),
+		MarkAsRead:  digest.MarkAsRead,
+	})
+}
+
+func (i *importer) importSavedSearches(archive *Archive) error {
+	for _, archiveSavedSearch := range archive.SavedSearches {
+		if i.store.SavedSearchTitleExists(i.user.ID, archiveSavedSearch.Title) {
+			continue
+		}
+
+		savedSearch := &model.SavedSearch{
+			UserID: i.user.ID,
+			Title:  archiveSavedSearch.Title,
+			Query:  archiveSavedSearch.Query,
+			Status: archiveSavedSearch.Status,
+		}
+
+		if archiveSavedSearch.CategoryID > 0 {
+			savedSearch.CategoryID = i.categoryIDs[archiveSavedSearch.CategoryID]
+		}
+
+		if err := savedSearch.ValidateSavedSearch(); err != nil {
+			logger.Info("[Backup:Import] Saved search %q is not restored: %v", savedSearch.Title, err)
+			continue
+		}
+
+		if err := i.store.CreateSavedSearch(savedSearch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *importer) category(archiveCategoryID int64) (*model.Category, error) {
+	if categoryID, found := i.categoryIDs[archiveCategoryID]; found {
+		return &model.Category{ID: categoryID, UserID: i.user.ID}, nil
+	}
+
+	category, err := i.store.FirstCategory(i.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, errors.New("backup: the user has no category")
+	}
+
+	return category, nil
+}
+
+// remapIDs translates the identifiers of the archive, unknown identifiers are dropped.
+func remapIDs(ids []int64, mapping map[int64]int64) []int64 {
+	var result []int64
+	for _, id := range ids {
+		if newID, found := mapping[id]; found {
+			result = append(result, newID)
+		}
+	}
+	return result
+}
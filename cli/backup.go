@@ -0,0 +1,70 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"miniflux.app/backup"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+func exportUser(store *storage.Storage, username string) {
+	user := findUser(store, username)
+	if err := backup.Export(store, user.ID, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func importUser(store *storage.Storage, username string) {
+	user := findUser(store, username)
+
+	data, err := ioutil.ReadAll(io.LimitReader(os.Stdin, backup.MaxArchiveSize+1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	if len(data) > backup.MaxArchiveSize {
+		fmt.Fprintf(os.Stderr, "The archive is larger than %d bytes\n", backup.MaxArchiveSize)
+		os.Exit(1)
+	}
+
+	archive, err := backup.Read(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	report, err := backup.Import(store, user.ID, archive)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Categories created: %d\n", report.CategoriesCreated)
+	fmt.Printf("Feeds created: %d, skipped: %d\n", report.FeedsCreated, report.FeedsSkipped)
+	fmt.Printf("Entries created: %d, updated: %d\n", report.EntriesCreated, report.EntriesUpdated)
+}
+
+func findUser(store *storage.Storage, username string) *model.User {
+	user, err := store.UserByUsername(username)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	if user == nil {
+		fmt.Fprintf(os.Stderr, "User not found!\n")
+		os.Exit(1)
+	}
+
+	return user
+}
@@ -26,6 +26,8 @@ const (
 	flagDebugModeHelp       = "Show debug logs"
 	flagConfigFileHelp      = "Load configuration file"
 	flagConfigDumpHelp      = "Print parsed configuration values"
+	flagExportUserHelp      = "Write the backup archive of a user to the standard output"
+	flagImportUserHelp      = "Restore a backup archive read from the standard input into a user account"
 )
 
 // Parse parses command line arguments.
@@ -42,6 +44,8 @@ func Parse() {
 		flagDebugMode       bool
 		flagConfigFile      string
 		flagConfigDump      bool
+		flagExportUser      string
+		flagImportUser      string
 	)
 
 	flag.BoolVar(&flagInfo, "info", false, flagInfoHelp)
@@ -57,6 +61,8 @@ func Parse() {
 	flag.StringVar(&flagConfigFile, "config-file", "", flagConfigFileHelp)
 	flag.StringVar(&flagConfigFile, "c", "", flagConfigFileHelp)
 	flag.BoolVar(&flagConfigDump, "config-dump", false, flagConfigDumpHelp)
+	flag.StringVar(&flagExportUser, "export-user", "", flagExportUserHelp)
+	flag.StringVar(&flagImportUser, "import-user", "", flagImportUserHelp)
 	flag.Parse()
 
 	cfg := config.NewParser()
@@ -137,6 +143,16 @@ func Parse() {
 		return
 	}
 
+	if flagExportUser != "" {
+		exportUser(store, flagExportUser)
+		return
+	}
+
+	if flagImportUser != "" {
+		importUser(store, flagImportUser)
+		return
+	}
+
 	// Run migrations and start the deamon.
 	if config.Opts.RunMigrations() {
 		database.Migrate(db)
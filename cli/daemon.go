@@ -15,6 +15,8 @@ import (
 
 	"miniflux.app/config"
 	"miniflux.app/logger"
+	"miniflux.app/newsletter"
+	"miniflux.app/proxy"
 	"miniflux.app/reader/feed"
 	"miniflux.app/service/httpd"
 	"miniflux.app/service/scheduler"
@@ -22,6 +24,8 @@ import (
 	"miniflux.app/worker"
 )
 
+const proxyPrivateKeySecret = "proxy_private_key"
+
 func startDaemon(store *storage.Storage) {
 	logger.Info("Starting Miniflux...")
 
@@ -31,11 +35,24 @@ func startDaemon(store *storage.Storage) {
 
 	feedHandler := feed.NewFeedHandler(store)
 	pool := worker.NewPool(feedHandler, config.Opts.WorkerPoolSize())
+	deliveryPool := worker.NewDeliveryPool(store, config.Opts.IntegrationWorkerPoolSize())
+
+	if len(config.Opts.ProxyPrivateKey()) == 0 {
+		key, err := store.Secret(proxyPrivateKeySecret, 16)
+		if err != nil {
+			logger.Fatal("Unable to load the image proxy key: %v", err)
+		}
+		proxy.UseStoredPrivateKey(key)
+	}
 
 	go showProcessStatistics()
 
 	if config.Opts.HasSchedulerService() {
-		scheduler.Serve(store, pool)
+		scheduler.Serve(store, pool, deliveryPool)
+	}
+
+	if addr := config.Opts.NewsletterListenAddr(); addr != "" {
+		go startNewsletterServer(store, addr)
 	}
 
 	var httpServer *http.Server
@@ -55,6 +72,16 @@ func startDaemon(store *storage.Storage) {
 	logger.Info("Process gracefully stopped")
 }
 
+func startNewsletterServer(store *storage.Storage, addr string) {
+	domain := newsletter.Domain()
+	logger.Info(`Listening for newsletters on %q for the domain %q`, addr, domain)
+
+	server := newsletter.NewServer(domain, newsletter.NewDeliverFunc(store, domain))
+	if err := server.ListenAndServe(addr); err != nil {
+		logger.Error("[Newsletter] %v", err)
+	}
+}
+
 func showProcessStatistics() {
 	for {
 		var m runtime.MemStats
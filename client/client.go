@@ -204,6 +204,23 @@ func (c *Client) UpdateCategory(categoryID int64, title string) (*Category, erro
 	return category, nil
 }
 
+// ModifyCategory updates the title or the retention policy of a category.
+func (c *Client) ModifyCategory(categoryID int64, categoryChanges *CategoryModification) (*Category, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/categories/%d", categoryID), categoryChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var category *Category
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&category); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return category, nil
+}
+
 // DeleteCategory removes a category.
 func (c *Client) DeleteCategory(categoryID int64) error {
 	body, err := c.request.Delete(fmt.Sprintf("/v1/categories/%d", categoryID))
@@ -215,6 +232,97 @@ func (c *Client) DeleteCategory(categoryID int64) error {
 	return nil
 }
 
+// SavedSearches gets the list of saved searches with their number of unread entries.
+func (c *Client) SavedSearches() (SavedSearches, error) {
+	body, err := c.request.Get("/v1/searches")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var savedSearches SavedSearches
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&savedSearches); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return savedSearches, nil
+}
+
+// CreateSavedSearch saves a search query.
+func (c *Client) CreateSavedSearch(savedSearch *SavedSearch) (*SavedSearch, error) {
+	body, err := c.request.Post("/v1/searches", savedSearch)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result *SavedSearch
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result, nil
+}
+
+// UpdateSavedSearch updates a saved search.
+func (c *Client) UpdateSavedSearch(savedSearchID int64, savedSearch *SavedSearch) (*SavedSearch, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/searches/%d", savedSearchID), savedSearch)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result *SavedSearch
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result, nil
+}
+
+// DeleteSavedSearch removes a saved search.
+func (c *Client) DeleteSavedSearch(savedSearchID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/searches/%d", savedSearchID))
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return nil
+}
+
+// SavedSearchEntries fetches the entries matching a saved search.
+func (c *Client) SavedSearchEntries(savedSearchID int64, filter *Filter) (*EntryResultSet, error) {
+	path := buildFilterQueryString(fmt.Sprintf("/v1/searches/%d/entries", savedSearchID), filter)
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
+// MarkSavedSearchAsRead marks all entries matching a saved search as read.
+func (c *Client) MarkSavedSearchAsRead(savedSearchID int64) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/searches/%d/mark-all-as-read", savedSearchID), nil)
+	if err != nil {
+		return err
+	}
+	body.Close()
+	return nil
+}
+
 // Feeds gets all feeds.
 func (c *Client) Feeds() (Feeds, error) {
 	body, err := c.request.Get("/v1/feeds")
@@ -254,6 +362,34 @@ func (c *Client) Import(f io.ReadCloser) error {
 	return err
 }
 
+// ExportBackup downloads the backup archive of the current user.
+func (c *Client) ExportBackup() ([]byte, error) {
+	body, err := c.request.Get("/v1/backup")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return ioutil.ReadAll(body)
+}
+
+// ImportBackup restores a backup archive into the account of the current user.
+func (c *Client) ImportBackup(f io.ReadCloser) (*BackupReport, error) {
+	body, err := c.request.PostFile("/v1/backup", f)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var report *BackupReport
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&report); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return report, nil
+}
+
 // Feed gets a feed.
 func (c *Client) Feed(feedID int64) (*Feed, error) {
 	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d", feedID))
@@ -448,6 +584,117 @@ func (c *Client) ToggleBookmark(entryID int64) error {
 	return nil
 }
 
+// SnoozeEntry hides an unread entry until the given time: "later_today", "tomorrow",
+// "next_week", a date formatted as YYYY-MM-DDTHH:MM in the user timezone or as RFC3339.
+func (c *Client) SnoozeEntry(entryID int64, until string) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/snooze", entryID), map[string]string{"snoozed_until": until})
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// UnsnoozeEntry cancels the snooze of an entry.
+func (c *Client) UnsnoozeEntry(entryID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/entries/%d/snooze", entryID))
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// EntryNote gets the note of an entry.
+func (c *Client) EntryNote(entryID int64) (*EntryNote, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/note", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var note *EntryNote
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&note); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return note, nil
+}
+
+// SaveEntryNote creates or updates the note of an entry, an empty content removes the note.
+func (c *Client) SaveEntryNote(entryID int64, content string) error {
+	body, err := c.request.Put(fmt.Sprintf("/v1/entries/%d/note", entryID), map[string]interface{}{
+		"content": content,
+	})
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// DeleteEntryNote removes the note of an entry.
+func (c *Client) DeleteEntryNote(entryID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/entries/%d/note", entryID))
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
+// EntryHighlights gets the highlights of an entry.
+func (c *Client) EntryHighlights(entryID int64) (Highlights, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/highlights", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlights Highlights
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&highlights); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlights, nil
+}
+
+// CreateEntryHighlight highlights a passage of an entry.
+func (c *Client) CreateEntryHighlight(entryID int64, text string) (*Highlight, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/entries/%d/highlights", entryID), map[string]interface{}{
+		"text": text,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var highlight *Highlight
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&highlight); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return highlight, nil
+}
+
+// DeleteEntryHighlight removes a highlight.
+func (c *Client) DeleteEntryHighlight(entryID, highlightID int64) error {
+	body, err := c.request.Delete(fmt.Sprintf("/v1/entries/%d/highlights/%d", entryID, highlightID))
+	if err != nil {
+		return err
+	}
+	body.Close()
+
+	return nil
+}
+
 // New returns a new Miniflux client.
 func New(endpoint, username, password string) *Client {
 	return &Client{request: &request{endpoint: endpoint, username: username, password: password}}
@@ -497,6 +744,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("starred", "1")
 		}
 
+		if filter.Snoozed {
+			values.Set("snoozed", "1")
+		}
+
 		if filter.Search != "" {
 			values.Set("search", filter.Search)
 		}
@@ -26,6 +26,7 @@ type User struct {
 	Language       string            `json:"language"`
 	Timezone       string            `json:"timezone"`
 	EntryDirection string            `json:"entry_sorting_direction"`
+	SearchLanguage string            `json:"search_language"`
 	LastLoginAt    *time.Time        `json:"last_login_at"`
 	Extra          map[string]string `json:"extra"`
 }
@@ -43,6 +44,7 @@ type UserModification struct {
 	Language       *string `json:"language"`
 	Timezone       *string `json:"timezone"`
 	EntryDirection *string `json:"entry_sorting_direction"`
+	SearchLanguage *string `json:"search_language"`
 }
 
 // Users represents a list of users.
@@ -50,9 +52,20 @@ type Users []User
 
 // Category represents a feed category.
 type Category struct {
-	ID     int64  `json:"id,omitempty"`
-	Title  string `json:"title,omitempty"`
-	UserID int64  `json:"user_id,omitempty"`
+	ID              int64  `json:"id,omitempty"`
+	Title           string `json:"title,omitempty"`
+	UserID          int64  `json:"user_id,omitempty"`
+	ArchiveReadDays int    `json:"archive_read_days"`
+	MaxEntries      int    `json:"max_entries"`
+	MarkAsReadDays  int    `json:"mark_as_read_days"`
+}
+
+// CategoryModification represents changes for a category.
+type CategoryModification struct {
+	Title           *string `json:"title"`
+	ArchiveReadDays *int    `json:"archive_read_days"`
+	MaxEntries      *int    `json:"max_entries"`
+	MarkAsReadDays  *int    `json:"mark_as_read_days"`
 }
 
 func (c Category) String() string {
@@ -62,6 +75,24 @@ func (c Category) String() string {
 // Categories represents a list of categories.
 type Categories []*Category
 
+// SavedSearch represents a search query displayed like a feed.
+type SavedSearch struct {
+	ID          int64  `json:"id,omitempty"`
+	UserID      int64  `json:"user_id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Query       string `json:"query,omitempty"`
+	Status      string `json:"status,omitempty"`
+	CategoryID  int64  `json:"category_id,omitempty"`
+	UnreadCount int    `json:"unread_count,omitempty"`
+}
+
+func (s SavedSearch) String() string {
+	return fmt.Sprintf("#%d %s (%s)", s.ID, s.Title, s.Query)
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
+
 // Subscription represents a feed subscription.
 type Subscription struct {
 	Title string `json:"title"`
@@ -94,6 +125,11 @@ type Feed struct {
 	UserAgent          string    `json:"user_agent"`
 	Username           string    `json:"username"`
 	Password           string    `json:"password"`
+	Language           string    `json:"language"`
+	SearchLanguage     string    `json:"search_language"`
+	ArchiveReadDays    int       `json:"archive_read_days"`
+	MaxEntries         int       `json:"max_entries"`
+	MarkAsReadDays     int       `json:"mark_as_read_days"`
 	Category           *Category `json:"category,omitempty"`
 }
 
@@ -109,6 +145,12 @@ type FeedModification struct {
 	Username     *string `json:"username"`
 	Password     *string `json:"password"`
 	CategoryID   *int64  `json:"category_id"`
+
+	SearchLanguage *string `json:"search_language"`
+
+	ArchiveReadDays *int `json:"archive_read_days"`
+	MaxEntries      *int `json:"max_entries"`
+	MarkAsReadDays  *int `json:"mark_as_read_days"`
 }
 
 // FeedIcon represents the feed icon.
@@ -123,19 +165,23 @@ type Feeds []*Feed
 
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID         int64      `json:"id"`
-	UserID     int64      `json:"user_id"`
-	FeedID     int64      `json:"feed_id"`
-	Status     string     `json:"status"`
-	Hash       string     `json:"hash"`
-	Title      string     `json:"title"`
-	URL        string     `json:"url"`
-	Date       time.Time  `json:"published_at"`
-	Content    string     `json:"content"`
-	Author     string     `json:"author"`
-	Starred    bool       `json:"starred"`
-	Enclosures Enclosures `json:"enclosures,omitempty"`
-	Feed       *Feed      `json:"feed,omitempty"`
+	ID           int64      `json:"id"`
+	UserID       int64      `json:"user_id"`
+	FeedID       int64      `json:"feed_id"`
+	Status       string     `json:"status"`
+	Hash         string     `json:"hash"`
+	Title        string     `json:"title"`
+	URL          string     `json:"url"`
+	Date         time.Time  `json:"published_at"`
+	Content      string     `json:"content"`
+	Author       string     `json:"author"`
+	Starred      bool       `json:"starred"`
+	Tags         []string   `json:"tags,omitempty"`
+	SnoozedUntil *time.Time `json:"snoozed_until,omitempty"`
+	Enclosures   Enclosures `json:"enclosures,omitempty"`
+	Note         *EntryNote `json:"note,omitempty"`
+	Highlights   Highlights `json:"highlights,omitempty"`
+	Feed         *Feed      `json:"feed,omitempty"`
 }
 
 // Entries represents a list of entries.
@@ -154,6 +200,27 @@ type Enclosure struct {
 // Enclosures represents a list of attachments.
 type Enclosures []*Enclosure
 
+// EntryNote represents the private note of an entry, written in Markdown.
+type EntryNote struct {
+	EntryID   int64     `json:"entry_id"`
+	UserID    int64     `json:"user_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// Highlight represents a highlighted passage of an entry.
+type Highlight struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	EntryID   int64     `json:"entry_id"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Highlights represents a list of highlights.
+type Highlights []*Highlight
+
 // Filter is used to filter entries.
 type Filter struct {
 	Status        string
@@ -162,6 +229,7 @@ type Filter struct {
 	Order         string
 	Direction     string
 	Starred       bool
+	Snoozed       bool
 	Before        int64
 	After         int64
 	BeforeEntryID int64
@@ -175,3 +243,12 @@ type EntryResultSet struct {
 	Total   int     `json:"total"`
 	Entries Entries `json:"entries"`
 }
+
+// BackupReport represents the result of a backup import.
+type BackupReport struct {
+	CategoriesCreated int `json:"categories_created"`
+	FeedsCreated      int `json:"feeds_created"`
+	FeedsSkipped      int `json:"feeds_skipped"`
+	EntriesCreated    int `json:"entries_created"`
+	EntriesUpdated    int `json:"entries_updated"`
+}
@@ -7,6 +7,7 @@ package config // import "miniflux.app/config"
 import (
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -587,6 +588,41 @@ func TestCleanupRemoveSessionsDays(t *testing.T) {
 	}
 }
 
+func TestDefaultCleanupRemoveDeliveriesDaysValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 30
+	result := opts.CleanupRemoveDeliveriesDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_REMOVE_DELIVERIES_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCleanupRemoveDeliveriesDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CLEANUP_REMOVE_DELIVERIES_DAYS", "7")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 7
+	result := opts.CleanupRemoveDeliveriesDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_REMOVE_DELIVERIES_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDefaultWorkerPoolSizeValue(t *testing.T) {
 	os.Clearenv()
 
@@ -1257,3 +1293,603 @@ Invalid text
 		t.Fatal(err)
 	}
 }
+
+func TestDefaultIntegrationWorkerPoolSizeValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultIntegrationWorkerPoolSize
+	result := opts.IntegrationWorkerPoolSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected INTEGRATION_WORKER_POOL_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestIntegrationWorkerPoolSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("INTEGRATION_WORKER_POOL_SIZE", "42")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 42
+	result := opts.IntegrationWorkerPoolSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected INTEGRATION_WORKER_POOL_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultIntegrationDeliveryFrequencyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultIntegrationDeliveryFrequency
+	result := opts.IntegrationDeliveryFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected INTEGRATION_DELIVERY_FREQUENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestIntegrationDeliveryFrequency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("INTEGRATION_DELIVERY_FREQUENCY", "42")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 42
+	result := opts.IntegrationDeliveryFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected INTEGRATION_DELIVERY_FREQUENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSMTPHostValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSMTPHost
+	result := opts.SMTPHost()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_HOST value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSMTPHost(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_HOST", "smtp.example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "smtp.example.org"
+	result := opts.SMTPHost()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_HOST value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSMTPPortValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSMTPPort
+	result := opts.SMTPPort()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PORT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSMTPPort(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_PORT", "587")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 587
+	result := opts.SMTPPort()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PORT value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSMTPUsernameValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSMTPUsername
+	result := opts.SMTPUsername()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_USERNAME value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSMTPUsername(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_USERNAME", "miniflux")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "miniflux"
+	result := opts.SMTPUsername()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_USERNAME value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSMTPPasswordValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSMTPPassword
+	result := opts.SMTPPassword()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PASSWORD value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSMTPPassword(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_PASSWORD", "secret")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "secret"
+	result := opts.SMTPPassword()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_PASSWORD value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultSMTPFromValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultSMTPFrom
+	result := opts.SMTPFrom()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_FROM value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestSMTPFrom(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SMTP_FROM", "digest@example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "digest@example.org"
+	result := opts.SMTPFrom()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SMTP_FROM value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultNewsletterDomainValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultNewsletterDomain
+	result := opts.NewsletterDomain()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_DOMAIN value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestNewsletterDomain(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("NEWSLETTER_DOMAIN", "mail.example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "mail.example.org"
+	result := opts.NewsletterDomain()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_DOMAIN value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultNewsletterListenAddrValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultNewsletterListenAddr
+	result := opts.NewsletterListenAddr()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_LISTEN_ADDR value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestNewsletterListenAddr(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("NEWSLETTER_LISTEN_ADDR", ":2525")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := ":2525"
+	result := opts.NewsletterListenAddr()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_LISTEN_ADDR value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultNewsletterMaildirValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultNewsletterMaildir
+	result := opts.NewsletterMaildir()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_MAILDIR value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestNewsletterMaildir(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("NEWSLETTER_MAILDIR", "/var/mail/miniflux")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "/var/mail/miniflux"
+	result := opts.NewsletterMaildir()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_MAILDIR value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultProxyCacheDirectoryValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultProxyCacheDirectory
+	result := opts.ProxyCacheDirectory()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_CACHE_DIRECTORY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestProxyCacheDirectory(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_CACHE_DIRECTORY", "/var/cache/miniflux")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "/var/cache/miniflux"
+	result := opts.ProxyCacheDirectory()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_CACHE_DIRECTORY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultProxyCacheMaxSizeValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultProxyCacheMaxSize
+	result := opts.ProxyCacheMaxSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestProxyCacheMaxSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_CACHE_MAX_SIZE", "250")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 250
+	result := opts.ProxyCacheMaxSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultProxyCacheMaxImageSizeValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := int64(defaultProxyCacheMaxImageSize * 1024 * 1024)
+	result := opts.ProxyCacheMaxImageSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_IMAGE_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestProxyCacheMaxImageSize(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_CACHE_MAX_IMAGE_SIZE", "2")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := int64(2 * 1024 * 1024)
+	result := opts.ProxyCacheMaxImageSize()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_IMAGE_SIZE value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultProxyPrefetchImagesValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultProxyPrefetchImages
+	result := opts.ProxyPrefetchImages()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_PREFETCH_IMAGES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestProxyPrefetchImages(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_PREFETCH_IMAGES", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := true
+	result := opts.ProxyPrefetchImages()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_PREFETCH_IMAGES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultProxyPrivateKeyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if len(opts.ProxyPrivateKey()) != 0 {
+		t.Fatalf(`PROXY_PRIVATE_KEY should be empty by default, got %d bytes`, len(opts.ProxyPrivateKey()))
+	}
+}
+
+func TestProxyPrivateKey(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_PRIVATE_KEY", "foobar")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "foobar"
+	result := string(opts.ProxyPrivateKey())
+
+	if result != expected {
+		t.Fatalf(`Unexpected PROXY_PRIVATE_KEY value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultHTTPClientAllowlistValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if len(opts.HTTPClientAllowlist()) != 0 {
+		t.Fatalf(`Unexpected HTTP_CLIENT_ALLOWLIST value: %v`, opts.HTTPClientAllowlist())
+	}
+}
+
+func TestHTTPClientAllowlist(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "Feeds.Internal, 10.0.0.0/8,,192.168.1.10 ")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "feeds.internal,10.0.0.0/8,192.168.1.10"
+	result := strings.Join(opts.HTTPClientAllowlist(), ",")
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_ALLOWLIST value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestHTTPClientAllowlistWithInvalidNetwork(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "10.0.0.0/99")
+
+	parser := NewParser()
+	_, err := parser.ParseEnvironmentVariables()
+	if err == nil {
+		t.Fatal(`Invalid networks should be rejected`)
+	}
+}
+
+func TestDefaultOPMLSyncFrequencyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultOPMLSyncFrequency
+	result := opts.OPMLSyncFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected OPML_SYNC_FREQUENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestOPMLSyncFrequency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("OPML_SYNC_FREQUENCY", "15")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 15
+	result := opts.OPMLSyncFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected OPML_SYNC_FREQUENCY value, got %v instead of %v`, result, expected)
+	}
+}
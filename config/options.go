@@ -10,117 +10,167 @@ import (
 )
 
 const (
-	defaultHTTPS                     = false
-	defaultLogDateTime               = false
-	defaultHSTS                      = true
-	defaultHTTPService               = true
-	defaultSchedulerService          = true
-	defaultDebug                     = false
-	defaultBaseURL                   = "http://localhost"
-	defaultRootURL                   = "http://localhost"
-	defaultBasePath                  = ""
-	defaultWorkerPoolSize            = 5
-	defaultPollingFrequency          = 60
-	defaultBatchSize                 = 10
-	defaultRunMigrations             = false
-	defaultDatabaseURL               = "user=postgres password=postgres dbname=miniflux2 sslmode=disable"
-	defaultDatabaseMaxConns          = 20
-	defaultDatabaseMinConns          = 1
-	defaultListenAddr                = "127.0.0.1:8080"
-	defaultCertFile                  = ""
-	defaultKeyFile                   = ""
-	defaultCertDomain                = ""
-	defaultCertCache                 = "/tmp/cert_cache"
-	defaultCleanupFrequencyHours     = 24
-	defaultCleanupArchiveReadDays    = 60
-	defaultCleanupRemoveSessionsDays = 30
-	defaultProxyImages               = "http-only"
-	defaultCreateAdmin               = false
-	defaultOAuth2UserCreation        = false
-	defaultOAuth2ClientID            = ""
-	defaultOAuth2ClientSecret        = ""
-	defaultOAuth2RedirectURL         = ""
-	defaultOAuth2Provider            = ""
-	defaultPocketConsumerKey         = ""
-	defaultHTTPClientTimeout         = 20
-	defaultHTTPClientMaxBodySize     = 15
+	defaultHTTPS                        = false
+	defaultLogDateTime                  = false
+	defaultHSTS                         = true
+	defaultHTTPService                  = true
+	defaultSchedulerService             = true
+	defaultDebug                        = false
+	defaultBaseURL                      = "http://localhost"
+	defaultRootURL                      = "http://localhost"
+	defaultBasePath                     = ""
+	defaultWorkerPoolSize               = 5
+	defaultPollingFrequency             = 60
+	defaultBatchSize                    = 10
+	defaultIntegrationWorkerPoolSize    = 2
+	defaultIntegrationDeliveryFrequency = 30
+	defaultOPMLSyncFrequency            = 60
+	defaultSMTPHost                     = ""
+	defaultSMTPPort                     = 25
+	defaultSMTPUsername                 = ""
+	defaultSMTPPassword                 = ""
+	defaultSMTPFrom                     = "miniflux@localhost"
+	defaultNewsletterDomain             = ""
+	defaultNewsletterListenAddr         = ""
+	defaultNewsletterMaildir            = ""
+	defaultRunMigrations                = false
+	defaultDatabaseURL                  = "user=postgres password=postgres dbname=miniflux2 sslmode=disable"
+	defaultDatabaseMaxConns             = 20
+	defaultDatabaseMinConns             = 1
+	defaultListenAddr                   = "127.0.0.1:8080"
+	defaultCertFile                     = ""
+	defaultKeyFile                      = ""
+	defaultCertDomain                   = ""
+	defaultCertCache                    = "/tmp/cert_cache"
+	defaultCleanupFrequencyHours        = 24
+	defaultCleanupArchiveReadDays       = 60
+	defaultCleanupRemoveSessionsDays    = 30
+	defaultCleanupRemoveDeliveriesDays  = 30
+	defaultProxyImages                  = "http-only"
+	defaultProxyCacheDirectory          = ""
+	defaultProxyCacheMaxSize            = 100
+	defaultProxyCacheMaxImageSize       = 5
+	defaultProxyPrefetchImages          = false
+	defaultCreateAdmin                  = false
+	defaultOAuth2UserCreation           = false
+	defaultOAuth2ClientID               = ""
+	defaultOAuth2ClientSecret           = ""
+	defaultOAuth2RedirectURL            = ""
+	defaultOAuth2Provider               = ""
+	defaultPocketConsumerKey            = ""
+	defaultHTTPClientTimeout            = 20
+	defaultHTTPClientMaxBodySize        = 15
 )
 
 // Options contains configuration options.
 type Options struct {
-	HTTPS                     bool
-	logDateTime               bool
-	hsts                      bool
-	httpService               bool
-	schedulerService          bool
-	debug                     bool
-	baseURL                   string
-	rootURL                   string
-	basePath                  string
-	databaseURL               string
-	databaseMaxConns          int
-	databaseMinConns          int
-	runMigrations             bool
-	listenAddr                string
-	certFile                  string
-	certDomain                string
-	certCache                 string
-	certKeyFile               string
-	cleanupFrequencyHours     int
-	cleanupArchiveReadDays    int
-	cleanupRemoveSessionsDays int
-	pollingFrequency          int
-	batchSize                 int
-	workerPoolSize            int
-	createAdmin               bool
-	proxyImages               string
-	oauth2UserCreationAllowed bool
-	oauth2ClientID            string
-	oauth2ClientSecret        string
-	oauth2RedirectURL         string
-	oauth2Provider            string
-	pocketConsumerKey         string
-	httpClientTimeout         int
-	httpClientMaxBodySize     int64
+	HTTPS                        bool
+	logDateTime                  bool
+	hsts                         bool
+	httpService                  bool
+	schedulerService             bool
+	debug                        bool
+	baseURL                      string
+	rootURL                      string
+	basePath                     string
+	databaseURL                  string
+	databaseMaxConns             int
+	databaseMinConns             int
+	runMigrations                bool
+	listenAddr                   string
+	certFile                     string
+	certDomain                   string
+	certCache                    string
+	certKeyFile                  string
+	cleanupFrequencyHours        int
+	cleanupArchiveReadDays       int
+	cleanupRemoveSessionsDays    int
+	cleanupRemoveDeliveriesDays  int
+	pollingFrequency             int
+	batchSize                    int
+	integrationWorkerPoolSize    int
+	integrationDeliveryFrequency int
+	opmlSyncFrequency            int
+	smtpHost                     string
+	smtpPort                     int
+	smtpUsername                 string
+	smtpPassword                 string
+	smtpFrom                     string
+	newsletterDomain             string
+	newsletterListenAddr         string
+	newsletterMaildir            string
+	workerPoolSize               int
+	createAdmin                  bool
+	proxyImages                  string
+	proxyCacheDirectory          string
+	proxyCacheMaxSize            int
+	proxyCacheMaxImageSize       int64
+	proxyPrefetchImages          bool
+	proxyPrivateKey              []byte
+	oauth2UserCreationAllowed    bool
+	oauth2ClientID               string
+	oauth2ClientSecret           string
+	oauth2RedirectURL            string
+	oauth2Provider               string
+	pocketConsumerKey            string
+	httpClientTimeout            int
+	httpClientMaxBodySize        int64
+	httpClientAllowlist          []string
 }
 
 // NewOptions returns Options with default values.
 func NewOptions() *Options {
 	return &Options{
-		HTTPS:                     defaultHTTPS,
-		logDateTime:               defaultLogDateTime,
-		hsts:                      defaultHSTS,
-		httpService:               defaultHTTPService,
-		schedulerService:          defaultSchedulerService,
-		debug:                     defaultDebug,
-		baseURL:                   defaultBaseURL,
-		rootURL:                   defaultRootURL,
-		basePath:                  defaultBasePath,
-		databaseURL:               defaultDatabaseURL,
-		databaseMaxConns:          defaultDatabaseMaxConns,
-		databaseMinConns:          defaultDatabaseMinConns,
-		runMigrations:             defaultRunMigrations,
-		listenAddr:                defaultListenAddr,
-		certFile:                  defaultCertFile,
-		certDomain:                defaultCertDomain,
-		certCache:                 defaultCertCache,
-		certKeyFile:               defaultKeyFile,
-		cleanupFrequencyHours:     defaultCleanupFrequencyHours,
-		cleanupArchiveReadDays:    defaultCleanupArchiveReadDays,
-		cleanupRemoveSessionsDays: defaultCleanupRemoveSessionsDays,
-		pollingFrequency:          defaultPollingFrequency,
-		batchSize:                 defaultBatchSize,
-		workerPoolSize:            defaultWorkerPoolSize,
-		createAdmin:               defaultCreateAdmin,
-		proxyImages:               defaultProxyImages,
-		oauth2UserCreationAllowed: defaultOAuth2UserCreation,
-		oauth2ClientID:            defaultOAuth2ClientID,
-		oauth2ClientSecret:        defaultOAuth2ClientSecret,
-		oauth2RedirectURL:         defaultOAuth2RedirectURL,
-		oauth2Provider:            defaultOAuth2Provider,
-		pocketConsumerKey:         defaultPocketConsumerKey,
-		httpClientTimeout:         defaultHTTPClientTimeout,
-		httpClientMaxBodySize:     defaultHTTPClientMaxBodySize * 1024 * 1024,
+		HTTPS:                        defaultHTTPS,
+		logDateTime:                  defaultLogDateTime,
+		hsts:                         defaultHSTS,
+		httpService:                  defaultHTTPService,
+		schedulerService:             defaultSchedulerService,
+		debug:                        defaultDebug,
+		baseURL:                      defaultBaseURL,
+		rootURL:                      defaultRootURL,
+		basePath:                     defaultBasePath,
+		databaseURL:                  defaultDatabaseURL,
+		databaseMaxConns:             defaultDatabaseMaxConns,
+		databaseMinConns:             defaultDatabaseMinConns,
+		runMigrations:                defaultRunMigrations,
+		listenAddr:                   defaultListenAddr,
+		certFile:                     defaultCertFile,
+		certDomain:                   defaultCertDomain,
+		certCache:                    defaultCertCache,
+		certKeyFile:                  defaultKeyFile,
+		cleanupFrequencyHours:        defaultCleanupFrequencyHours,
+		cleanupArchiveReadDays:       defaultCleanupArchiveReadDays,
+		cleanupRemoveSessionsDays:    defaultCleanupRemoveSessionsDays,
+		cleanupRemoveDeliveriesDays:  defaultCleanupRemoveDeliveriesDays,
+		pollingFrequency:             defaultPollingFrequency,
+		batchSize:                    defaultBatchSize,
+		integrationWorkerPoolSize:    defaultIntegrationWorkerPoolSize,
+		integrationDeliveryFrequency: defaultIntegrationDeliveryFrequency,
+		opmlSyncFrequency:            defaultOPMLSyncFrequency,
+		smtpHost:                     defaultSMTPHost,
+		smtpPort:                     defaultSMTPPort,
+		smtpUsername:                 defaultSMTPUsername,
+		smtpPassword:                 defaultSMTPPassword,
+		smtpFrom:                     defaultSMTPFrom,
+		newsletterDomain:             defaultNewsletterDomain,
+		newsletterListenAddr:         defaultNewsletterListenAddr,
+		newsletterMaildir:            defaultNewsletterMaildir,
+		workerPoolSize:               defaultWorkerPoolSize,
+		createAdmin:                  defaultCreateAdmin,
+		proxyImages:                  defaultProxyImages,
+		proxyCacheDirectory:          defaultProxyCacheDirectory,
+		proxyCacheMaxSize:            defaultProxyCacheMaxSize,
+		proxyCacheMaxImageSize:       defaultProxyCacheMaxImageSize * 1024 * 1024,
+		proxyPrefetchImages:          defaultProxyPrefetchImages,
+		oauth2UserCreationAllowed:    defaultOAuth2UserCreation,
+		oauth2ClientID:               defaultOAuth2ClientID,
+		oauth2ClientSecret:           defaultOAuth2ClientSecret,
+		oauth2RedirectURL:            defaultOAuth2RedirectURL,
+		oauth2Provider:               defaultOAuth2Provider,
+		pocketConsumerKey:            defaultPocketConsumerKey,
+		httpClientTimeout:            defaultHTTPClientTimeout,
+		httpClientMaxBodySize:        defaultHTTPClientMaxBodySize * 1024 * 1024,
 	}
 }
 
@@ -209,6 +259,11 @@ func (o *Options) CleanupRemoveSessionsDays() int {
 	return o.cleanupRemoveSessionsDays
 }
 
+// CleanupRemoveDeliveriesDays returns the number of days after which to remove finished integration deliveries.
+func (o *Options) CleanupRemoveDeliveriesDays() int {
+	return o.cleanupRemoveDeliveriesDays
+}
+
 // WorkerPoolSize returns the number of background worker.
 func (o *Options) WorkerPoolSize() int {
 	return o.workerPoolSize
@@ -224,6 +279,61 @@ func (o *Options) BatchSize() int {
 	return o.batchSize
 }
 
+// IntegrationWorkerPoolSize returns the number of background workers sending entries to third-party services.
+func (o *Options) IntegrationWorkerPoolSize() int {
+	return o.integrationWorkerPoolSize
+}
+
+// IntegrationDeliveryFrequency returns the interval in seconds to process queued integration deliveries.
+func (o *Options) IntegrationDeliveryFrequency() int {
+	return o.integrationDeliveryFrequency
+}
+
+// OPMLSyncFrequency returns the interval in minutes to synchronize remote OPML subscriptions.
+func (o *Options) OPMLSyncFrequency() int {
+	return o.opmlSyncFrequency
+}
+
+// SMTPHost returns the hostname of the SMTP server used to send emails.
+func (o *Options) SMTPHost() string {
+	return o.smtpHost
+}
+
+// SMTPPort returns the port of the SMTP server.
+func (o *Options) SMTPPort() int {
+	return o.smtpPort
+}
+
+// SMTPUsername returns the username used to authenticate with the SMTP server.
+func (o *Options) SMTPUsername() string {
+	return o.smtpUsername
+}
+
+// SMTPPassword returns the password used to authenticate with the SMTP server.
+func (o *Options) SMTPPassword() string {
+	return o.smtpPassword
+}
+
+// SMTPFrom returns the sender address of the emails.
+func (o *Options) SMTPFrom() string {
+	return o.smtpFrom
+}
+
+// NewsletterDomain returns the domain of the newsletter addresses.
+func (o *Options) NewsletterDomain() string {
+	return o.newsletterDomain
+}
+
+// NewsletterListenAddr returns the listen address of the SMTP server receiving newsletters.
+func (o *Options) NewsletterListenAddr() string {
+	return o.newsletterListenAddr
+}
+
+// NewsletterMaildir returns the path of the Maildir polled for newsletters.
+func (o *Options) NewsletterMaildir() string {
+	return o.newsletterMaildir
+}
+
 // IsOAuth2UserCreationAllowed returns true if user creation is allowed for OAuth2 users.
 func (o *Options) IsOAuth2UserCreationAllowed() bool {
 	return o.oauth2UserCreationAllowed
@@ -269,6 +379,32 @@ func (o *Options) ProxyImages() string {
 	return o.proxyImages
 }
 
+// ProxyCacheDirectory returns the directory where proxified images are cached, the cache is disabled when empty.
+func (o *Options) ProxyCacheDirectory() string {
+	return o.proxyCacheDirectory
+}
+
+// ProxyCacheMaxSize returns the maximum size of the image cache in megabytes.
+func (o *Options) ProxyCacheMaxSize() int {
+	return o.proxyCacheMaxSize
+}
+
+// ProxyCacheMaxImageSize returns the maximum size in bytes of an image stored in the cache.
+func (o *Options) ProxyCacheMaxImageSize() int64 {
+	return o.proxyCacheMaxImageSize
+}
+
+// ProxyPrefetchImages returns true if images of new entries are downloaded into the cache when feeds are refreshed.
+func (o *Options) ProxyPrefetchImages() bool {
+	return o.proxyPrefetchImages
+}
+
+// ProxyPrivateKey returns the key used to sign the URLs of the image proxy.
+// It is empty when the key generated and stored in the database must be used.
+func (o *Options) ProxyPrivateKey() []byte {
+	return o.proxyPrivateKey
+}
+
 // HasHTTPService returns true if the HTTP service is enabled.
 func (o *Options) HasHTTPService() bool {
 	return o.httpService
@@ -297,6 +433,11 @@ func (o *Options) HTTPClientMaxBodySize() int64 {
 	return o.httpClientMaxBodySize
 }
 
+// HTTPClientAllowlist returns the hostnames and CIDR ranges that the HTTP client can reach even if they are private.
+func (o *Options) HTTPClientAllowlist() []string {
+	return o.httpClientAllowlist
+}
+
 func (o *Options) String() string {
 	var builder strings.Builder
 	builder.WriteString(fmt.Sprintf("LOG_DATE_TIME: %v\n", o.logDateTime))
@@ -320,10 +461,27 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("CLEANUP_FREQUENCY_HOURS: %v\n", o.cleanupFrequencyHours))
 	builder.WriteString(fmt.Sprintf("CLEANUP_ARCHIVE_READ_DAYS: %v\n", o.cleanupArchiveReadDays))
 	builder.WriteString(fmt.Sprintf("CLEANUP_REMOVE_SESSIONS_DAYS: %v\n", o.cleanupRemoveSessionsDays))
+	builder.WriteString(fmt.Sprintf("CLEANUP_REMOVE_DELIVERIES_DAYS: %v\n", o.cleanupRemoveDeliveriesDays))
 	builder.WriteString(fmt.Sprintf("WORKER_POOL_SIZE: %v\n", o.workerPoolSize))
 	builder.WriteString(fmt.Sprintf("POLLING_FREQUENCY: %v\n", o.pollingFrequency))
 	builder.WriteString(fmt.Sprintf("BATCH_SIZE: %v\n", o.batchSize))
+	builder.WriteString(fmt.Sprintf("INTEGRATION_WORKER_POOL_SIZE: %v\n", o.integrationWorkerPoolSize))
+	builder.WriteString(fmt.Sprintf("INTEGRATION_DELIVERY_FREQUENCY: %v\n", o.integrationDeliveryFrequency))
+	builder.WriteString(fmt.Sprintf("OPML_SYNC_FREQUENCY: %v\n", o.opmlSyncFrequency))
+	builder.WriteString(fmt.Sprintf("SMTP_HOST: %v\n", o.smtpHost))
+	builder.WriteString(fmt.Sprintf("SMTP_PORT: %v\n", o.smtpPort))
+	builder.WriteString(fmt.Sprintf("SMTP_USERNAME: %v\n", o.smtpUsername))
+	builder.WriteString("SMTP_PASSWORD: <hidden>\n")
+	builder.WriteString(fmt.Sprintf("SMTP_FROM: %v\n", o.smtpFrom))
+	builder.WriteString(fmt.Sprintf("NEWSLETTER_DOMAIN: %v\n", o.newsletterDomain))
+	builder.WriteString(fmt.Sprintf("NEWSLETTER_LISTEN_ADDR: %v\n", o.newsletterListenAddr))
+	builder.WriteString(fmt.Sprintf("NEWSLETTER_MAILDIR: %v\n", o.newsletterMaildir))
 	builder.WriteString(fmt.Sprintf("PROXY_IMAGES: %v\n", o.proxyImages))
+	builder.WriteString(fmt.Sprintf("PROXY_CACHE_DIRECTORY: %v\n", o.proxyCacheDirectory))
+	builder.WriteString(fmt.Sprintf("PROXY_CACHE_MAX_SIZE: %v\n", o.proxyCacheMaxSize))
+	builder.WriteString(fmt.Sprintf("PROXY_CACHE_MAX_IMAGE_SIZE: %v\n", o.proxyCacheMaxImageSize))
+	builder.WriteString(fmt.Sprintf("PROXY_PREFETCH_IMAGES: %v\n", o.proxyPrefetchImages))
+	builder.WriteString("PROXY_PRIVATE_KEY: <hidden>\n")
 	builder.WriteString(fmt.Sprintf("CREATE_ADMIN: %v\n", o.createAdmin))
 	builder.WriteString(fmt.Sprintf("POCKET_CONSUMER_KEY: %v\n", o.pocketConsumerKey))
 	builder.WriteString(fmt.Sprintf("OAUTH2_USER_CREATION: %v\n", o.oauth2UserCreationAllowed))
@@ -333,5 +491,6 @@ func (o *Options) String() string {
 	builder.WriteString(fmt.Sprintf("OAUTH2_PROVIDER: %v\n", o.oauth2Provider))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_TIMEOUT: %v\n", o.httpClientTimeout))
 	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_MAX_BODY_SIZE: %v\n", o.httpClientMaxBodySize))
+	builder.WriteString(fmt.Sprintf("HTTP_CLIENT_ALLOWLIST: %v\n", strings.Join(o.httpClientAllowlist, ",")))
 	return builder.String()
 }
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	url_parser "net/url"
 	"os"
 	"strconv"
@@ -116,6 +117,8 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.cleanupArchiveReadDays = parseInt(value, defaultCleanupArchiveReadDays)
 		case "CLEANUP_REMOVE_SESSIONS_DAYS":
 			p.opts.cleanupRemoveSessionsDays = parseInt(value, defaultCleanupRemoveSessionsDays)
+		case "CLEANUP_REMOVE_DELIVERIES_DAYS":
+			p.opts.cleanupRemoveDeliveriesDays = parseInt(value, defaultCleanupRemoveDeliveriesDays)
 		case "CLEANUP_FREQUENCY":
 			logger.Error("[Config] CLEANUP_FREQUENCY has been deprecated in favor of CLEANUP_FREQUENCY_HOURS.")
 
@@ -138,8 +141,40 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.pollingFrequency = parseInt(value, defaultPollingFrequency)
 		case "BATCH_SIZE":
 			p.opts.batchSize = parseInt(value, defaultBatchSize)
+		case "INTEGRATION_WORKER_POOL_SIZE":
+			p.opts.integrationWorkerPoolSize = parseInt(value, defaultIntegrationWorkerPoolSize)
+		case "INTEGRATION_DELIVERY_FREQUENCY":
+			p.opts.integrationDeliveryFrequency = parseInt(value, defaultIntegrationDeliveryFrequency)
+		case "OPML_SYNC_FREQUENCY":
+			p.opts.opmlSyncFrequency = parseInt(value, defaultOPMLSyncFrequency)
+		case "SMTP_HOST":
+			p.opts.smtpHost = parseString(value, defaultSMTPHost)
+		case "SMTP_PORT":
+			p.opts.smtpPort = parseInt(value, defaultSMTPPort)
+		case "SMTP_USERNAME":
+			p.opts.smtpUsername = parseString(value, defaultSMTPUsername)
+		case "SMTP_PASSWORD":
+			p.opts.smtpPassword = parseString(value, defaultSMTPPassword)
+		case "SMTP_FROM":
+			p.opts.smtpFrom = parseString(value, defaultSMTPFrom)
+		case "NEWSLETTER_DOMAIN":
+			p.opts.newsletterDomain = parseString(value, defaultNewsletterDomain)
+		case "NEWSLETTER_LISTEN_ADDR":
+			p.opts.newsletterListenAddr = parseString(value, defaultNewsletterListenAddr)
+		case "NEWSLETTER_MAILDIR":
+			p.opts.newsletterMaildir = parseString(value, defaultNewsletterMaildir)
 		case "PROXY_IMAGES":
 			p.opts.proxyImages = parseString(value, defaultProxyImages)
+		case "PROXY_CACHE_DIRECTORY":
+			p.opts.proxyCacheDirectory = parseString(value, defaultProxyCacheDirectory)
+		case "PROXY_CACHE_MAX_SIZE":
+			p.opts.proxyCacheMaxSize = parseInt(value, defaultProxyCacheMaxSize)
+		case "PROXY_CACHE_MAX_IMAGE_SIZE":
+			p.opts.proxyCacheMaxImageSize = int64(parseInt(value, defaultProxyCacheMaxImageSize) * 1024 * 1024)
+		case "PROXY_PREFETCH_IMAGES":
+			p.opts.proxyPrefetchImages = parseBool(value, defaultProxyPrefetchImages)
+		case "PROXY_PRIVATE_KEY":
+			p.opts.proxyPrivateKey = parseBytes(value, nil)
 		case "CREATE_ADMIN":
 			p.opts.createAdmin = parseBool(value, defaultCreateAdmin)
 		case "POCKET_CONSUMER_KEY":
@@ -158,6 +193,11 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.httpClientTimeout = parseInt(value, defaultHTTPClientTimeout)
 		case "HTTP_CLIENT_MAX_BODY_SIZE":
 			p.opts.httpClientMaxBodySize = int64(parseInt(value, defaultHTTPClientMaxBodySize) * 1024 * 1024)
+		case "HTTP_CLIENT_ALLOWLIST":
+			p.opts.httpClientAllowlist, err = parseAllowlist(value)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -191,6 +231,26 @@ func parseBaseURL(value string) (string, string, string, error) {
 	return value, url.String(), basePath, nil
 }
 
+func parseAllowlist(value string) ([]string, error) {
+	var allowlist []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" {
+			continue
+		}
+
+		if strings.Contains(item, "/") {
+			if _, _, err := net.ParseCIDR(item); err != nil {
+				return nil, fmt.Errorf("invalid network in HTTP_CLIENT_ALLOWLIST: %q", item)
+			}
+		}
+
+		allowlist = append(allowlist, item)
+	}
+
+	return allowlist, nil
+}
+
 func parseBool(value string, fallback bool) bool {
 	if value == "" {
 		return fallback
@@ -217,6 +277,13 @@ func parseInt(value string, fallback int) int {
 	return v
 }
 
+func parseBytes(value string, fallback []byte) []byte {
+	if value == "" {
+		return fallback
+	}
+	return []byte(value)
+}
+
 func parseString(value string, fallback string) string {
 	if value == "" {
 		return fallback
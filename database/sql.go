@@ -156,6 +156,44 @@ UPDATE users SET theme='dark_serif' WHERE theme='black';
 	"schema_version_26": `alter table entries add column changed_at timestamp with time zone;
 update entries set changed_at = published_at;
 alter table entries alter column changed_at set not null;
+`,
+	"schema_version_27": `alter table integrations add column webhook_enabled bool default 'f';
+alter table integrations add column webhook_url text default '';
+alter table integrations add column webhook_secret text default '';
+alter table integrations add column webhook_new_entries bool default 'f';
+alter table integrations add column webhook_save_entry bool default 'f';
+`,
+	"schema_version_28": `create type integration_delivery_status as enum('pending', 'processing', 'success', 'failed');
+
+create table integration_deliveries (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    service text not null,
+    status integration_delivery_status not null default 'pending',
+    attempts int not null default 0,
+    error_msg text not null default '',
+    next_attempt_at timestamp with time zone not null default now(),
+    created_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index integration_deliveries_status_idx on integration_deliveries(status, next_attempt_at);
+create index integration_deliveries_user_idx on integration_deliveries(user_id, created_at);
+`,
+	"schema_version_29": `create table integration_rules (
+    user_id int not null,
+    service text not null,
+    on_bookmark bool not null default 'f',
+    feed_ids bigint[] not null default '{}',
+    category_ids bigint[] not null default '{}',
+    keywords text not null default '',
+    primary key (user_id, service),
+    foreign key (user_id) references users(id) on delete cascade
+);
 `,
 	"schema_version_3": `create table tokens (
     id text not null,
@@ -163,8 +201,230 @@ alter table entries alter column changed_at set not null;
     created_at timestamp with time zone not null default now(),
     primary key(id, value)
 );`,
+	"schema_version_30": `alter table integrations add column linkding_enabled bool default 'f';
+alter table integrations add column linkding_url text default '';
+alter table integrations add column linkding_api_key text default '';
+alter table integrations add column linkding_tags text default '';
+alter table integrations add column linkding_mark_as_unread bool default 'f';
+alter table integrations add column shaarli_enabled bool default 'f';
+alter table integrations add column shaarli_url text default '';
+alter table integrations add column shaarli_api_secret text default '';
+alter table integrations add column shaarli_tags text default '';
+alter table integrations add column shaarli_private bool default 'f';
+`,
+	"schema_version_31": `alter table feeds add column notify bool default 'f';
+alter table integrations add column matrix_enabled bool default 'f';
+alter table integrations add column matrix_url text default '';
+alter table integrations add column matrix_access_token text default '';
+alter table integrations add column matrix_room_id text default '';
+alter table integrations add column telegram_enabled bool default 'f';
+alter table integrations add column telegram_bot_token text default '';
+alter table integrations add column telegram_chat_id text default '';
+`,
+	"schema_version_32": `create table digests (
+    user_id int not null,
+    enabled bool default 'f',
+    email text not null default '',
+    frequency text not null default 'daily',
+    hour int not null default 8,
+    weekday int not null default 1,
+    category_ids bigint[] not null default '{}',
+    mark_as_read bool default 'f',
+    last_entry_id bigint not null default 0,
+    last_sent_at timestamp with time zone,
+    primary key(user_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
+`,
+	"schema_version_33": `create table published_feeds (
+    id serial not null,
+    user_id int not null,
+    token text not null,
+    title text not null,
+    source text not null,
+    category_id int,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(token),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
+`,
+	"schema_version_34": `alter table entries add column share_code text not null default '';
+create unique index entries_share_code_idx on entries using btree(share_code) where share_code <> '';
+`,
+	"schema_version_35": `alter table integrations add column newsletter_token text not null default '';
+create unique index integrations_newsletter_token_idx on integrations using btree(newsletter_token) where newsletter_token <> '';
+
+create table newsletter_feeds (
+    user_id int not null,
+    sender text not null,
+    feed_id int,
+    primary key(user_id, sender),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete set null
+);
+
+create table newsletter_attachments (
+    token text not null,
+    user_id int not null,
+    feed_id int not null,
+    filename text not null,
+    mime_type text not null,
+    content bytea not null,
+    primary key(token),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
+`,
+	"schema_version_36": `alter table feeds add column item_selector text not null default '';
+alter table feeds add column title_selector text not null default '';
+alter table feeds add column link_selector text not null default '';
+alter table feeds add column date_selector text not null default '';
+alter table feeds add column content_selector text not null default '';
+`,
+	"schema_version_37": `alter table feeds add column podcast jsonb;
+alter table entries add column podcast jsonb;
+`,
+	"schema_version_38": `alter table enclosures add column media_progression int not null default 0;
+alter table enclosures add column played bool not null default 'f';
+`,
+	"schema_version_39": `create table opml_subscriptions (
+    id serial not null,
+    user_id int not null,
+    url text not null,
+    remove_missing bool not null default 'f',
+    checked_at timestamp with time zone,
+    error_msg text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(user_id, url),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table opml_subscription_changes (
+    id serial not null,
+    subscription_id int not null,
+    action text not null,
+    feed_url text not null,
+    title text not null default '',
+    category text not null default '',
+    details text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    foreign key (subscription_id) references opml_subscriptions(id) on delete cascade
+);
+
+create index opml_subscription_changes_subscription_idx on opml_subscription_changes(subscription_id);
+
+alter table feeds add column opml_subscription_id int;
+alter table feeds add foreign key (opml_subscription_id) references opml_subscriptions(id) on delete set null;
+`,
 	"schema_version_4": `create type entry_sorting_direction as enum('asc', 'desc');
 alter table users add column entry_direction entry_sorting_direction default 'asc';
+`,
+	"schema_version_40": `alter table users add column search_language text not null default '';
+alter table feeds add column language text not null default '';
+alter table feeds add column search_language text not null default '';
+alter table feeds add column document_configuration regconfig not null default 'simple';
+
+update feeds set document_configuration = (
+    select
+        case split_part(u.language, '_', 1)
+            when 'de' then 'german'
+            when 'en' then 'english'
+            when 'es' then 'spanish'
+            when 'fr' then 'french'
+            when 'it' then 'italian'
+            when 'nl' then 'dutch'
+            when 'ru' then 'russian'
+            else 'simple'
+        end
+    from users u
+    where u.id = feeds.user_id
+)::regconfig;
+
+update entries set document_vectors =
+    setweight(to_tsvector(f.document_configuration, substring(coalesce(entries.title, '') for 1000000)), 'A') ||
+    setweight(to_tsvector(f.document_configuration, substring(coalesce(entries.content, '') for 1000000)), 'B')
+from feeds f
+where f.id = entries.feed_id;
+`,
+	"schema_version_41": `create table saved_searches (
+    id serial not null,
+    user_id int not null,
+    title text not null,
+    query text not null,
+    status text not null default '',
+    category_id int,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(user_id, title),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete set null
+);
+`,
+	"schema_version_42": `create table entry_notes (
+    entry_id bigint not null,
+    user_id int not null,
+    content text not null,
+    created_at timestamp with time zone not null default now(),
+    changed_at timestamp with time zone not null default now(),
+    primary key(entry_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create table entry_highlights (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    text text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index entry_highlights_entry_idx on entry_highlights(entry_id);
+`,
+	"schema_version_43": `alter table entries add column snoozed_until timestamp with time zone;
+create index entries_snoozed_until_idx on entries(snoozed_until) where snoozed_until is not null;
+`,
+	"schema_version_44": `alter table feeds add column archive_read_days int not null default 0;
+alter table feeds add column max_entries int not null default 0;
+alter table feeds add column mark_as_read_days int not null default 0;
+
+alter table categories add column archive_read_days int not null default 0;
+alter table categories add column max_entries int not null default 0;
+alter table categories add column mark_as_read_days int not null default 0;
+`,
+	"schema_version_45": `alter table entries add column tags text[] not null default '{}';
+create index entries_tags_idx on entries using gin(tags);
+
+alter table published_feeds add column tag text not null default '';
+`,
+	"schema_version_46": `create table secrets (
+    name text not null,
+    value bytea not null,
+    primary key(name)
+);
+`,
+	"schema_version_47": `alter table entries add column imported bool not null default false;
+`,
+	"schema_version_48": `alter table entries add column woken_at timestamp with time zone;
+`,
+	"schema_version_49": `alter table enclosures add column chapters_url text not null default '';
+alter table enclosures add column chapters jsonb;
+alter table enclosures add column transcripts jsonb;
+update enclosures set
+    chapters_url = coalesce(e.podcast->>'chapters_url', ''),
+    chapters = e.podcast->'chapters',
+    transcripts = e.podcast->'transcripts'
+from entries e
+where e.id = enclosures.entry_id and e.podcast is not null and (enclosures.mime_type like 'audio/%' or enclosures.mime_type like 'video/%');
+update entries set podcast = podcast - 'chapters_url' - 'chapters' - 'transcripts' where podcast is not null;
+create index enclosures_missing_chapters_idx on enclosures(id) where chapters_url <> '' and chapters is null;
 `,
 	"schema_version_5": `create table integrations (
     user_id int not null,
@@ -211,8 +471,31 @@ var SqlMapChecksums = map[string]string{
 	"schema_version_24": "1224754c5b9c6b4038599852bbe72656d21b09cb018d3970bd7c00f0019845bf",
 	"schema_version_25": "5262d2d4c88d637b6603a1fcd4f68ad257bd59bd1adf89c58a18ee87b12050d7",
 	"schema_version_26": "64f14add40691f18f514ac0eed10cd9b19c83a35e5c3d8e0bce667e0ceca9094",
+	"schema_version_27": "2571e185ba55db06658f1f7948ea2d46f3a196df212924133657a10bce3528ec",
+	"schema_version_28": "ffc96d684db3a5aac1063f9ff379c00a32eb2eb17df8d209db2f5dff2b766969",
+	"schema_version_29": "b8447434ced3797f6db254258f480d0f555a0437afe65350fe343019731a242c",
 	"schema_version_3":  "a54745dbc1c51c000f74d4e5068f1e2f43e83309f023415b1749a47d5c1e0f12",
+	"schema_version_30": "b34fdb0d0b5913a1932e66a534e0c0ef1cb21dfd66a98c3cf2969865e77ded05",
+	"schema_version_31": "40fd924993771251eac6eec9d221e32d71c37a152179c1d677f44c48b61a9903",
+	"schema_version_32": "3e7fff0680842a573ab3305219ff432a9ea648e5e921edacc98e3d9c9d5ddef7",
+	"schema_version_33": "c06a4bb04be60071b4090722d970ab37cae0ae54f727e1a8bc1e822a480faa1a",
+	"schema_version_34": "a64b5ba0b37fe3f209617b7d0e4dd05018d2b8362d2c9c528ba8cce19b77e326",
+	"schema_version_35": "9f2739ad8eab97ffc65ffd8cad79431730993c7d925742f15e6d19899dfb9de7",
+	"schema_version_36": "efbcdf1ce489c87316ec826218fa4074393c74378c88a57dfb8c9eeff9a08959",
+	"schema_version_37": "6f90b22a3952abc6943be2fd4d24c2a4d87c6469080bc2c80764e78421e388d8",
+	"schema_version_38": "fd85a0f9217657fba4b9c18d53e1a657b2bf9941466b83f5a88cde82dd8373be",
+	"schema_version_39": "e5d6deca0679f596c7f39a2fdc6f005dcdfa02605b0af9bc838ea9253a14bbb7",
 	"schema_version_4":  "216ea3a7d3e1704e40c797b5dc47456517c27dbb6ca98bf88812f4f63d74b5d9",
+	"schema_version_40": "b7506f78aa75142e6fb9e568a8099c1bc0ded56687c1bbe582dccbc559fd538b",
+	"schema_version_41": "b2d68404e41dd101aa3d521de2254daf484bc6095eb2967ae07e2cc0ef9db989",
+	"schema_version_42": "514f918043ad1ff022a2f3466d1cf43899b12406fec2d859919a5dc18cf4e622",
+	"schema_version_43": "5c729dce9013327ba920f4a75ac3cb7b8444f4e43b33ca6de16d462fe698d54c",
+	"schema_version_44": "2c5ac4cec281bbe0dd4f03e46380a5797eb66abf043c335125c1d06112420d7b",
+	"schema_version_45": "3e54208dbdee352499bec2351e660ef6ffbc5b909b29645e84d508888dbd6628",
+	"schema_version_46": "f6eee06c1c2ff79545aa141846a4ca8eae8bed7102275aaf905a11296c25ba6f",
+	"schema_version_47": "47a39b5365e5856b52b48569ebf91b37ea70ef522d2705e96d49b81e6090c3c3",
+	"schema_version_48": "5b5d0d7183c34cac58d375d02243fa5e620e3333c087c79d5a8a0a1b327e8d05",
+	"schema_version_49": "f30acab45b31476ad4d698630427dc5c62e3754723204c0c562e185695f47b91",
 	"schema_version_5":  "46397e2f5f2c82116786127e9f6a403e975b14d2ca7b652a48cd1ba843e6a27c",
 	"schema_version_6":  "9d05b4fb223f0e60efc716add5048b0ca9c37511cf2041721e20505d6d798ce4",
 	"schema_version_7":  "33f298c9aa30d6de3ca28e1270df51c2884d7596f1283a75716e2aeb634cd05c",
@@ -0,0 +1,5 @@
+alter table integrations add column webhook_enabled bool default 'f';
+alter table integrations add column webhook_url text default '';
+alter table integrations add column webhook_secret text default '';
+alter table integrations add column webhook_new_entries bool default 'f';
+alter table integrations add column webhook_save_entry bool default 'f';
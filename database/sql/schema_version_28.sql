@@ -0,0 +1,20 @@
+create type integration_delivery_status as enum('pending', 'processing', 'success', 'failed');
+
+create table integration_deliveries (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    service text not null,
+    status integration_delivery_status not null default 'pending',
+    attempts int not null default 0,
+    error_msg text not null default '',
+    next_attempt_at timestamp with time zone not null default now(),
+    created_at timestamp with time zone not null default now(),
+    updated_at timestamp with time zone not null default now(),
+    primary key (id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index integration_deliveries_status_idx on integration_deliveries(status, next_attempt_at);
+create index integration_deliveries_user_idx on integration_deliveries(user_id, created_at);
@@ -0,0 +1,10 @@
+create table integration_rules (
+    user_id int not null,
+    service text not null,
+    on_bookmark bool not null default 'f',
+    feed_ids bigint[] not null default '{}',
+    category_ids bigint[] not null default '{}',
+    keywords text not null default '',
+    primary key (user_id, service),
+    foreign key (user_id) references users(id) on delete cascade
+);
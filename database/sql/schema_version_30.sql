@@ -0,0 +1,10 @@
+alter table integrations add column linkding_enabled bool default 'f';
+alter table integrations add column linkding_url text default '';
+alter table integrations add column linkding_api_key text default '';
+alter table integrations add column linkding_tags text default '';
+alter table integrations add column linkding_mark_as_unread bool default 'f';
+alter table integrations add column shaarli_enabled bool default 'f';
+alter table integrations add column shaarli_url text default '';
+alter table integrations add column shaarli_api_secret text default '';
+alter table integrations add column shaarli_tags text default '';
+alter table integrations add column shaarli_private bool default 'f';
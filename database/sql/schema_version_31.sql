@@ -0,0 +1,8 @@
+alter table feeds add column notify bool default 'f';
+alter table integrations add column matrix_enabled bool default 'f';
+alter table integrations add column matrix_url text default '';
+alter table integrations add column matrix_access_token text default '';
+alter table integrations add column matrix_room_id text default '';
+alter table integrations add column telegram_enabled bool default 'f';
+alter table integrations add column telegram_bot_token text default '';
+alter table integrations add column telegram_chat_id text default '';
@@ -0,0 +1,14 @@
+create table digests (
+    user_id int not null,
+    enabled bool default 'f',
+    email text not null default '',
+    frequency text not null default 'daily',
+    hour int not null default 8,
+    weekday int not null default 1,
+    category_ids bigint[] not null default '{}',
+    mark_as_read bool default 'f',
+    last_entry_id bigint not null default 0,
+    last_sent_at timestamp with time zone,
+    primary key(user_id),
+    foreign key (user_id) references users(id) on delete cascade
+);
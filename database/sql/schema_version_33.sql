@@ -0,0 +1,13 @@
+create table published_feeds (
+    id serial not null,
+    user_id int not null,
+    token text not null,
+    title text not null,
+    source text not null,
+    category_id int,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(token),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete cascade
+);
@@ -0,0 +1,23 @@
+alter table integrations add column newsletter_token text not null default '';
+create unique index integrations_newsletter_token_idx on integrations using btree(newsletter_token) where newsletter_token <> '';
+
+create table newsletter_feeds (
+    user_id int not null,
+    sender text not null,
+    feed_id int,
+    primary key(user_id, sender),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete set null
+);
+
+create table newsletter_attachments (
+    token text not null,
+    user_id int not null,
+    feed_id int not null,
+    filename text not null,
+    mime_type text not null,
+    content bytea not null,
+    primary key(token),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (feed_id) references feeds(id) on delete cascade
+);
@@ -0,0 +1,5 @@
+alter table feeds add column item_selector text not null default '';
+alter table feeds add column title_selector text not null default '';
+alter table feeds add column link_selector text not null default '';
+alter table feeds add column date_selector text not null default '';
+alter table feeds add column content_selector text not null default '';
@@ -0,0 +1,2 @@
+alter table feeds add column podcast jsonb;
+alter table entries add column podcast jsonb;
@@ -0,0 +1,2 @@
+alter table enclosures add column media_progression int not null default 0;
+alter table enclosures add column played bool not null default 'f';
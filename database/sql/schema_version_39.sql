@@ -0,0 +1,30 @@
+create table opml_subscriptions (
+    id serial not null,
+    user_id int not null,
+    url text not null,
+    remove_missing bool not null default 'f',
+    checked_at timestamp with time zone,
+    error_msg text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(user_id, url),
+    foreign key (user_id) references users(id) on delete cascade
+);
+
+create table opml_subscription_changes (
+    id serial not null,
+    subscription_id int not null,
+    action text not null,
+    feed_url text not null,
+    title text not null default '',
+    category text not null default '',
+    details text not null default '',
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    foreign key (subscription_id) references opml_subscriptions(id) on delete cascade
+);
+
+create index opml_subscription_changes_subscription_idx on opml_subscription_changes(subscription_id);
+
+alter table feeds add column opml_subscription_id int;
+alter table feeds add foreign key (opml_subscription_id) references opml_subscriptions(id) on delete set null;
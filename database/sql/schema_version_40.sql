@@ -0,0 +1,26 @@
+alter table users add column search_language text not null default '';
+alter table feeds add column language text not null default '';
+alter table feeds add column search_language text not null default '';
+alter table feeds add column document_configuration regconfig not null default 'simple';
+
+update feeds set document_configuration = (
+    select
+        case split_part(u.language, '_', 1)
+            when 'de' then 'german'
+            when 'en' then 'english'
+            when 'es' then 'spanish'
+            when 'fr' then 'french'
+            when 'it' then 'italian'
+            when 'nl' then 'dutch'
+            when 'ru' then 'russian'
+            else 'simple'
+        end
+    from users u
+    where u.id = feeds.user_id
+)::regconfig;
+
+update entries set document_vectors =
+    setweight(to_tsvector(f.document_configuration, substring(coalesce(entries.title, '') for 1000000)), 'A') ||
+    setweight(to_tsvector(f.document_configuration, substring(coalesce(entries.content, '') for 1000000)), 'B')
+from feeds f
+where f.id = entries.feed_id;
@@ -0,0 +1,13 @@
+create table saved_searches (
+    id serial not null,
+    user_id int not null,
+    title text not null,
+    query text not null,
+    status text not null default '',
+    category_id int,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    unique(user_id, title),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (category_id) references categories(id) on delete set null
+);
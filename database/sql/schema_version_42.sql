@@ -0,0 +1,23 @@
+create table entry_notes (
+    entry_id bigint not null,
+    user_id int not null,
+    content text not null,
+    created_at timestamp with time zone not null default now(),
+    changed_at timestamp with time zone not null default now(),
+    primary key(entry_id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create table entry_highlights (
+    id bigserial not null,
+    user_id int not null,
+    entry_id bigint not null,
+    text text not null,
+    created_at timestamp with time zone not null default now(),
+    primary key(id),
+    foreign key (user_id) references users(id) on delete cascade,
+    foreign key (entry_id) references entries(id) on delete cascade
+);
+
+create index entry_highlights_entry_idx on entry_highlights(entry_id);
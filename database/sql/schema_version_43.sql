@@ -0,0 +1,2 @@
+alter table entries add column snoozed_until timestamp with time zone;
+create index entries_snoozed_until_idx on entries(snoozed_until) where snoozed_until is not null;
@@ -0,0 +1,7 @@
+alter table feeds add column archive_read_days int not null default 0;
+alter table feeds add column max_entries int not null default 0;
+alter table feeds add column mark_as_read_days int not null default 0;
+
+alter table categories add column archive_read_days int not null default 0;
+alter table categories add column max_entries int not null default 0;
+alter table categories add column mark_as_read_days int not null default 0;
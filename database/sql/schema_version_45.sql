@@ -0,0 +1,4 @@
+alter table entries add column tags text[] not null default '{}';
+create index entries_tags_idx on entries using gin(tags);
+
+alter table published_feeds add column tag text not null default '';
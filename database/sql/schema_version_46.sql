@@ -0,0 +1,5 @@
+create table secrets (
+    name text not null,
+    value bytea not null,
+    primary key(name)
+);
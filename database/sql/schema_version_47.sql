@@ -0,0 +1 @@
+alter table entries add column imported bool not null default false;
@@ -0,0 +1 @@
+alter table entries add column woken_at timestamp with time zone;
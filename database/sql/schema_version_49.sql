@@ -0,0 +1,11 @@
+alter table enclosures add column chapters_url text not null default '';
+alter table enclosures add column chapters jsonb;
+alter table enclosures add column transcripts jsonb;
+update enclosures set
+    chapters_url = coalesce(e.podcast->>'chapters_url', ''),
+    chapters = e.podcast->'chapters',
+    transcripts = e.podcast->'transcripts'
+from entries e
+where e.id = enclosures.entry_id and e.podcast is not null and (enclosures.mime_type like 'audio/%' or enclosures.mime_type like 'video/%');
+update entries set podcast = podcast - 'chapters_url' - 'chapters' - 'transcripts' where podcast is not null;
+create index enclosures_missing_chapters_idx on enclosures(id) where chapters_url <> '' and chapters is null;
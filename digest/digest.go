@@ -0,0 +1,95 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package digest // import "miniflux.app/digest"
+
+import (
+	"time"
+
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/mailer"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+	"miniflux.app/template"
+)
+
+// SendDueDigests sends the digests scheduled before the given date.
+func SendDueDigests(store *storage.Storage, now time.Time) {
+	digests, err := store.Digests()
+	if err != nil {
+		logger.Error("[Digest] %v", err)
+		return
+	}
+
+	for _, digest := range digests {
+		if !digest.IsDue(now) {
+			continue
+		}
+
+		logger.Debug("[Digest] Sending digest to UserID #%d", digest.UserID)
+		if err := Send(store, digest); err != nil {
+			logger.Error("[Digest] UserID #%d: %v", digest.UserID, err)
+		}
+	}
+}
+
+// Send renders the unread entries received since the last digest and sends them by email.
+// When there are more than DigestMaxEntries entries, the remaining ones are sent with the next digest.
+func Send(store *storage.Storage, digest *model.Digest) error {
+	builder := store.NewEntryQueryBuilder(digest.UserID)
+	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithCategoryIDs(digest.CategoryIDs)
+	builder.AfterEntryID(digest.LastEntryID)
+	builder.WithOrder("e.id")
+	builder.WithDirection("asc")
+	builder.WithLimit(model.DigestMaxEntries)
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		return err
+	}
+
+	entries, lastEntryID := digest.NextBatch(entries)
+
+	if len(entries) == 0 {
+		return store.UpdateDigestDelivery(digest.UserID, 0)
+	}
+
+	printer := locale.NewPrinter(digest.Language)
+	subject := printer.Printf("email.digest.subject", len(entries))
+
+	htmlBody, textBody, err := template.RenderEmail("digest", digest.Language, map[string]interface{}{
+		"subject":    subject,
+		"language":   digest.Language,
+		"entries":    entries,
+		"markAsRead": digest.MarkAsRead,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = mailer.Send(&mailer.Message{
+		To:       digest.Email,
+		Subject:  subject,
+		HTMLBody: htmlBody,
+		TextBody: textBody,
+	})
+	if err != nil {
+		return err
+	}
+
+	entryIDs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		entryIDs = append(entryIDs, entry.ID)
+	}
+
+	if digest.MarkAsRead {
+		if err := store.SetEntriesStatus(digest.UserID, entryIDs, model.EntryStatusRead); err != nil {
+			return err
+		}
+	}
+
+	return store.UpdateDigestDelivery(digest.UserID, lastEntryID)
+}
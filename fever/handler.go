@@ -22,7 +22,7 @@ import (
 
 // Serve handles Fever API calls.
 func Serve(router *mux.Router, store *storage.Storage) {
-	handler := &handler{store}
+	handler := &handler{store: store, savedSearchFeeds: newSavedSearchCache()}
 
 	sr := router.PathPrefix("/fever").Subrouter()
 	sr.Use(newMiddleware(store).serve)
@@ -30,7 +30,8 @@ func Serve(router *mux.Router, store *storage.Storage) {
 }
 
 type handler struct {
-	store *storage.Storage
+	store            *storage.Storage
+	savedSearchFeeds *savedSearchCache
 }
 
 func (h *handler) serve(w http.ResponseWriter, r *http.Request) {
@@ -94,12 +95,19 @@ func (h *handler) handleGroups(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	savedSearchGroups, savedSearchFeedsGroups, err := h.buildSavedSearchGroups(userID, request.UserTimezone(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
 	var result groupsResponse
 	for _, category := range categories {
 		result.Groups = append(result.Groups, group{ID: category.ID, Title: category.Title})
 	}
 
-	result.FeedsGroups = h.buildFeedGroups(feeds)
+	result.Groups = append(result.Groups, savedSearchGroups...)
+	result.FeedsGroups = append(h.buildFeedGroups(feeds), savedSearchFeedsGroups...)
 	result.SetCommonValues()
 	json.OK(w, r, result)
 }
@@ -157,7 +165,13 @@ func (h *handler) handleFeeds(w http.ResponseWriter, r *http.Request) {
 		result.Feeds = append(result.Feeds, subscripion)
 	}
 
-	result.FeedsGroups = h.buildFeedGroups(feeds)
+	_, savedSearchFeedsGroups, err := h.buildSavedSearchGroups(userID, request.UserTimezone(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	result.FeedsGroups = append(h.buildFeedGroups(feeds), savedSearchFeedsGroups...)
 	result.SetCommonValues()
 	json.OK(w, r, result)
 }
@@ -412,20 +426,22 @@ func (h *handler) handleWriteItems(w http.ResponseWriter, r *http.Request) {
 		h.store.SetEntriesStatus(userID, []int64{entryID}, model.EntryStatusUnread)
 	case "saved", "unsaved":
 		logger.Debug("[Fever] Mark entry #%d as saved/unsaved", entryID)
-		if err := h.store.ToggleBookmark(userID, entryID); err != nil {
-			json.ServerError(w, r, err)
-			return
+		saved := r.FormValue("as") == "saved"
+		if entry.Starred == saved {
+			break
 		}
 
-		settings, err := h.store.Integration(userID)
-		if err != nil {
+		if err := h.store.ToggleBookmark(userID, entryID); err != nil {
 			json.ServerError(w, r, err)
 			return
 		}
 
-		go func() {
-			integration.SendEntry(entry, settings)
-		}()
+		// Saved entries are sent to the services configured to receive bookmarks, like in the user interface.
+		if saved {
+			if err := integration.QueueStarredEntry(h.store, userID, entryID); err != nil {
+				logger.Error("[Fever] QueueStarredEntry failed: %v", err)
+			}
+		}
 	}
 
 	json.OK(w, r, newBaseResponse())
@@ -468,6 +484,8 @@ func (h *handler) handleWriteGroups(w http.ResponseWriter, r *http.Request) {
 	groupID := request.FormInt64Value(r, "id")
 	before := time.Unix(request.FormInt64Value(r, "before"), 0)
 
+	timezone := request.UserTimezone(r)
+
 	logger.Debug("[Fever] mark=group, userID=%d, groupID=%d, before=%v", userID, groupID, before)
 
 	if groupID < 0 {
@@ -479,6 +497,8 @@ func (h *handler) handleWriteGroups(w http.ResponseWriter, r *http.Request) {
 
 		if groupID == 0 {
 			err = h.store.MarkAllAsRead(userID)
+		} else if groupID > savedSearchGroupOffset {
+			err = h.markSavedSearchAsRead(userID, groupID-savedSearchGroupOffset, timezone, before)
 		} else {
 			err = h.store.MarkCategoryAsRead(userID, groupID, before)
 		}
@@ -0,0 +1,145 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package fever // import "miniflux.app/fever"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// Saved searches are exposed as groups, their IDs are offset to never collide with category IDs.
+const savedSearchGroupOffset int64 = 1000000000
+
+// Duration during which the feeds of a saved search group are reused instead of running the search again.
+const savedSearchFeedsTTL = 5 * time.Minute
+
+/*
+Fever groups can only contain whole feeds, so a saved search is exposed as a group containing
+the feeds of its matching entries. The items of a saved search group are therefore an approximation
+of the saved search: clients show all the items of these feeds. Marking the group as read only
+marks the entries matching the search.
+
+The feeds of each group are cached for a few minutes because clients request groups and feeds often.
+*/
+func (h *handler) buildSavedSearchGroups(userID int64, timezone string) ([]group, []feedsGroups, error) {
+	savedSearches, err := h.store.SavedSearches(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := make([]group, 0)
+	result := make([]feedsGroups, 0)
+	for _, savedSearch := range savedSearches {
+		feedIDs, err := h.savedSearchFeedIDs(savedSearch, timezone)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		groupID := savedSearchGroupOffset + savedSearch.ID
+		groups = append(groups, group{ID: groupID, Title: savedSearch.Title})
+		result = append(result, feedsGroups{GroupID: groupID, FeedIDs: feedIDs})
+	}
+
+	return groups, result, nil
+}
+
+// savedSearchFeedIDs returns the comma-separated IDs of the feeds having entries matching the saved search.
+func (h *handler) savedSearchFeedIDs(savedSearch *model.SavedSearch, timezone string) (string, error) {
+	key := savedSearchCacheKey(savedSearch, timezone)
+	if feedIDs, found := h.savedSearchFeeds.get(savedSearch.ID, key); found {
+		return feedIDs, nil
+	}
+
+	query, err := savedSearch.SearchQuery(timezone)
+	if err != nil {
+		logger.Error("[Fever] Invalid saved search #%d: %v", savedSearch.ID, err)
+		return "", nil
+	}
+
+	builder := h.store.NewEntryQueryBuilder(savedSearch.UserID)
+	builder.WithSavedSearch(savedSearch, query)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	ids, err := builder.GetFeedIDs()
+	if err != nil {
+		return "", err
+	}
+
+	var feedIDs []string
+	for _, feedID := range ids {
+		feedIDs = append(feedIDs, strconv.FormatInt(feedID, 10))
+	}
+
+	result := strings.Join(feedIDs, ",")
+	h.savedSearchFeeds.set(savedSearch.ID, key, result)
+	return result, nil
+}
+
+func (h *handler) markSavedSearchAsRead(userID, savedSearchID int64, timezone string, before time.Time) error {
+	savedSearch, err := h.store.SavedSearchByID(userID, savedSearchID)
+	if err != nil || savedSearch == nil {
+		return err
+	}
+
+	query, err := savedSearch.SearchQuery(timezone)
+	if err != nil {
+		return err
+	}
+
+	return h.store.MarkSavedSearchAsRead(userID, savedSearch, query, before)
+}
+
+// savedSearchCacheKey changes when the saved search is modified, to not reuse the feeds of the previous search.
+func savedSearchCacheKey(savedSearch *model.SavedSearch, timezone string) string {
+	return fmt.Sprintf("%s\x00%s\x00%d\x00%s", savedSearch.Query, savedSearch.Status, savedSearch.CategoryID, timezone)
+}
+
+type cachedFeedIDs struct {
+	key       string
+	feedIDs   string
+	expiresAt time.Time
+}
+
+// savedSearchCache keeps the feeds of the saved search groups.
+type savedSearchCache struct {
+	mutex sync.Mutex
+	items map[int64]*cachedFeedIDs
+}
+
+func newSavedSearchCache() *savedSearchCache {
+	return &savedSearchCache{items: make(map[int64]*cachedFeedIDs)}
+}
+
+func (c *savedSearchCache) get(savedSearchID int64, key string) (string, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	item, found := c.items[savedSearchID]
+	if !found || item.key != key || time.Now().After(item.expiresAt) {
+		return "", false
+	}
+
+	return item.feedIDs, true
+}
+
+func (c *savedSearchCache) set(savedSearchID int64, key, feedIDs string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	for id, item := range c.items {
+		if now.After(item.expiresAt) {
+			delete(c.items, id)
+		}
+	}
+
+	c.items[savedSearchID] = &cachedFeedIDs{key: key, feedIDs: feedIDs, expiresAt: now.Add(savedSearchFeedsTTL)}
+}
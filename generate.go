@@ -218,5 +218,6 @@ func main() {
 	generateBundle("database/sql.go", "database", "SqlMap", glob("database/sql/*.sql"))
 	generateBundle("template/views.go", "template", "templateViewsMap", glob("template/html/*.html"))
 	generateBundle("template/common.go", "template", "templateCommonMap", glob("template/html/common/*.html"))
+	generateBundle("template/emails.go", "template", "templateEmailsMap", glob("template/html/email/*.html"))
 	generateBundle("locale/translations.go", "locale", "translations", glob("locale/translations/*.json"))
 }
@@ -101,6 +101,16 @@ func (c *Client) Get() (*Response, error) {
 	return c.executeRequest(request)
 }
 
+// Stream execute a GET HTTP request without reading the body, the caller must close the body of the response.
+func (c *Client) Stream() (*http.Response, error) {
+	request, err := c.buildRequest(http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(request)
+}
+
 // PostForm execute a POST HTTP request with form values.
 func (c *Client) PostForm(values url.Values) (*Response, error) {
 	request, err := c.buildRequest(http.MethodPost, strings.NewReader(values.Encode()))
@@ -128,6 +138,22 @@ func (c *Client) PostJSON(data interface{}) (*Response, error) {
 	return c.executeRequest(request)
 }
 
+// PutJSON execute a PUT HTTP request with JSON payload.
+func (c *Client) PutJSON(data interface{}) (*Response, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := c.buildRequest(http.MethodPut, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Add("Content-Type", "application/json")
+	return c.executeRequest(request)
+}
+
 func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[HttpClient] inputURL=%s", c.inputURL))
 
@@ -136,35 +162,11 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 		c.String(),
 	)
 
-	client := c.buildClient()
-	resp, err := client.Do(request)
-	if resp != nil {
-		defer resp.Body.Close()
-	}
-
+	resp, err := c.do(request)
 	if err != nil {
-		if uerr, ok := err.(*url.Error); ok {
-			switch uerr.Err.(type) {
-			case x509.CertificateInvalidError, x509.HostnameError:
-				err = errors.NewLocalizedError(errInvalidCertificate, uerr.Err)
-			case *net.OpError:
-				if uerr.Err.(*net.OpError).Temporary() {
-					err = errors.NewLocalizedError(errTemporaryNetworkOperation, uerr.Err)
-				} else {
-					err = errors.NewLocalizedError(errPermanentNetworkOperation, uerr.Err)
-				}
-			case net.Error:
-				nerr := uerr.Err.(net.Error)
-				if nerr.Timeout() {
-					err = errors.NewLocalizedError(errRequestTimeout, config.Opts.HTTPClientTimeout())
-				} else if nerr.Temporary() {
-					err = errors.NewLocalizedError(errTemporaryNetworkOperation, nerr)
-				}
-			}
-		}
-
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	if resp.ContentLength > config.Opts.HTTPClientMaxBodySize() {
 		return nil, fmt.Errorf("client: response too large (%d bytes)", resp.ContentLength)
@@ -202,6 +204,39 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 	return response, err
 }
 
+// do sends the request, the caller must close the body of the response.
+func (c *Client) do(request *http.Request) (*http.Response, error) {
+	client := c.buildClient()
+	resp, err := client.Do(request)
+	if err != nil {
+		if uerr, ok := err.(*url.Error); ok {
+			switch uerr.Err.(type) {
+			case *errors.LocalizedError:
+				err = uerr.Err
+			case x509.CertificateInvalidError, x509.HostnameError:
+				err = errors.NewLocalizedError(errInvalidCertificate, uerr.Err)
+			case *net.OpError:
+				if uerr.Err.(*net.OpError).Temporary() {
+					err = errors.NewLocalizedError(errTemporaryNetworkOperation, uerr.Err)
+				} else {
+					err = errors.NewLocalizedError(errPermanentNetworkOperation, uerr.Err)
+				}
+			case net.Error:
+				nerr := uerr.Err.(net.Error)
+				if nerr.Timeout() {
+					err = errors.NewLocalizedError(errRequestTimeout, config.Opts.HTTPClientTimeout())
+				} else if nerr.Temporary() {
+					err = errors.NewLocalizedError(errTemporaryNetworkOperation, nerr)
+				}
+			}
+		}
+
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (c *Client) buildRequest(method string, body io.Reader) (*http.Request, error) {
 	c.requestURL = url_helper.RequestURI(c.inputURL)
 	request, err := http.NewRequest(method, c.requestURL, body)
@@ -219,11 +254,14 @@ func (c *Client) buildRequest(method string, body io.Reader) (*http.Request, err
 }
 
 func (c *Client) buildClient() http.Client {
-	client := http.Client{Timeout: time.Duration(config.Opts.HTTPClientTimeout()) * time.Second}
+	transport := NewTransport()
 	if c.Insecure {
-		client.Transport = &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-		}
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	client := http.Client{
+		Timeout:   time.Duration(config.Opts.HTTPClientTimeout()) * time.Second,
+		Transport: transport,
 	}
 
 	return client
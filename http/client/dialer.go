@@ -0,0 +1,213 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/errors"
+)
+
+const errRestrictedAddress = "Access to this network address is not allowed: %s"
+
+// restrictedNetworks contains private, loopback, link-local, multicast and reserved ranges.
+// The cloud metadata services are located in the link-local and shared address ranges.
+// The IPv4-compatible, 6to4 and Teredo ranges embed IPv4 addresses that could be private,
+// IPv4-mapped addresses are verified as IPv4 addresses.
+var restrictedNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/96",
+	"64:ff9b::/96",
+	"2001::/32",
+	"2002::/16",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+// environmentProxy returns the proxy defined by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables.
+var environmentProxy = http.ProxyFromEnvironment
+
+// restrictedTransport verifies the destination of the connections of a transport.
+type restrictedTransport struct {
+	mu sync.Mutex
+
+	// proxyAddresses contains the addresses of the proxies used by this transport,
+	// they are the only restricted addresses it can connect to.
+	proxyAddresses map[string]bool
+}
+
+// NewTransport returns an HTTP transport that refuses to connect to restricted addresses.
+// The addresses are verified after the name resolution and for each redirect.
+// When a proxy is defined in the environment, the destination is verified before sending the request to the proxy.
+func NewTransport() *http.Transport {
+	t := &restrictedTransport{proxyAddresses: make(map[string]bool)}
+
+	return &http.Transport{
+		Proxy:                 t.proxy,
+		DialContext:           t.dialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// proxy returns the proxy of the request after verifying the destination.
+// The proxy resolves the name of the destination again, the verification relies on the same DNS answer.
+func (t *restrictedTransport) proxy(r *http.Request) (*url.URL, error) {
+	proxyURL, err := environmentProxy(r)
+	if err != nil || proxyURL == nil {
+		return proxyURL, err
+	}
+
+	if _, err := resolveAllowedAddresses(r.Context(), r.URL.Hostname()); err != nil {
+		return nil, err
+	}
+
+	// The proxy is configured by the administrator, it can be located on a private network.
+	port := proxyURL.Port()
+	if port == "" {
+		port = "80"
+		if proxyURL.Scheme == "https" {
+			port = "443"
+		}
+	}
+
+	t.mu.Lock()
+	t.proxyAddresses[net.JoinHostPort(proxyURL.Hostname(), port)] = true
+	t.mu.Unlock()
+
+	return proxyURL, nil
+}
+
+func (t *restrictedTransport) isProxyAddress(address string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.proxyAddresses[address]
+}
+
+func (t *restrictedTransport) dialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+
+	if t.isProxyAddress(address) {
+		return dialer.DialContext(ctx, network, address)
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := resolveAllowedAddresses(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	if addresses == nil {
+		return dialer.DialContext(ctx, network, address)
+	}
+
+	// Connect to the verified addresses to prevent another name resolution.
+	var lastErr error
+	for _, address := range addresses {
+		conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(address.IP.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// resolveAllowedAddresses returns the addresses of the host, or an error if one of them is restricted.
+// Hosts of the allowlist are not resolved, nil is returned.
+func resolveAllowedAddresses(ctx context.Context, host string) ([]net.IPAddr, error) {
+	if isAllowedHost(host) {
+		return nil, nil
+	}
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range addresses {
+		if IsRestrictedIP(address.IP) && !isAllowedIP(address.IP) {
+			return nil, errors.NewLocalizedError(errRestrictedAddress, address.IP.String())
+		}
+	}
+
+	return addresses, nil
+}
+
+// IsRestrictedIP returns true if the IP address belongs to a private or reserved network.
+func IsRestrictedIP(ip net.IP) bool {
+	if ipv4 := ip.To4(); ipv4 != nil {
+		ip = ipv4
+	}
+
+	for _, network := range restrictedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isAllowedHost(host string) bool {
+	host = strings.ToLower(strings.Trim(host, "[]"))
+	for _, item := range config.Opts.HTTPClientAllowlist() {
+		if item == host {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isAllowedIP(ip net.IP) bool {
+	for _, item := range config.Opts.HTTPClientAllowlist() {
+		if strings.Contains(item, "/") {
+			if _, network, err := net.ParseCIDR(item); err == nil && network.Contains(ip) {
+				return true
+			}
+		} else if allowedIP := net.ParseIP(item); allowedIP != nil && allowedIP.Equal(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks
+}
@@ -0,0 +1,179 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func parseOptions(t *testing.T, allowlist string) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", allowlist)
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func TestIsRestrictedIP(t *testing.T) {
+	scenarios := map[string]bool{
+		"127.0.0.1":        true,
+		"10.1.2.3":         true,
+		"172.20.0.1":       true,
+		"192.168.1.1":      true,
+		"169.254.169.254":  true,
+		"100.100.100.200":  true,
+		"0.0.0.0":          true,
+		"::1":              true,
+		"fe80::1":          true,
+		"fd00:ec2::254":    true,
+		"::ffff:127.0.0.1": true,
+		"::ffff:10.1.2.3":  true,
+		"::127.0.0.1":      true,
+		"::8.8.8.8":        true,
+		"::":               true,
+		"2002:c0a8:101::1": true,
+		"2001:0:4136::1":   true,
+		"93.184.216.34":    false,
+		"8.8.8.8":          false,
+		"2606:4700::1111":  false,
+		"::ffff:8.8.8.8":   false,
+	}
+
+	for input, expected := range scenarios {
+		if result := IsRestrictedIP(net.ParseIP(input)); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
+
+func TestAllowlist(t *testing.T) {
+	parseOptions(t, "feeds.internal, 10.0.0.0/8, 192.168.1.10")
+
+	if !isAllowedHost("Feeds.Internal") {
+		t.Error(`The hostname should be allowed`)
+	}
+
+	if isAllowedHost("other.internal") {
+		t.Error(`The hostname should not be allowed`)
+	}
+
+	if !isAllowedIP(net.ParseIP("10.20.30.40")) || !isAllowedIP(net.ParseIP("192.168.1.10")) {
+		t.Error(`The addresses should be allowed`)
+	}
+
+	if isAllowedIP(net.ParseIP("192.168.1.11")) || isAllowedIP(net.ParseIP("127.0.0.1")) {
+		t.Error(`The addresses should not be allowed`)
+	}
+}
+
+func TestClientRefusesRestrictedAddresses(t *testing.T) {
+	parseOptions(t, "")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("secret"))
+	}))
+	defer server.Close()
+
+	_, err := New(server.URL).Get()
+	if err == nil || !strings.Contains(err.Error(), "127.0.0.1") {
+		t.Fatalf(`The request should be refused: %v`, err)
+	}
+
+	parseOptions(t, "127.0.0.1")
+
+	response, err := New(server.URL).Get()
+	if err != nil {
+		t.Fatalf(`Allowed addresses should be reachable: %v`, err)
+	}
+
+	if response.BodyAsString() != "secret" {
+		t.Errorf(`Unexpected response body: %q`, response.BodyAsString())
+	}
+}
+
+func TestClientRefusesRedirectsToRestrictedAddresses(t *testing.T) {
+	parseOptions(t, "localhost")
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, server.URL+"/secret", http.StatusFound)
+			return
+		}
+		w.Write([]byte("secret"))
+	}))
+	defer server.Close()
+
+	// The allowed hostname redirects to an IP address which is not allowed.
+	redirectURL := strings.Replace(server.URL, "127.0.0.1", "localhost", 1) + "/redirect"
+	if _, err := New(redirectURL).Get(); err == nil {
+		t.Fatal(`The redirect to a restricted address should be refused`)
+	}
+}
+
+func TestClientVerifiesDestinationBeforeProxy(t *testing.T) {
+	parseOptions(t, "feeds.internal")
+
+	var proxiedURLs []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedURLs = append(proxiedURLs, r.URL.String())
+		w.Write([]byte("proxied"))
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	environmentProxy = http.ProxyURL(proxyURL)
+	defer func() { environmentProxy = http.ProxyFromEnvironment }()
+
+	if _, err := New("http://127.0.0.1:8080/secret").Get(); err == nil || !strings.Contains(err.Error(), "127.0.0.1") {
+		t.Fatalf(`The request should be refused before reaching the proxy: %v`, err)
+	}
+
+	if len(proxiedURLs) != 0 {
+		t.Fatalf(`The proxy should not receive the request: %v`, proxiedURLs)
+	}
+
+	// The proxy itself is on a private network, but it is configured by the administrator.
+	response, err := New("http://feeds.internal/feed.xml").Get()
+	if err != nil {
+		t.Fatalf(`Allowed destinations should be reachable through the proxy: %v`, err)
+	}
+
+	if response.BodyAsString() != "proxied" || len(proxiedURLs) != 1 {
+		t.Errorf(`The request should go through the proxy, got %q`, response.BodyAsString())
+	}
+}
+
+func TestProxyAddressesAreScopedToTheTransport(t *testing.T) {
+	parseOptions(t, "feeds.internal")
+
+	proxyURL, _ := url.Parse("http://127.0.0.1:3128")
+	environmentProxy = http.ProxyURL(proxyURL)
+	defer func() { environmentProxy = http.ProxyFromEnvironment }()
+
+	transport := NewTransport()
+	request, _ := http.NewRequest(http.MethodGet, "http://feeds.internal/feed.xml", nil)
+	if _, err := transport.Proxy(request); err != nil {
+		t.Fatal(err)
+	}
+
+	environmentProxy = func(*http.Request) (*url.URL, error) { return nil, nil }
+
+	// Another transport has not used the proxy, the private address is refused.
+	if _, err := NewTransport().DialContext(request.Context(), "tcp", "127.0.0.1:3128"); err == nil || !strings.Contains(err.Error(), "127.0.0.1") {
+		t.Errorf(`The proxy address should only be allowed for the transport using it: %v`, err)
+	}
+}
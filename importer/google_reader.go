@@ -0,0 +1,128 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/importer"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	googleReaderFeedPrefix     = "feed/"
+	googleReaderStarredSuffix  = "/state/com.google/starred"
+	googleReaderReadSuffix     = "/state/com.google/read"
+	googleReaderReadingSuffix  = "/state/com.google/reading-list"
+	googleReaderLabelSeparator = "/label/"
+)
+
+// Google Reader stream, used by Google Takeout (starred.json) and by FreshRSS for starred entries and labels.
+type googleReaderStream struct {
+	ID    string              `json:"id"`
+	Title string              `json:"title"`
+	Items []*googleReaderItem `json:"items"`
+}
+
+type googleReaderItem struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	Published  int64    `json:"published"`
+	Updated    int64    `json:"updated"`
+	Author     string   `json:"author"`
+	Categories []string `json:"categories"`
+	Canonical  []struct {
+		Href string `json:"href"`
+	} `json:"canonical"`
+	Alternate []struct {
+		Href string `json:"href"`
+	} `json:"alternate"`
+	Content *struct {
+		Content string `json:"content"`
+	} `json:"content"`
+	Summary *struct {
+		Content string `json:"content"`
+	} `json:"summary"`
+	Origin *struct {
+		StreamID string `json:"streamId"`
+		Title    string `json:"title"`
+		HTMLURL  string `json:"htmlUrl"`
+	} `json:"origin"`
+}
+
+func parseGoogleReader(data []byte) ([]*Item, error) {
+	var stream googleReaderStream
+	if err := json.Unmarshal(data, &stream); err != nil {
+		return nil, fmt.Errorf("importer: unable to parse Google Reader export: %v", err)
+	}
+
+	starredStream := strings.HasSuffix(stream.ID, googleReaderStarredSuffix)
+	streamLabel := googleReaderLabel(stream.ID)
+
+	items := make([]*Item, 0, len(stream.Items))
+	for _, entry := range stream.Items {
+		item := &Item{
+			ID:      entry.ID,
+			Title:   entry.Title,
+			Author:  entry.Author,
+			Read:    true,
+			Starred: starredStream,
+		}
+
+		switch {
+		case len(entry.Canonical) > 0:
+			item.URL = entry.Canonical[0].Href
+		case len(entry.Alternate) > 0:
+			item.URL = entry.Alternate[0].Href
+		}
+
+		switch {
+		case entry.Content != nil && entry.Content.Content != "":
+			item.Content = entry.Content.Content
+		case entry.Summary != nil:
+			item.Content = entry.Summary.Content
+		}
+
+		switch {
+		case entry.Published > 0:
+			item.Date = time.Unix(entry.Published, 0)
+		case entry.Updated > 0:
+			item.Date = time.Unix(entry.Updated, 0)
+		default:
+			item.Date = time.Now()
+		}
+
+		if entry.Origin != nil {
+			item.FeedURL = strings.TrimPrefix(entry.Origin.StreamID, googleReaderFeedPrefix)
+			item.FeedTitle = entry.Origin.Title
+			item.SiteURL = entry.Origin.HTMLURL
+		}
+
+		item.Category = streamLabel
+		for _, category := range entry.Categories {
+			switch {
+			case strings.HasSuffix(category, googleReaderStarredSuffix):
+				item.Starred = true
+			case strings.HasSuffix(category, googleReaderReadSuffix), strings.HasSuffix(category, googleReaderReadingSuffix):
+			default:
+				if label := googleReaderLabel(category); label != "" && item.Category == "" {
+					item.Category = label
+				}
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func googleReaderLabel(streamID string) string {
+	if index := strings.Index(streamID, googleReaderLabelSeparator); index >= 0 && strings.HasPrefix(streamID, "user/") {
+		return streamID[index+len(googleReaderLabelSeparator):]
+	}
+
+	return ""
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/importer"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseGoogleTakeoutStarred(t *testing.T) {
+	data := `{
+		"id": "user/01234567890/state/com.google/starred",
+		"title": "Starred items",
+		"items": [
+			{
+				"id": "tag:google.com,2005:reader/item/0001",
+				"title": "First article",
+				"published": 1546300800,
+				"author": "Alice",
+				"categories": ["user/01234567890/state/com.google/starred", "user/01234567890/label/Tech"],
+				"canonical": [{"href": "https://example.org/first"}],
+				"summary": {"content": "<p>Summary</p>"},
+				"origin": {"streamId": "feed/https://example.org/feed.xml", "title": "Example", "htmlUrl": "https://example.org/"}
+			},
+			{
+				"id": "tag:google.com,2005:reader/item/0002",
+				"alternate": [{"href": "https://example.org/second", "type": "text/html"}],
+				"content": {"content": "<p>Content</p>"},
+				"summary": {"content": "<p>Summary</p>"}
+			}
+		]
+	}`
+
+	items, err := parseGoogleReader([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf(`Incorrect number of items, got %d`, len(items))
+	}
+
+	first := items[0]
+	if first.URL != "https://example.org/first" || first.Title != "First article" || first.Author != "Alice" {
+		t.Errorf(`Incorrect item: %+v`, first)
+	}
+
+	if first.FeedURL != "https://example.org/feed.xml" || first.FeedTitle != "Example" || first.SiteURL != "https://example.org/" {
+		t.Errorf(`Incorrect origin: %+v`, first)
+	}
+
+	if first.Category != "Tech" {
+		t.Errorf(`Incorrect category, got %q`, first.Category)
+	}
+
+	if !first.Starred || !first.Read {
+		t.Errorf(`Items of the starred stream should be starred and read`)
+	}
+
+	if !first.Date.Equal(time.Unix(1546300800, 0)) {
+		t.Errorf(`Incorrect date, got %v`, first.Date)
+	}
+
+	if first.Content != "<p>Summary</p>" {
+		t.Errorf(`Incorrect content, got %q`, first.Content)
+	}
+
+	second := items[1]
+	if second.URL != "https://example.org/second" || second.Content != "<p>Content</p>" || second.FeedURL != "" {
+		t.Errorf(`Incorrect item: %+v`, second)
+	}
+}
+
+func TestParseFreshRSSLabel(t *testing.T) {
+	data := `{
+		"id": "user/-/label/Cooking",
+		"items": [
+			{
+				"id": "1",
+				"title": "Recipe",
+				"categories": ["user/-/state/com.google/read"],
+				"alternate": [{"href": "https://example.org/recipe"}],
+				"origin": {"streamId": "feed/https://example.org/feed.xml"}
+			},
+			{
+				"id": "2",
+				"title": "Favorite recipe",
+				"categories": ["user/-/state/com.google/starred"]
+			}
+		]
+	}`
+
+	items, err := parseGoogleReader([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if items[0].Category != "Cooking" || items[0].Starred {
+		t.Errorf(`Incorrect item: %+v`, items[0])
+	}
+
+	if !items[1].Starred {
+		t.Errorf(`The item should be starred`)
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/importer"
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/locale"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/storage"
+)
+
+// Supported export formats.
+const (
+	FormatTTRSS        = "ttrss"
+	FormatGoogleReader = "google_reader"
+)
+
+// Prefix of the URL of the feeds holding the articles of an import whose feed no longer exists.
+const archiveFeedURLPrefix = "archive:"
+
+// Report summarizes what an import changed.
+type Report struct {
+	Format          string `json:"format"`
+	FeedsCreated    int    `json:"feeds_created"`
+	EntriesCreated  int    `json:"entries_created"`
+	EntriesUpdated  int    `json:"entries_updated"`
+	ArchivedEntries int    `json:"archived_entries"`
+	jobs            model.JobList
+}
+
+// Jobs returns the refresh jobs of the feeds created by the import.
+func (r *Report) Jobs() model.JobList {
+	return r.jobs
+}
+
+// Detect returns the format of an export, or an empty string when the data is not a supported export.
+func Detect(data []byte) string {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		if isTTRSSArray(data) {
+			return FormatTTRSS
+		}
+	case bytes.HasPrefix(data, []byte("{")):
+		var document map[string]json.RawMessage
+		if err := json.Unmarshal(data, &document); err != nil {
+			return ""
+		}
+
+		if _, found := document["items"]; found {
+			return FormatGoogleReader
+		}
+
+		if _, found := document["articles"]; found {
+			return FormatTTRSS
+		}
+	}
+
+	return ""
+}
+
+// IsSupported returns true if the data is a supported export, or a zip file containing supported exports.
+func IsSupported(data []byte) bool {
+	for _, document := range documents(data) {
+		if Detect(document) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// documents returns the JSON files of a zip file, like the export of FreshRSS, or the data itself.
+func documents(data []byte) [][]byte {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return [][]byte{data}
+	}
+
+	var documents [][]byte
+	for _, file := range zipReader.File {
+		if !strings.EqualFold(path.Ext(file.Name), ".json") {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			continue
+		}
+
+		content, err := ioutil.ReadAll(reader)
+		reader.Close()
+		if err == nil {
+			documents = append(documents, content)
+		}
+	}
+
+	return documents
+}
+
+// Parse returns the format and the articles of an export, or of all the exports of a zip file.
+func Parse(data []byte) (string, []*Item, error) {
+	var format string
+	var items []*Item
+	for _, document := range documents(data) {
+		if Detect(document) == "" {
+			continue
+		}
+
+		documentFormat, documentItems, err := parseDocument(document)
+		if err != nil {
+			return "", nil, err
+		}
+
+		format = documentFormat
+		items = append(items, documentItems...)
+	}
+
+	if format == "" {
+		return "", nil, errors.New("importer: unsupported export format")
+	}
+
+	return format, items, nil
+}
+
+func parseDocument(data []byte) (string, []*Item, error) {
+	format := Detect(data)
+	switch format {
+	case FormatTTRSS:
+		items, err := parseTTRSS(data)
+		return format, items, err
+	case FormatGoogleReader:
+		items, err := parseGoogleReader(data)
+		return format, items, err
+	}
+
+	return "", nil, errors.New("importer: unsupported export format")
+}
+
+// Handler creates the feeds and the entries of an export.
+type Handler struct {
+	store *storage.Storage
+}
+
+// NewHandler returns a new Handler.
+func NewHandler(store *storage.Storage) *Handler {
+	return &Handler{store: store}
+}
+
+// Import inserts the articles of an export into the feeds they come from.
+//
+// Missing feeds are created without being fetched, the caller must push
+// the jobs of the report to refresh them. The articles that do not
+// indicate their feed go to an archive feed dedicated to this export.
+// Entries are matched by checksum or URL, so importing the same export
+// twice, or articles already fetched, only updates their status.
+func (h *Handler) Import(userID int64, data []byte) (*Report, error) {
+	user, err := h.store.UserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, errors.New("importer: user not found")
+	}
+
+	format, items, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	feeds, err := h.store.Feeds(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &importer{
+		Handler:    h,
+		user:       user,
+		report:     &Report{Format: format},
+		feedIDs:    make(map[string]int64, len(feeds)),
+		archiveURL: archiveFeedURLPrefix + format + ":" + crypto.HashFromBytes(data)[:16],
+	}
+
+	for _, feed := range feeds {
+		i.feedIDs[feed.FeedURL] = feed.ID
+	}
+
+	for _, item := range items {
+		if err := i.importItem(item); err != nil {
+			return i.report, err
+		}
+	}
+
+	return i.report, nil
+}
+
+type importer struct {
+	*Handler
+	user       *model.User
+	report     *Report
+	feedIDs    map[string]int64
+	archiveURL string
+}
+
+func (i *importer) importItem(item *Item) error {
+	feedID, err := i.feedID(item)
+	if err != nil {
+		return err
+	}
+
+	entry := &model.Entry{
+		UserID:  i.user.ID,
+		FeedID:  feedID,
+		Hash:    item.Hash(),
+		Title:   item.Title,
+		URL:     item.URL,
+		Date:    item.Date,
+		Content: sanitizer.Sanitize(item.URL, item.Content),
+		Author:  item.Author,
+		Status:  model.EntryStatusUnread,
+		Starred: item.Starred,
+	}
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	if item.Read {
+		entry.Status = model.EntryStatusRead
+	}
+
+	created, err := i.store.ImportEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case created:
+		i.report.EntriesCreated++
+	default:
+		i.report.EntriesUpdated++
+	}
+
+	if feedID == i.feedIDs[i.archiveURL] {
+		i.report.ArchivedEntries++
+	}
+
+	return nil
+}
+
+func (i *importer) feedID(item *Item) (int64, error) {
+	if item.FeedURL == "" {
+		return i.archiveFeedID()
+	}
+
+	if feedID, found := i.feedIDs[item.FeedURL]; found {
+		return feedID, nil
+	}
+
+	category, err := i.category(item.Category)
+	if err != nil {
+		return 0, err
+	}
+
+	feed := &model.Feed{
+		UserID:   i.user.ID,
+		FeedURL:  item.FeedURL,
+		SiteURL:  item.SiteURL,
+		Title:    item.FeedTitle,
+		Category: category,
+	}
+
+	if feed.SiteURL == "" {
+		feed.SiteURL = feed.FeedURL
+	}
+
+	if feed.Title == "" {
+		feed.Title = feed.SiteURL
+	}
+
+	if err := i.store.CreateFeed(feed); err != nil {
+		return 0, err
+	}
+
+	i.report.FeedsCreated++
+	i.report.jobs = append(i.report.jobs, model.Job{UserID: i.user.ID, FeedID: feed.ID})
+	i.feedIDs[item.FeedURL] = feed.ID
+	return feed.ID, nil
+}
+
+func (i *importer) archiveFeedID() (int64, error) {
+	if feedID := i.feedIDs[i.archiveURL]; feedID > 0 {
+		return feedID, nil
+	}
+
+	category, err := i.category("")
+	if err != nil {
+		return 0, err
+	}
+
+	printer := locale.NewPrinter(i.user.Language)
+	archive := &model.Feed{
+		UserID:   i.user.ID,
+		FeedURL:  i.archiveURL,
+		Title:    printer.Printf("page.import.archive_feed_title", time.Now().Format("2006-01-02")),
+		Disabled: true,
+		Category: category,
+	}
+
+	if err := i.store.CreateFeed(archive); err != nil {
+		return 0, err
+	}
+
+	i.feedIDs[i.archiveURL] = archive.ID
+	return archive.ID, nil
+}
+
+func (i *importer) category(title string) (*model.Category, error) {
+	var category *model.Category
+	var err error
+
+	if title != "" {
+		category, err = i.store.CategoryByTitle(i.user.ID, title)
+		if err != nil {
+			return nil, err
+		}
+
+		if category == nil {
+			category = &model.Category{UserID: i.user.ID, Title: title}
+			if err := i.store.CreateCategory(category); err != nil {
+				return nil, err
+			}
+		}
+
+		return category, nil
+	}
+
+	category, err = i.store.FirstCategory(i.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, errors.New("importer: the user has no category")
+	}
+
+	return category, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/importer"
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+)
+
+func TestDetect(t *testing.T) {
+	scenarios := map[string]string{
+		`[{"title": "a", "marked": 1}]`: FormatTTRSS,
+		` [{"feed_url": "x"}, {}]`:      FormatTTRSS,
+		`[{"title": "a"}]`:              "",
+		`[1, 2]`:                        "",
+		`[]`:                            "",
+		`{"articles": []}`:              FormatTTRSS,
+		`  {"id": "x", "items": []}`:    FormatGoogleReader,
+		`{"version": 1}`:                "",
+		`<?xml version="1.0"?><opml/>`:  "",
+		`not json`:                      "",
+	}
+
+	for input, expected := range scenarios {
+		if result := Detect([]byte(input)); result != expected {
+			t.Errorf(`Unexpected format for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestParseZipFile(t *testing.T) {
+	var buffer bytes.Buffer
+	zipWriter := zip.NewWriter(&buffer)
+	files := map[string]string{
+		"feeds.opml":        `<opml/>`,
+		"starred.json":      `{"id": "user/-/state/com.google/starred", "items": [{"id": "1", "title": "A"}]}`,
+		"feed_example.json": `{"id": "feed/https://example.org/", "items": [{"id": "2", "title": "B"}, {"id": "3", "title": "C"}]}`,
+	}
+
+	for name, content := range files {
+		file, _ := zipWriter.Create(name)
+		file.Write([]byte(content))
+	}
+	zipWriter.Close()
+
+	if !IsSupported(buffer.Bytes()) {
+		t.Fatal(`The zip file should be supported`)
+	}
+
+	format, items, err := Parse(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if format != FormatGoogleReader || len(items) != 3 {
+		t.Errorf(`Unexpected result: %q, %d items`, format, len(items))
+	}
+}
+
+func TestParseUnsupportedFormat(t *testing.T) {
+	if IsSupported([]byte(`<opml/>`)) {
+		t.Error(`An OPML file is not supported by the importer`)
+	}
+
+	if _, _, err := Parse([]byte(`{"version": 1}`)); err == nil {
+		t.Error(`An unknown document should be rejected`)
+	}
+}
+
+func TestItemHash(t *testing.T) {
+	withURL := &Item{ID: "1", URL: "https://example.org/"}
+	withID := &Item{ID: "1"}
+	if withURL.Hash() == withID.Hash() {
+		t.Error(`The URL should be used before the identifier`)
+	}
+
+	if withID.Hash() != (&Item{ID: "1", Title: "other"}).Hash() {
+		t.Error(`The identifier should be used when there is no URL`)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/importer"
+
+import (
+	"time"
+
+	"miniflux.app/crypto"
+)
+
+// Item represents an article found in an export, with the feed it comes from.
+type Item struct {
+	ID        string
+	FeedURL   string
+	FeedTitle string
+	SiteURL   string
+	Category  string
+	Title     string
+	URL       string
+	Content   string
+	Author    string
+	Date      time.Time
+	Read      bool
+	Starred   bool
+}
+
+// Hash returns the checksum used to find the entry when the export is imported again.
+// The checksum of the URL lets the refreshes of the feed find the imported entry.
+func (i *Item) Hash() string {
+	for _, value := range []string{i.URL, i.ID} {
+		if value != "" {
+			return crypto.Hash(value)
+		}
+	}
+
+	return crypto.Hash(i.Title + i.Content)
+}
@@ -0,0 +1,126 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/importer"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"miniflux.app/reader/date"
+)
+
+// Article exported by the data migration plugin of Tiny Tiny RSS.
+type ttrssArticle struct {
+	GUID      string    `json:"guid"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	Link      string    `json:"link"`
+	Author    string    `json:"author"`
+	Updated   string    `json:"updated"`
+	Marked    ttrssBool `json:"marked"`
+	Unread    ttrssBool `json:"unread"`
+	FeedURL   string    `json:"feed_url"`
+	FeedTitle string    `json:"feed_title"`
+	SiteURL   string    `json:"site_url"`
+	Category  string    `json:"feed_category"`
+}
+
+type ttrssExport struct {
+	Articles []*ttrssArticle `json:"articles"`
+}
+
+// Keys of the articles exported by Tiny Tiny RSS that are not found in other JSON exports.
+var ttrssArticleKeys = []string{"marked", "unread", "published", "score", "feed_url", "feed_title", "feed_category", "tag_cache", "label_cache"}
+
+// isTTRSSArray returns true if the first element of a JSON array looks like an article exported by Tiny Tiny RSS.
+func isTTRSSArray(data []byte) bool {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return false
+	}
+
+	var article map[string]json.RawMessage
+	if !decoder.More() || decoder.Decode(&article) != nil {
+		return false
+	}
+
+	for _, key := range ttrssArticleKeys {
+		if _, found := article[key]; found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Tiny Tiny RSS exports booleans as JSON booleans, numbers or database strings depending on the version.
+type ttrssBool bool
+
+func (b *ttrssBool) UnmarshalJSON(data []byte) error {
+	switch strings.ToLower(strings.Trim(string(data), `"`)) {
+	case "true", "t", "1":
+		*b = true
+	case "false", "f", "0", "", "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean value: %s", data)
+	}
+
+	return nil
+}
+
+func parseTTRSS(data []byte) ([]*Item, error) {
+	var articles []*ttrssArticle
+	var err error
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		err = json.Unmarshal(data, &articles)
+	} else {
+		var export ttrssExport
+		err = json.Unmarshal(data, &export)
+		articles = export.Articles
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("importer: unable to parse Tiny Tiny RSS export: %v", err)
+	}
+
+	items := make([]*Item, 0, len(articles))
+	for _, article := range articles {
+		item := &Item{
+			ID:        article.GUID,
+			FeedURL:   article.FeedURL,
+			FeedTitle: article.FeedTitle,
+			SiteURL:   article.SiteURL,
+			Category:  article.Category,
+			Title:     article.Title,
+			URL:       article.Link,
+			Content:   article.Content,
+			Author:    article.Author,
+			Date:      parseTTRSSDate(article.Updated),
+			Read:      !bool(article.Unread),
+			Starred:   bool(article.Marked),
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func parseTTRSSDate(value string) time.Time {
+	if t, err := time.Parse("2006-01-02 15:04:05", value); err == nil {
+		return t
+	}
+
+	if t, err := date.Parse(value); err == nil {
+		return t
+	}
+
+	return time.Now()
+}
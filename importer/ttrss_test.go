@@ -0,0 +1,81 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/importer"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTTRSSArray(t *testing.T) {
+	data := `[
+		{
+			"guid": "{\"ver\":2,\"uid\":\"1\",\"hash\":\"SHA1:abc\"}",
+			"title": "Article",
+			"content": "<p>Hello</p>",
+			"link": "https://example.org/article",
+			"author": "Bob",
+			"updated": "2019-03-04 05:06:07",
+			"marked": "t",
+			"unread": "f",
+			"feed_url": "https://example.org/feed.xml",
+			"feed_title": "Example"
+		},
+		{
+			"title": "Unread",
+			"link": "https://example.org/unread",
+			"marked": 0,
+			"unread": true
+		}
+	]`
+
+	items, err := parseTTRSS([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf(`Incorrect number of items, got %d`, len(items))
+	}
+
+	first := items[0]
+	if first.Title != "Article" || first.URL != "https://example.org/article" || first.Author != "Bob" || first.Content != "<p>Hello</p>" {
+		t.Errorf(`Incorrect item: %+v`, first)
+	}
+
+	if first.FeedURL != "https://example.org/feed.xml" || first.FeedTitle != "Example" {
+		t.Errorf(`Incorrect feed: %+v`, first)
+	}
+
+	if !first.Starred || !first.Read {
+		t.Errorf(`The first item should be starred and read`)
+	}
+
+	if !first.Date.Equal(time.Date(2019, 3, 4, 5, 6, 7, 0, time.UTC)) {
+		t.Errorf(`Incorrect date, got %v`, first.Date)
+	}
+
+	if items[1].Starred || items[1].Read {
+		t.Errorf(`The second item should be unread and not starred`)
+	}
+}
+
+func TestParseTTRSSObject(t *testing.T) {
+	data := `{"articles": [{"title": "Article", "link": "https://example.org/", "marked": true, "unread": 0}]}`
+	items, err := parseTTRSS([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 || !items[0].Starred || !items[0].Read {
+		t.Errorf(`Incorrect items: %+v`, items)
+	}
+}
+
+func TestParseTTRSSWithInvalidBoolean(t *testing.T) {
+	if _, err := parseTTRSS([]byte(`[{"marked": "maybe"}]`)); err == nil {
+		t.Error(`An invalid boolean should be rejected`)
+	}
+}
@@ -5,40 +5,188 @@
 package integration // import "miniflux.app/integration"
 
 import (
+	"fmt"
+	"strings"
+
 	"miniflux.app/config"
 	"miniflux.app/integration/instapaper"
+	"miniflux.app/integration/linkding"
+	"miniflux.app/integration/matrix"
 	"miniflux.app/integration/nunuxkeeper"
 	"miniflux.app/integration/pinboard"
 	"miniflux.app/integration/pocket"
+	"miniflux.app/integration/shaarli"
+	"miniflux.app/integration/telegram"
 	"miniflux.app/integration/wallabag"
+	"miniflux.app/integration/webhook"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/storage"
+)
+
+// Services that can receive saved entries.
+const (
+	ServicePinboard    = "pinboard"
+	ServiceInstapaper  = "instapaper"
+	ServiceWallabag    = "wallabag"
+	ServiceNunuxKeeper = "nunux_keeper"
+	ServicePocket      = "pocket"
+	ServiceLinkding    = "linkding"
+	ServiceShaarli     = "shaarli"
+	ServiceWebhook     = "webhook"
 )
 
-// SendEntry send the entry to the activated providers.
-func SendEntry(entry *model.Entry, integration *model.Integration) {
+// AutomaticServices returns the services supporting automatic delivery rules.
+func AutomaticServices() []string {
+	return []string{ServicePinboard, ServiceInstapaper, ServicePocket, ServiceWallabag, ServiceNunuxKeeper, ServiceLinkding, ServiceShaarli}
+}
+
+// EnabledServices returns the services activated by the user to receive saved entries.
+func EnabledServices(integration *model.Integration) []string {
+	var services []string
+
 	if integration.PinboardEnabled {
-		client := pinboard.NewClient(integration.PinboardToken)
-		err := client.AddBookmark(
-			entry.URL,
-			entry.Title,
-			integration.PinboardTags,
-			integration.PinboardMarkAsUnread,
-		)
+		services = append(services, ServicePinboard)
+	}
 
-		if err != nil {
-			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+	if integration.InstapaperEnabled {
+		services = append(services, ServiceInstapaper)
+	}
+
+	if integration.WallabagEnabled {
+		services = append(services, ServiceWallabag)
+	}
+
+	if integration.NunuxKeeperEnabled {
+		services = append(services, ServiceNunuxKeeper)
+	}
+
+	if integration.PocketEnabled {
+		services = append(services, ServicePocket)
+	}
+
+	if integration.LinkdingEnabled {
+		services = append(services, ServiceLinkding)
+	}
+
+	if integration.ShaarliEnabled {
+		services = append(services, ServiceShaarli)
+	}
+
+	if integration.WebhookEnabled && integration.WebhookSaveEntry {
+		services = append(services, ServiceWebhook)
+	}
+
+	return services
+}
+
+// QueueEntry queues the entry for delivery to the activated providers.
+func QueueEntry(store *storage.Storage, entry *model.Entry, integration *model.Integration) error {
+	return store.CreateIntegrationDeliveries(entry.UserID, entry.ID, EnabledServices(integration))
+}
+
+// QueueStarredEntry queues a newly starred entry for the providers configured to receive bookmarks automatically.
+func QueueStarredEntry(store *storage.Storage, userID, entryID int64) error {
+	builder := store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		return err
+	}
+
+	if entry == nil || !entry.Starred {
+		return nil
+	}
+
+	settings, err := store.Integration(userID)
+	if err != nil {
+		return err
+	}
+
+	rules, err := store.IntegrationRules(userID)
+	if err != nil {
+		return err
+	}
+
+	var services []string
+	for _, service := range EnabledServices(settings) {
+		if rules.ForService(service).OnBookmark {
+			services = append(services, service)
 		}
 	}
 
-	if integration.InstapaperEnabled {
-		client := instapaper.NewClient(integration.InstapaperUsername, integration.InstapaperPassword)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
-			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
+	return store.CreateIntegrationDeliveries(userID, entryID, services)
+}
+
+// QueueNewEntries queues the new entries of a feed matching the automatic delivery rules of the user.
+func QueueNewEntries(store *storage.Storage, feed *model.Feed, entries model.Entries, integration *model.Integration) error {
+	rules, err := store.IntegrationRules(feed.UserID)
+	if err != nil {
+		return err
+	}
+
+	var categoryID int64
+	if feed.Category != nil {
+		categoryID = feed.Category.ID
+	}
+
+	enabledServices := EnabledServices(integration)
+	for _, entry := range entries {
+		var services []string
+		for _, service := range enabledServices {
+			if matchNewEntry(rules.ForService(service), entry, categoryID) {
+				services = append(services, service)
+			}
+		}
+
+		if err := store.CreateIntegrationDeliveries(feed.UserID, entry.ID, services); err != nil {
+			return err
 		}
 	}
 
-	if integration.WallabagEnabled {
+	return nil
+}
+
+// matchNewEntry returns true if the new entry should be sent automatically.
+// Keywords are searched in the title and in the text of the content, without HTML markup.
+func matchNewEntry(rule *model.IntegrationRule, entry *model.Entry, categoryID int64) bool {
+	if rule.HasFeed(entry.FeedID) || rule.HasCategory(categoryID) {
+		return true
+	}
+
+	keywords := rule.KeywordList()
+	if len(keywords) == 0 {
+		return false
+	}
+
+	title := strings.ToLower(entry.Title)
+	content := strings.ToLower(sanitizer.StripTags(entry.Content))
+	for _, keyword := range keywords {
+		if strings.Contains(title, keyword) || strings.Contains(content, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SendEntry send the entry to the given provider.
+func SendEntry(service string, entry *model.Entry, integration *model.Integration) error {
+	switch service {
+	case ServicePinboard:
+		client := pinboard.NewClient(integration.PinboardToken)
+		return client.AddBookmark(
+			entry.URL,
+			entry.Title,
+			integration.PinboardTags,
+			integration.PinboardMarkAsUnread,
+		)
+	case ServiceInstapaper:
+		client := instapaper.NewClient(integration.InstapaperUsername, integration.InstapaperPassword)
+		return client.AddURL(entry.URL, entry.Title)
+	case ServiceWallabag:
 		client := wallabag.NewClient(
 			integration.WallabagURL,
 			integration.WallabagClientID,
@@ -46,26 +194,112 @@ func SendEntry(entry *model.Entry, integration *model.Integration) {
 			integration.WallabagUsername,
 			integration.WallabagPassword,
 		)
+		return client.AddEntry(entry.URL, entry.Title, wallabagAnnotations(entry))
+	case ServiceNunuxKeeper:
+		client := nunuxkeeper.NewClient(
+			integration.NunuxKeeperURL,
+			integration.NunuxKeeperAPIKey,
+		)
+		return client.AddEntry(entry.URL, entry.Title, entry.Content)
+	case ServicePocket:
+		client := pocket.NewClient(config.Opts.PocketConsumerKey(integration.PocketConsumerKey), integration.PocketAccessToken)
+		return client.AddURL(entry.URL, entry.Title)
+	case ServiceLinkding:
+		client := linkding.NewClient(
+			integration.LinkdingURL,
+			integration.LinkdingAPIKey,
+			integration.LinkdingTags,
+			integration.LinkdingMarkAsUnread,
+		)
+		return client.AddBookmark(entry.URL, entry.Title)
+	case ServiceShaarli:
+		client := shaarli.NewClient(
+			integration.ShaarliURL,
+			integration.ShaarliAPISecret,
+			integration.ShaarliTags,
+			integration.ShaarliPrivate,
+		)
+		return client.AddLink(entry.URL, entry.Title)
+	case ServiceWebhook:
+		client := webhook.NewClient(integration.WebhookURL, integration.WebhookSecret)
+		return client.SendSaveEntryEvent(entry)
+	default:
+		return fmt.Errorf("integration: unknown service %q", service)
+	}
+}
 
-		if err := client.AddEntry(entry.URL, entry.Title); err != nil {
+// wallabagAnnotations converts the note and the highlights of an entry to Wallabag annotations.
+func wallabagAnnotations(entry *model.Entry) []wallabag.Annotation {
+	var annotations []wallabag.Annotation
+	for _, highlight := range entry.Highlights {
+		annotations = append(annotations, wallabag.Annotation{Quote: highlight.Text})
+	}
+
+	if entry.Note != nil && entry.Note.Content != "" {
+		annotations = append(annotations, wallabag.Annotation{Text: entry.Note.Content})
+	}
+
+	return annotations
+}
+
+// ProcessDelivery sends a queued entry and records the outcome of the attempt.
+func ProcessDelivery(store *storage.Storage, delivery *model.IntegrationDelivery) {
+	if err := deliver(store, delivery); err != nil {
+		logger.Error("[Integration] UserID #%d: %v", delivery.UserID, err)
+		delivery.WithError(err.Error())
+	} else {
+		delivery.WithSuccess()
+	}
+
+	if err := store.UpdateIntegrationDelivery(delivery); err != nil {
+		logger.Error("[Integration] %v", err)
+	}
+}
+
+func deliver(store *storage.Storage, delivery *model.IntegrationDelivery) error {
+	builder := store.NewEntryQueryBuilder(delivery.UserID)
+	builder.WithEntryID(delivery.EntryID)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		return err
+	}
+
+	if entry == nil {
+		return fmt.Errorf("integration: entry #%d not found", delivery.EntryID)
+	}
+
+	settings, err := store.Integration(delivery.UserID)
+	if err != nil {
+		return err
+	}
+
+	return SendEntry(delivery.Service, entry, settings)
+}
+
+// PushEntries send the new entries of a feed to the activated providers.
+func PushEntries(feed *model.Feed, entries model.Entries, integration *model.Integration) {
+	if integration.WebhookEnabled && integration.WebhookNewEntries {
+		client := webhook.NewClient(integration.WebhookURL, integration.WebhookSecret)
+		if err := client.SendNewEntriesEvent(feed, entries); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 
-	if integration.NunuxKeeperEnabled {
-		client := nunuxkeeper.NewClient(
-			integration.NunuxKeeperURL,
-			integration.NunuxKeeperAPIKey,
-		)
+	if !feed.Notify || len(entries) == 0 {
+		return
+	}
 
-		if err := client.AddEntry(entry.URL, entry.Title, entry.Content); err != nil {
+	if integration.MatrixEnabled {
+		client := matrix.NewClient(integration.MatrixURL, integration.MatrixAccessToken, integration.MatrixRoomID)
+		if err := client.SendNewEntries(feed, entries); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
 
-	if integration.PocketEnabled {
-		client := pocket.NewClient(config.Opts.PocketConsumerKey(integration.PocketConsumerKey), integration.PocketAccessToken)
-		if err := client.AddURL(entry.URL, entry.Title); err != nil {
+	if integration.TelegramEnabled {
+		client := telegram.NewClient(integration.TelegramBotToken, integration.TelegramChatID)
+		if err := client.SendNewEntries(feed, entries); err != nil {
 			logger.Error("[Integration] UserID #%d: %v", integration.UserID, err)
 		}
 	}
@@ -0,0 +1,78 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package integration // import "miniflux.app/integration"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestWallabagAnnotations(t *testing.T) {
+	entry := &model.Entry{
+		Note: &model.EntryNote{Content: "Why it *matters*"},
+		Highlights: model.EntryHighlights{
+			&model.EntryHighlight{Text: "first passage"},
+			&model.EntryHighlight{Text: "second passage"},
+		},
+	}
+
+	annotations := wallabagAnnotations(entry)
+	if len(annotations) != 3 {
+		t.Fatalf(`Unexpected annotations: %v`, annotations)
+	}
+
+	if annotations[0].Quote != "first passage" || annotations[0].Text != "" {
+		t.Errorf(`Highlights should be sent as quotes: %v`, annotations[0])
+	}
+
+	if annotations[2].Text != "Why it *matters*" || annotations[2].Quote != "" {
+		t.Errorf(`The note should be sent as text: %v`, annotations[2])
+	}
+
+	if annotations := wallabagAnnotations(&model.Entry{}); len(annotations) != 0 {
+		t.Errorf(`Entries without note and highlights have no annotations: %v`, annotations)
+	}
+}
+
+func TestIntegrationRuleMatchNewEntryByFeedAndCategory(t *testing.T) {
+	rule := &model.IntegrationRule{FeedIDs: []int64{1, 2}, CategoryIDs: []int64{10}}
+
+	if !matchNewEntry(rule, &model.Entry{FeedID: 2}, 20) {
+		t.Error(`Entries from a selected feed should match`)
+	}
+
+	if !matchNewEntry(rule, &model.Entry{FeedID: 3}, 10) {
+		t.Error(`Entries from a selected category should match`)
+	}
+
+	if matchNewEntry(rule, &model.Entry{FeedID: 3}, 20) {
+		t.Error(`Entries from other feeds and categories should not match`)
+	}
+}
+
+func TestIntegrationRuleMatchNewEntryByKeyword(t *testing.T) {
+	rule := &model.IntegrationRule{Keywords: " golang, PostgreSQL ,,"}
+
+	if !matchNewEntry(rule, &model.Entry{Title: "Tuning postgresql"}, 0) {
+		t.Error(`The keyword matching should be case-insensitive`)
+	}
+
+	if !matchNewEntry(rule, &model.Entry{Content: "<p>Written in Golang</p>"}, 0) {
+		t.Error(`The keywords should be searched in the content`)
+	}
+
+	if matchNewEntry(rule, &model.Entry{Title: "Rust"}, 0) {
+		t.Error(`Entries without keywords should not match`)
+	}
+
+	if matchNewEntry(rule, &model.Entry{Content: `<a href="https://golang.org/">Link</a>`}, 0) {
+		t.Error(`The keywords should not be searched in the HTML markup`)
+	}
+
+	if matchNewEntry(&model.IntegrationRule{}, &model.Entry{Title: "Anything"}, 0) {
+		t.Error(`An empty rule should not match anything`)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package linkding // import "miniflux.app/integration/linkding"
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"miniflux.app/http/client"
+)
+
+// Bookmark represents a Linkding bookmark.
+type Bookmark struct {
+	URL      string   `json:"url"`
+	Title    string   `json:"title,omitempty"`
+	TagNames []string `json:"tag_names,omitempty"`
+	Unread   bool     `json:"unread"`
+}
+
+// Client represents a Linkding client.
+type Client struct {
+	baseURL string
+	apiKey  string
+	tags    string
+	unread  bool
+}
+
+// AddBookmark sends a link to Linkding.
+func (c *Client) AddBookmark(link, title string) error {
+	if c.baseURL == "" || c.apiKey == "" {
+		return fmt.Errorf("linkding: missing credentials")
+	}
+
+	apiURL, err := getAPIEndpoint(c.baseURL, "/api/bookmarks/")
+	if err != nil {
+		return err
+	}
+
+	bookmark := &Bookmark{
+		URL:      link,
+		Title:    title,
+		TagNames: SplitTags(c.tags),
+		Unread:   c.unread,
+	}
+
+	clt := client.New(apiURL)
+	clt.WithAuthorization("Token " + c.apiKey)
+	response, err := clt.PostJSON(bookmark)
+	if err != nil {
+		return fmt.Errorf("linkding: unable to send bookmark: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("linkding: unable to send bookmark, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Linkding client.
+func NewClient(baseURL, apiKey, tags string, unread bool) *Client {
+	return &Client{baseURL: baseURL, apiKey: apiKey, tags: tags, unread: unread}
+}
+
+// SplitTags converts a list of tags separated by spaces or commas to a slice.
+func SplitTags(tags string) []string {
+	return strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+func getAPIEndpoint(baseURL, pathURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("linkding: invalid API endpoint: %v", err)
+	}
+
+	// The trailing slash is required by the Linkding API.
+	u.Path = path.Join(u.Path, pathURL) + "/"
+	return u.String(), nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package linkding // import "miniflux.app/integration/linkding"
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitTags(t *testing.T) {
+	scenarios := map[string][]string{
+		"":                 []string{},
+		"golang":           []string{"golang"},
+		"golang rss":       []string{"golang", "rss"},
+		"golang, rss,,web": []string{"golang", "rss", "web"},
+	}
+
+	for input, expected := range scenarios {
+		result := SplitTags(input)
+		if len(result) != len(expected) || (len(result) > 0 && !reflect.DeepEqual(result, expected)) {
+			t.Errorf(`Unexpected tags for %q: got %v instead of %v`, input, result, expected)
+		}
+	}
+}
+
+func TestGetAPIEndpoint(t *testing.T) {
+	endpoint, err := getAPIEndpoint("https://linkding.example.org/", "/api/bookmarks/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if endpoint != "https://linkding.example.org/api/bookmarks/" {
+		t.Errorf(`Unexpected endpoint: %q`, endpoint)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package matrix // import "miniflux.app/integration/matrix"
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+)
+
+// MaxEntriesPerMessage is the number of entries grouped in a single message.
+const MaxEntriesPerMessage = 10
+
+// Message represents a "m.room.message" event.
+type Message struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format"`
+	FormattedBody string `json:"formatted_body"`
+}
+
+// Client represents a Matrix client.
+type Client struct {
+	homeserverURL string
+	accessToken   string
+	roomID        string
+}
+
+// NewClient returns a new Matrix client.
+func NewClient(homeserverURL, accessToken, roomID string) *Client {
+	return &Client{homeserverURL: homeserverURL, accessToken: accessToken, roomID: roomID}
+}
+
+// SendNewEntries posts the new entries of a feed to the Matrix room, in batches of MaxEntriesPerMessage entries.
+func (c *Client) SendNewEntries(feed *model.Feed, entries model.Entries) error {
+	if c.homeserverURL == "" || c.accessToken == "" || c.roomID == "" {
+		return fmt.Errorf("matrix: missing credentials")
+	}
+
+	for start := 0; start < len(entries); start += MaxEntriesPerMessage {
+		end := start + MaxEntriesPerMessage
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		if err := c.sendMessage(NewMessage(feed, entries[start:end])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) sendMessage(message *Message) error {
+	transactionID := fmt.Sprintf("miniflux-%d", time.Now().UnixNano())
+	endpoint := fmt.Sprintf(
+		"%s/_matrix/client/r0/rooms/%s/send/m.room.message/%s",
+		strings.TrimRight(c.homeserverURL, "/"),
+		url.PathEscape(c.roomID),
+		transactionID,
+	)
+
+	clt := client.New(endpoint)
+	clt.WithAuthorization("Bearer " + c.accessToken)
+	response, err := clt.PutJSON(message)
+	if err != nil {
+		return fmt.Errorf("matrix: unable to send message: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("matrix: unable to send message, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewMessage formats a list of entries as a Matrix message.
+// The body uses Markdown for clients without HTML support.
+func NewMessage(feed *model.Feed, entries model.Entries) *Message {
+	var body, formattedBody strings.Builder
+
+	body.WriteString("**" + escapeMarkdown(feed.Title) + "**\n")
+	formattedBody.WriteString("<strong>" + html.EscapeString(feed.Title) + "</strong><ul>")
+
+	for _, entry := range entries {
+		body.WriteString(fmt.Sprintf("- [%s](%s)\n", escapeMarkdown(entry.Title), entry.URL))
+		formattedBody.WriteString(fmt.Sprintf(`<li><a href="%s">%s</a></li>`, html.EscapeString(entry.URL), html.EscapeString(entry.Title)))
+	}
+
+	formattedBody.WriteString("</ul>")
+
+	return &Message{
+		MsgType:       "m.text",
+		Body:          body.String(),
+		Format:        "org.matrix.custom.html",
+		FormattedBody: formattedBody.String(),
+	}
+}
+
+var markdownReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`*`, `\*`,
+	`_`, `\_`,
+	"`", "\\`",
+	`[`, `\[`,
+	`]`, `\]`,
+)
+
+func escapeMarkdown(text string) string {
+	return markdownReplacer.Replace(text)
+}
@@ -0,0 +1,76 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package matrix // import "miniflux.app/integration/matrix"
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func TestNewMessage(t *testing.T) {
+	feed := &model.Feed{Title: "Feed"}
+	entries := model.Entries{&model.Entry{Title: "[Go] <1.12>", URL: "https://example.org/"}}
+
+	message := NewMessage(feed, entries)
+	if message.Body != "**Feed**\n- [\\[Go\\] <1.12>](https://example.org/)\n" {
+		t.Errorf(`Unexpected body: %q`, message.Body)
+	}
+
+	if message.FormattedBody != `<strong>Feed</strong><ul><li><a href="https://example.org/">[Go] &lt;1.12&gt;</a></li></ul>` {
+		t.Errorf(`Unexpected formatted body: %q`, message.FormattedBody)
+	}
+}
+
+func TestSendNewEntries(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var messages []Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf(`Unexpected method: %s`, r.Method)
+		}
+
+		if !strings.HasPrefix(r.URL.EscapedPath(), "/_matrix/client/r0/rooms/%21room:example.org/send/m.room.message/") {
+			t.Errorf(`Unexpected path: %q`, r.URL.EscapedPath())
+		}
+
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf(`Unexpected authorization header: %q`, r.Header.Get("Authorization"))
+		}
+
+		var message Message
+		json.NewDecoder(r.Body).Decode(&message)
+		messages = append(messages, message)
+		w.Write([]byte(`{"event_id": "$1"}`))
+	}))
+	defer server.Close()
+
+	var entries model.Entries
+	for i := 0; i < MaxEntriesPerMessage*2; i++ {
+		entries = append(entries, &model.Entry{Title: "Entry", URL: "https://example.org/"})
+	}
+
+	if err := NewClient(server.URL+"/", "token", "!room:example.org").SendNewEntries(&model.Feed{}, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 2 {
+		t.Errorf(`Entries should be sent in 2 messages instead of %d`, len(messages))
+	}
+}
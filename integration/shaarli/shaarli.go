@@ -0,0 +1,105 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package shaarli // import "miniflux.app/integration/shaarli"
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"miniflux.app/http/client"
+)
+
+// Link represents a Shaarli link.
+type Link struct {
+	URL         string   `json:"url"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	Private     bool     `json:"private"`
+}
+
+// Client represents a Shaarli client.
+type Client struct {
+	baseURL   string
+	apiSecret string
+	tags      string
+	private   bool
+}
+
+// AddLink sends a link to Shaarli.
+func (c *Client) AddLink(link, title string) error {
+	if c.baseURL == "" || c.apiSecret == "" {
+		return fmt.Errorf("shaarli: missing credentials")
+	}
+
+	apiURL, err := getAPIEndpoint(c.baseURL, "/api/v1/links")
+	if err != nil {
+		return err
+	}
+
+	token, err := GenerateToken(c.apiSecret, time.Now())
+	if err != nil {
+		return err
+	}
+
+	clt := client.New(apiURL)
+	clt.WithAuthorization("Bearer " + token)
+	response, err := clt.PostJSON(&Link{
+		URL:     link,
+		Title:   title,
+		Tags:    strings.Fields(strings.Replace(c.tags, ",", " ", -1)),
+		Private: c.private,
+	})
+	if err != nil {
+		return fmt.Errorf("shaarli: unable to send link: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return fmt.Errorf("shaarli: unable to send link, status=%d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// NewClient returns a new Shaarli client.
+func NewClient(baseURL, apiSecret, tags string, private bool) *Client {
+	return &Client{baseURL: baseURL, apiSecret: apiSecret, tags: tags, private: private}
+}
+
+// GenerateToken returns a JWT signed with the API secret, as expected by the Shaarli REST API.
+// Shaarli rejects tokens issued more than 9 minutes ago, so a new token is generated for each request.
+func GenerateToken(apiSecret string, issuedAt time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"typ": "JWT", "alg": "HS512"})
+	if err != nil {
+		return "", fmt.Errorf("shaarli: unable to encode token header: %v", err)
+	}
+
+	payload, err := json.Marshal(map[string]int64{"iat": issuedAt.Unix()})
+	if err != nil {
+		return "", fmt.Errorf("shaarli: unable to encode token payload: %v", err)
+	}
+
+	data := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha512.New, []byte(apiSecret))
+	mac.Write([]byte(data))
+	return data + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+func getAPIEndpoint(baseURL, pathURL string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("shaarli: invalid API endpoint: %v", err)
+	}
+	u.Path = path.Join(u.Path, pathURL)
+	return u.String(), nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package shaarli // import "miniflux.app/integration/shaarli"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateToken(t *testing.T) {
+	// {"alg":"HS512","typ":"JWT"}.{"iat":1550000000}
+	expected := "eyJhbGciOiJIUzUxMiIsInR5cCI6IkpXVCJ9.eyJpYXQiOjE1NTAwMDAwMDB9."
+	token, err := GenerateToken("secret", time.Unix(1550000000, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(token) <= len(expected) || token[:len(expected)] != expected {
+		t.Errorf(`Unexpected token header or payload: %q`, token)
+	}
+}
+
+func TestGenerateTokenWithDifferentSecrets(t *testing.T) {
+	now := time.Now()
+	token1, _ := GenerateToken("secret1", now)
+	token2, _ := GenerateToken("secret2", now)
+
+	if token1 == token2 {
+		t.Error(`The signature should depend on the API secret`)
+	}
+}
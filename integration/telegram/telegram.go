@@ -0,0 +1,103 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package telegram // import "miniflux.app/integration/telegram"
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+)
+
+const (
+	// MaxEntriesPerMessage is the number of entries grouped in a single message.
+	MaxEntriesPerMessage = 10
+
+	defaultAPIURL = "https://api.telegram.org"
+)
+
+// Message represents the payload of the sendMessage method.
+type Message struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+type apiResponse struct {
+	OK          bool   `json:"ok"`
+	Description string `json:"description"`
+}
+
+// Client represents a Telegram bot client.
+type Client struct {
+	apiURL   string
+	botToken string
+	chatID   string
+}
+
+// NewClient returns a new Telegram client.
+func NewClient(botToken, chatID string) *Client {
+	return &Client{apiURL: defaultAPIURL, botToken: botToken, chatID: chatID}
+}
+
+// SendNewEntries posts the new entries of a feed to the Telegram chat, in batches of MaxEntriesPerMessage entries.
+func (c *Client) SendNewEntries(feed *model.Feed, entries model.Entries) error {
+	if c.botToken == "" || c.chatID == "" {
+		return fmt.Errorf("telegram: missing credentials")
+	}
+
+	for start := 0; start < len(entries); start += MaxEntriesPerMessage {
+		end := start + MaxEntriesPerMessage
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		if err := c.sendMessage(FormatMessage(feed, entries[start:end])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) sendMessage(text string) error {
+	clt := client.New(fmt.Sprintf("%s/bot%s/sendMessage", c.apiURL, c.botToken))
+	response, err := clt.PostJSON(&Message{
+		ChatID:                c.chatID,
+		Text:                  text,
+		ParseMode:             "HTML",
+		DisableWebPagePreview: true,
+	})
+	if err != nil {
+		return fmt.Errorf("telegram: unable to send message: %v", err)
+	}
+
+	var result apiResponse
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return fmt.Errorf("telegram: unable to decode response, status=%d: %v", response.StatusCode, err)
+	}
+
+	if response.HasServerFailure() || !result.OK {
+		return fmt.Errorf("telegram: unable to send message, status=%d: %s", response.StatusCode, result.Description)
+	}
+
+	return nil
+}
+
+// FormatMessage formats a list of entries with the subset of HTML supported by Telegram.
+func FormatMessage(feed *model.Feed, entries model.Entries) string {
+	var text strings.Builder
+	text.WriteString("<b>" + html.EscapeString(feed.Title) + "</b>\n")
+
+	for _, entry := range entries {
+		text.WriteString(fmt.Sprintf("\n• <a href=\"%s\">%s</a>", html.EscapeString(entry.URL), html.EscapeString(entry.Title)))
+	}
+
+	return text.String()
+}
@@ -0,0 +1,99 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package telegram // import "miniflux.app/integration/telegram"
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/model"
+)
+
+func TestFormatMessage(t *testing.T) {
+	feed := &model.Feed{Title: "Feed <1>"}
+	entries := model.Entries{&model.Entry{Title: "A & B", URL: "https://example.org/?a=1&b=2"}}
+
+	expected := "<b>Feed &lt;1&gt;</b>\n\n• <a href=\"https://example.org/?a=1&amp;b=2\">A &amp; B</a>"
+	if result := FormatMessage(feed, entries); result != expected {
+		t.Errorf(`Unexpected message: %q`, result)
+	}
+}
+
+func TestSendNewEntriesInBatches(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var messages []Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bottoken/sendMessage" {
+			t.Errorf(`Unexpected path: %q`, r.URL.Path)
+		}
+
+		var message Message
+		json.NewDecoder(r.Body).Decode(&message)
+		messages = append(messages, message)
+		fmt.Fprint(w, `{"ok": true}`)
+	}))
+	defer server.Close()
+
+	var entries model.Entries
+	for i := 0; i < MaxEntriesPerMessage+1; i++ {
+		entries = append(entries, &model.Entry{Title: fmt.Sprintf("Entry %d", i), URL: "https://example.org/"})
+	}
+
+	clt := NewClient("token", "42")
+	clt.apiURL = server.URL
+	if err := clt.SendNewEntries(&model.Feed{Title: "Feed"}, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 2 {
+		t.Fatalf(`Entries should be sent in 2 messages instead of %d`, len(messages))
+	}
+
+	if messages[0].ChatID != "42" || messages[0].ParseMode != "HTML" {
+		t.Errorf(`Unexpected message: %+v`, messages[0])
+	}
+
+	if strings.Count(messages[1].Text, "<a href") != 1 {
+		t.Errorf(`The second message should contain the last entry: %q`, messages[1].Text)
+	}
+}
+
+func TestSendNewEntriesWithAPIError(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"ok": false, "description": "Bad Request: chat not found"}`)
+	}))
+	defer server.Close()
+
+	clt := NewClient("token", "42")
+	clt.apiURL = server.URL
+	err = clt.SendNewEntries(&model.Feed{}, model.Entries{&model.Entry{}})
+	if err == nil || !strings.Contains(err.Error(), "chat not found") {
+		t.Errorf(`The error returned by the API should be reported: %v`, err)
+	}
+}
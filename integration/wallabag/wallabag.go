@@ -22,8 +22,14 @@ type Client struct {
 	password     string
 }
 
-// AddEntry sends a link to Wallabag.
-func (c *Client) AddEntry(link, title string) error {
+// Annotation represents a note or a highlighted passage attached to an entry saved in Wallabag.
+type Annotation struct {
+	Text  string
+	Quote string
+}
+
+// AddEntry sends a link to Wallabag with its annotations.
+func (c *Client) AddEntry(link, title string, annotations []Annotation) error {
 	if c.baseURL == "" || c.clientID == "" || c.clientSecret == "" || c.username == "" || c.password == "" {
 		return fmt.Errorf("wallabag: missing credentials")
 	}
@@ -33,24 +39,68 @@ func (c *Client) AddEntry(link, title string) error {
 		return err
 	}
 
-	return c.createEntry(accessToken, link, title)
+	entryID, err := c.createEntry(accessToken, link, title)
+	if err != nil {
+		return err
+	}
+
+	for _, annotation := range annotations {
+		if err := c.createAnnotation(accessToken, entryID, annotation); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (c *Client) createEntry(accessToken, link, title string) error {
+func (c *Client) createEntry(accessToken, link, title string) (int64, error) {
 	endpoint, err := getAPIEndpoint(c.baseURL, "/api/entries.json")
 	if err != nil {
-		return fmt.Errorf("wallbag: unable to get entries endpoint: %v", err)
+		return 0, fmt.Errorf("wallbag: unable to get entries endpoint: %v", err)
 	}
 
 	clt := client.New(endpoint)
 	clt.WithAuthorization("Bearer " + accessToken)
 	response, err := clt.PostJSON(map[string]string{"url": link, "title": title})
 	if err != nil {
-		return fmt.Errorf("wallabag: unable to post entry: %v", err)
+		return 0, fmt.Errorf("wallabag: unable to post entry: %v", err)
+	}
+
+	if response.HasServerFailure() {
+		return 0, fmt.Errorf("wallabag: request failed, status=%d", response.StatusCode)
+	}
+
+	var entry entryResponse
+	if err := json.NewDecoder(response.Body).Decode(&entry); err != nil {
+		return 0, fmt.Errorf("wallabag: unable to decode entry response: %v", err)
+	}
+
+	return entry.ID, nil
+}
+
+// createAnnotation attaches an annotation to an entry. Highlights are stored without their position
+// in the document, the range is left empty and Wallabag keeps the quoted text.
+func (c *Client) createAnnotation(accessToken string, entryID int64, annotation Annotation) error {
+	endpoint, err := getAPIEndpoint(c.baseURL, fmt.Sprintf("/api/annotations/%d.json", entryID))
+	if err != nil {
+		return fmt.Errorf("wallbag: unable to get annotations endpoint: %v", err)
+	}
+
+	clt := client.New(endpoint)
+	clt.WithAuthorization("Bearer " + accessToken)
+	response, err := clt.PostJSON(map[string]interface{}{
+		"text":  annotation.Text,
+		"quote": annotation.Quote,
+		"ranges": []map[string]interface{}{
+			{"start": "", "startOffset": 0, "end": "", "endOffset": 0},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("wallabag: unable to post annotation: %v", err)
 	}
 
 	if response.HasServerFailure() {
-		return fmt.Errorf("wallabag: request failed, status=%d", response.StatusCode)
+		return fmt.Errorf("wallabag: annotation request failed, status=%d", response.StatusCode)
 	}
 
 	return nil
@@ -101,6 +151,10 @@ func getAPIEndpoint(baseURL, path string) (string, error) {
 	return u.String(), nil
 }
 
+type entryResponse struct {
+	ID int64 `json:"id"`
+}
+
 type tokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	Expires      int    `json:"expires_in"`
@@ -0,0 +1,196 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webhook // import "miniflux.app/integration/webhook"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/http/client"
+	"miniflux.app/model"
+	"miniflux.app/version"
+)
+
+const (
+	// NewEntriesEventType is sent when new entries are stored after a feed refresh.
+	NewEntriesEventType = "new_entries"
+
+	// SaveEntryEventType is sent when the user saves an entry.
+	SaveEntryEventType = "save_entry"
+
+	// SignatureHeader contains the HMAC-SHA256 signature of the request body.
+	SignatureHeader = "X-Miniflux-Signature"
+
+	// EventTypeHeader contains the type of event sent.
+	EventTypeHeader = "X-Miniflux-Event-Type"
+)
+
+// Client represents a webhook client.
+type Client struct {
+	webhookURL    string
+	webhookSecret string
+}
+
+// NewClient returns a new webhook client.
+func NewClient(webhookURL, webhookSecret string) *Client {
+	return &Client{webhookURL: webhookURL, webhookSecret: webhookSecret}
+}
+
+// SendNewEntriesEvent sends the entries stored after a feed refresh to the webhook.
+func (c *Client) SendNewEntriesEvent(feed *model.Feed, entries model.Entries) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	webhookEntries := make([]*Entry, 0, len(entries))
+	for _, entry := range entries {
+		webhookEntries = append(webhookEntries, newEntry(entry, nil))
+	}
+
+	return c.sendEvent(NewEntriesEventType, &NewEntriesEvent{
+		EventType: NewEntriesEventType,
+		Feed:      newFeed(feed),
+		Entries:   webhookEntries,
+	})
+}
+
+// SendSaveEntryEvent sends a saved entry to the webhook.
+func (c *Client) SendSaveEntryEvent(entry *model.Entry) error {
+	return c.sendEvent(SaveEntryEventType, &SaveEntryEvent{
+		EventType: SaveEntryEventType,
+		Entry:     newEntry(entry, newFeed(entry.Feed)),
+	})
+}
+
+func (c *Client) sendEvent(eventType string, payload interface{}) error {
+	if c.webhookURL == "" {
+		return fmt.Errorf(`webhook: missing webhook URL`)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf(`webhook: unable to encode request body: %v`, err)
+	}
+
+	request, err := http.NewRequest(http.MethodPost, c.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf(`webhook: unable to create request: %v`, err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Miniflux/"+version.Version)
+	request.Header.Set(EventTypeHeader, eventType)
+	request.Header.Set(SignatureHeader, crypto.GenerateSHA256Hmac(c.webhookSecret, body))
+
+	httpClient := &http.Client{
+		Timeout:   time.Duration(config.Opts.HTTPClientTimeout()) * time.Second,
+		Transport: client.NewTransport(),
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf(`webhook: unable to send request: %v`, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 400 {
+		return fmt.Errorf(`webhook: incorrect response status code %d for url %s`, response.StatusCode, c.webhookURL)
+	}
+
+	return nil
+}
+
+// NewEntriesEvent is the payload sent for the "new_entries" event.
+type NewEntriesEvent struct {
+	EventType string   `json:"event_type"`
+	Feed      *Feed    `json:"feed"`
+	Entries   []*Entry `json:"entries"`
+}
+
+// SaveEntryEvent is the payload sent for the "save_entry" event.
+type SaveEntryEvent struct {
+	EventType string `json:"event_type"`
+	Entry     *Entry `json:"entry"`
+}
+
+// Feed represents the feed fields sent to the webhook.
+// Credentials and crawler settings are intentionally left out.
+type Feed struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	FeedURL   string    `json:"feed_url"`
+	SiteURL   string    `json:"site_url"`
+	Title     string    `json:"title"`
+	CheckedAt time.Time `json:"checked_at"`
+	Category  *Category `json:"category,omitempty"`
+}
+
+// Category represents the category fields sent to the webhook.
+type Category struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+// Entry represents the entry fields sent to the webhook.
+type Entry struct {
+	ID          int64               `json:"id"`
+	UserID      int64               `json:"user_id"`
+	FeedID      int64               `json:"feed_id"`
+	Status      string              `json:"status"`
+	Hash        string              `json:"hash"`
+	Title       string              `json:"title"`
+	URL         string              `json:"url"`
+	CommentsURL string              `json:"comments_url"`
+	Date        time.Time           `json:"published_at"`
+	Content     string              `json:"content"`
+	Author      string              `json:"author"`
+	Starred     bool                `json:"starred"`
+	Enclosures  model.EnclosureList `json:"enclosures,omitempty"`
+	Feed        *Feed               `json:"feed,omitempty"`
+}
+
+func newFeed(feed *model.Feed) *Feed {
+	if feed == nil {
+		return nil
+	}
+
+	webhookFeed := &Feed{
+		ID:        feed.ID,
+		UserID:    feed.UserID,
+		FeedURL:   feed.FeedURL,
+		SiteURL:   feed.SiteURL,
+		Title:     feed.Title,
+		CheckedAt: feed.CheckedAt,
+	}
+
+	if feed.Category != nil {
+		webhookFeed.Category = &Category{ID: feed.Category.ID, Title: feed.Category.Title}
+	}
+
+	return webhookFeed
+}
+
+func newEntry(entry *model.Entry, feed *Feed) *Entry {
+	return &Entry{
+		ID:          entry.ID,
+		UserID:      entry.UserID,
+		FeedID:      entry.FeedID,
+		Status:      entry.Status,
+		Hash:        entry.Hash,
+		Title:       entry.Title,
+		URL:         entry.URL,
+		CommentsURL: entry.CommentsURL,
+		Date:        entry.Date,
+		Content:     entry.Content,
+		Author:      entry.Author,
+		Starred:     entry.Starred,
+		Enclosures:  entry.Enclosures,
+		Feed:        feed,
+	}
+}
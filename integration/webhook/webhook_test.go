@@ -0,0 +1,123 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webhook // import "miniflux.app/integration/webhook"
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+func TestSendSaveEntryEvent(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var receivedEvent SaveEntryEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Header.Get(EventTypeHeader) != SaveEntryEventType {
+			t.Errorf(`Unexpected event type header: %q`, r.Header.Get(EventTypeHeader))
+		}
+
+		if r.Header.Get(SignatureHeader) != crypto.GenerateSHA256Hmac("secret", body) {
+			t.Errorf(`Invalid signature: %q`, r.Header.Get(SignatureHeader))
+		}
+
+		if err := json.Unmarshal(body, &receivedEvent); err != nil {
+			t.Errorf(`Unable to decode payload: %v`, err)
+		}
+	}))
+	defer server.Close()
+
+	entry := &model.Entry{
+		ID:    42,
+		Title: "Entry",
+		URL:   "https://example.org/entry",
+		Feed: &model.Feed{
+			ID:       1,
+			Title:    "Feed",
+			Password: "do not leak",
+			Category: &model.Category{ID: 2, Title: "Category"},
+		},
+	}
+
+	if err := NewClient(server.URL, "secret").SendSaveEntryEvent(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	if receivedEvent.EventType != SaveEntryEventType || receivedEvent.Entry.ID != 42 {
+		t.Errorf(`Unexpected payload: %+v`, receivedEvent)
+	}
+
+	if receivedEvent.Entry.Feed == nil || receivedEvent.Entry.Feed.Category.Title != "Category" {
+		t.Errorf(`The feed and category should be sent with the entry`)
+	}
+}
+
+func TestSendNewEntriesEvent(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var receivedEvent NewEntriesEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(EventTypeHeader) != NewEntriesEventType {
+			t.Errorf(`Unexpected event type header: %q`, r.Header.Get(EventTypeHeader))
+		}
+
+		json.NewDecoder(r.Body).Decode(&receivedEvent)
+	}))
+	defer server.Close()
+
+	feed := &model.Feed{ID: 1, Title: "Feed"}
+	entries := model.Entries{&model.Entry{ID: 1}, &model.Entry{ID: 2}}
+
+	if err := NewClient(server.URL, "secret").SendNewEntriesEvent(feed, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	if receivedEvent.Feed.Title != "Feed" || len(receivedEvent.Entries) != 2 {
+		t.Errorf(`Unexpected payload: %+v`, receivedEvent)
+	}
+}
+
+func TestSendEventWithServerError(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	if err := NewClient(server.URL, "secret").SendSaveEntryEvent(&model.Entry{}); err == nil {
+		t.Error(`An error should be returned when the webhook fails`)
+	}
+}
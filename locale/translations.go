@@ -11,6 +11,7 @@ var translations = map[string]string{
     "action.subscribe": "Abonnieren",
     "action.save": "Speichern",
     "action.or": "oder",
+    "action.preview": "Vorschau",
     "action.cancel": "abbrechen",
     "action.remove": "Entfernen",
     "action.remove_feed": "Dieses Abonnement entfernen",
@@ -20,6 +21,10 @@ var translations = map[string]string{
     "action.import": "Importieren",
     "action.login": "Anmelden",
     "action.home_screen": "Zum Startbildschirm hinzufügen",
+    "action.retry": "Wiederholen",
+    "action.publish": "Veröffentlichen",
+    "action.revoke": "Widerrufen",
+    "action.sync": "Synchronisieren",
     "tooltip.keyboard_shortcuts": "Tastenkürzel: %s",
     "tooltip.logged_user": "Angemeldet als %s",
     "menu.unread": "Ungelesen",
@@ -31,11 +36,15 @@ var translations = map[string]string{
     "menu.logout": "Abmelden",
     "menu.preferences": "Einstellungen",
     "menu.integrations": "Dienste",
+    "menu.digest": "E-Mail-Zusammenfassung",
+    "menu.published_feeds": "Öffentliche Feeds",
+    "menu.shared_entries": "Geteilte Artikel",
     "menu.sessions": "Sitzungen",
     "menu.users": "Benutzer",
     "menu.about": "Über",
     "menu.export": "Exportieren",
     "menu.import": "Importieren",
+    "menu.opml_subscriptions": "OPML-Abonnements",
     "menu.create_category": "Kategorie anlegen",
     "menu.mark_page_as_read": "Diese Seite als gelesen markieren",
     "menu.mark_all_as_read": "Alle als gelesen markieren",
@@ -46,11 +55,35 @@ var translations = map[string]string{
     "menu.edit_feed": "Bearbeiten",
     "menu.edit_category": "Bearbeiten",
     "menu.add_feed": "Abonnement hinzufügen",
+    "menu.scrape_page": "Aus einer Webseite hinzufügen",
     "menu.add_user": "Benutzer anlegen",
     "menu.flush_history": "Verlauf leeren",
     "menu.feed_entries": "Artikel",
+    "menu.saved_searches": "Gespeicherte Suchen",
+    "menu.snoozed_entries": "Zurückgestellte Artikel (%d)",
+    "menu.save_search": "Diese Suche speichern",
+    "menu.create_saved_search": "Suche speichern",
+    "menu.edit_saved_search": "Bearbeiten",
+    "menu.show_saved_search_entries": "Artikel",
     "search.label": "Suche",
     "search.placeholder": "Suche...",
+    "search.syntax": "Verwenden Sie Anführungszeichen für Ausdrücke, -Wort zum Ausschließen, OR zwischen Wörtern und die Filter feed:, category:, author:, is:unread, is:read, is:starred, before:JJJJ-MM-TT und after:JJJJ-MM-TT",
+    "search_language.danish": "Dänisch",
+    "search_language.dutch": "Niederländisch",
+    "search_language.english": "Englisch",
+    "search_language.finnish": "Finnisch",
+    "search_language.french": "Französisch",
+    "search_language.german": "Deutsch",
+    "search_language.hungarian": "Ungarisch",
+    "search_language.italian": "Italienisch",
+    "search_language.norwegian": "Norwegisch",
+    "search_language.portuguese": "Portugiesisch",
+    "search_language.romanian": "Rumänisch",
+    "search_language.russian": "Russisch",
+    "search_language.simple": "Andere Sprache (ohne Stammformreduktion)",
+    "search_language.spanish": "Spanisch",
+    "search_language.swedish": "Schwedisch",
+    "search_language.turkish": "Türkisch",
     "pagination.next": "Nächste",
     "pagination.previous": "Vorherige",
     "entry.status.unread": "Ungelesen",
@@ -58,10 +91,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "Als ungelesen markiert",
     "entry.status.toast.read": "Als gelesen markiert",
     "entry.status.title": "Status des Artikels ändern",
+    "entry.snooze.title": "Diesen Artikel bis morgen früh aus den ungelesenen Artikeln ausblenden",
+    "entry.snooze.label": "Zurückstellen",
+    "entry.snooze.later_today": "Später heute",
+    "entry.snooze.tomorrow": "Morgen früh",
+    "entry.snooze.next_week": "Nächste Woche",
+    "entry.snooze.custom": "Bis zu diesem Datum zurückstellen",
+    "entry.snooze.toast": "Zurückgestellt",
+    "entry.unsnooze.label": "Zurückstellen abbrechen",
+    "entry.unsnooze.toast": "Zurückstellen abgebrochen",
     "entry.bookmark.toggle.on": "Lesezeichen hinzufügen",
     "entry.bookmark.toggle.off": "Lesezeichen entfernen",
     "entry.bookmark.toast.on": "Markiert",
     "entry.bookmark.toast.off": "Nicht markiert",
+    "entry.played.toggle.on": "Als abgespielt markieren",
+    "entry.played.toggle.off": "Als nicht abgespielt markieren",
     "entry.state.saving": "Speichern...",
     "entry.state.loading": "Lade...",
     "entry.save.label": "Speichern",
@@ -74,8 +118,13 @@ var translations = map[string]string{
     "entry.original.label": "Original-Artikel",
     "entry.comments.label": "Kommentare",
     "entry.comments.title": "Kommentare anzeigen",
+    "entry.share.label": "Teilen",
+    "entry.share.title": "Einen öffentlichen Link zu diesem Artikel erstellen",
+    "entry.shared_entry.label": "Öffentlicher Link",
+    "entry.shared_entry.title": "Den öffentlichen Link öffnen",
     "page.unread.title": "Ungelesen",
     "page.starred.title": "Lesezeichen",
+    "page.snoozed.title": "Zurückgestellte Artikel",
     "page.categories.title": "Kategorien",
     "page.categories.no_feed": "Kein Abonnement.",
     "page.categories.feeds": "Siehe Abonnements",
@@ -86,6 +135,10 @@ var translations = map[string]string{
     "page.new_category.title": "Neue Kategorie",
     "page.new_user.title": "Neuer Benutzer",
     "page.edit_category.title": "Kategorie bearbeiten: %s",
+    "page.saved_searches.title": "Gespeicherte Suchen",
+    "page.saved_searches.unread_count": "Ungelesene Artikel",
+    "page.new_saved_search.title": "Neue gespeicherte Suche",
+    "page.edit_saved_search.title": "Gespeicherte Suche bearbeiten: %s",
     "page.edit_user.title": "Benutzer bearbeiten: %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Letzte Aktualisierung:",
@@ -97,6 +150,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "Verlauf",
     "page.import.title": "Importieren",
+    "page.import.report.summary": "%d Abonnements importiert, %d übersprungen, da sie bereits existieren, %d fehlgeschlagen.",
+    "page.import.report.table.feed": "Abonnement",
+    "page.import.report.table.category": "Kategorie",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Importiert",
+    "page.import.report.status.duplicate": "Bereits abonniert",
+    "page.import.report.status.failed": "Fehlgeschlagen",
+    "page.import.formats": "Unterstützte Dateien: OPML, JSON-Export von Tiny Tiny RSS, FreshRSS-Export (Lesezeichen und Labels) und starred.json von Google Takeout.",
+    "page.import.entries_report.summary": "%d Artikel importiert, %d Artikel aktualisiert, %d neue Abonnements.",
+    "page.import.entries_report.archived": "%d Artikel geben ihr Abonnement nicht an und wurden in einem Archiv-Abonnement gespeichert.",
+    "page.import.archive_feed_title": "Importierte Artikel (%s)",
+    "page.opml_subscriptions.title": "OPML-Abonnements",
+    "page.opml_subscriptions.help": "Die in einer entfernten OPML-Datei aufgeführten Abonnements werden hinzugefügt und in die Kategorien der Datei verschoben. Die Datei wird regelmäßig überprüft.",
+    "page.opml_subscriptions.no_subscription": "Es gibt kein OPML-Abonnement.",
+    "page.opml_subscriptions.new": "Einer OPML-Datei folgen",
+    "page.opml_subscriptions.changes": "Letzte Änderungen",
+    "page.opml_subscriptions.remove_missing": "Aus der Datei entfernte Abonnements werden gelöscht.",
+    "page.opml_subscriptions.never_checked": "Nie",
+    "page.opml_subscriptions.table.url": "OPML-Datei",
+    "page.opml_subscriptions.table.checked_at": "Letzte Prüfung",
+    "page.opml_subscriptions.table.actions": "Aktionen",
+    "page.opml_subscriptions.table.feed": "Abonnement",
+    "page.opml_subscriptions.table.category": "Kategorie",
+    "page.opml_subscriptions.table.action": "Änderung",
+    "page.opml_subscriptions.table.date": "Datum",
+    "page.opml_subscriptions.action.added": "Hinzugefügt",
+    "page.opml_subscriptions.action.removed": "Entfernt",
+    "page.opml_subscriptions.action.moved": "Verschoben aus %s",
+    "page.opml_subscriptions.action.failed": "Fehlgeschlagen",
     "page.search.title": "Suchergebnisse",
     "page.about.title": "Über",
     "page.about.credits": "Urheberrechte",
@@ -110,6 +192,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Abonnement suchen",
     "page.add_feed.legend.advanced_options": "Erweiterte Optionen",
     "page.add_feed.choose_feed": "Abonnement auswählen",
+    "page.scrape_feed.title": "Neues Abonnement aus einer Webseite",
+    "page.scrape_feed.help": "Die Artikel werden mit CSS-Selektoren aus der Webseite extrahiert. Titel-, Link-, Datum- und Inhalt-Selektoren beziehen sich auf jeden Artikel, standardmäßig wird der erste Link des Artikels verwendet.",
+    "page.add_feed.scrape_suggestion": "Es wurde kein Abonnement gefunden, Sie können eines mit CSS-Selektoren aus dieser Webseite erstellen.",
     "page.edit_feed.title": "Abonnement bearbeiten: %s",
     "page.edit_feed.last_check": "Letzte Aktualisierung:",
     "page.edit_feed.last_modified_header": "Zuletzt geändert:",
@@ -117,6 +202,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Nicht verfügbar",
     "page.edit_feed.last_parsing_error": "Letzter Analysefehler",
     "page.entry.attachments": "Anlagen",
+    "page.entry.snooze": "Zurückstellen",
+    "page.entry.snoozed_until": "Zurückgestellt bis %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Staffel %d",
+    "page.entry.podcast.episode": "Folge %s",
+    "page.entry.podcast.chapters": "Kapitel",
+    "page.entry.podcast.transcripts": "Transkripte",
+    "page.entry.podcast.transcript": "Transkript",
+    "page.entry.podcast.captions": "Untertitel",
+    "page.entry.podcast.persons": "Mitwirkende",
+    "page.entry.podcast.funding": "Diesen Podcast unterstützen:",
+    "page.entry.annotations": "Notizen und Markierungen",
+    "page.entry.highlights": "Markierungen",
+    "page.entry.highlight.label": "Ausgewählten Text markieren",
+    "page.entry.highlight.title": "Wählen Sie eine Passage des Artikels aus und klicken Sie hier, um sie zu markieren",
+    "page.entry.highlight.empty_selection": "Wählen Sie zuerst eine Passage des Artikels aus",
+    "page.entry.note": "Notiz",
+    "page.entry.note.placeholder": "Private Notiz, Markdown wird unterstützt",
+    "page.entry.note.save": "Notiz speichern",
+    "page.entry.note.toast.saved": "Notiz gespeichert",
     "page.keyboard_shortcuts.title": "Tastenkürzel",
     "page.keyboard_shortcuts.subtitle.sections": "Navigation zwischen den Menüpunkten",
     "page.keyboard_shortcuts.subtitle.items": "Navigation zwischen den Artikeln",
@@ -144,6 +249,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Vollständigen Inhalt herunterladen",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Lesezeichen hinzufügen/entfernen",
     "page.keyboard_shortcuts.save_article": "Artikel speichern",
+    "page.keyboard_shortcuts.snooze_entry": "Bis morgen früh zurückstellen",
     "page.keyboard_shortcuts.remove_feed": "Dieses Abonnement entfernen",
     "page.keyboard_shortcuts.go_to_search": "Fokus auf das Suchformular setzen",
     "page.keyboard_shortcuts.close_modal": "Liste der Tastenkürzel schließen",
@@ -161,22 +267,51 @@ var translations = map[string]string{
     "page.login.title": "Anmeldung",
     "page.login.google_signin": "Anmeldung mit Google",
     "page.integrations.title": "Dienste",
+    "page.digest.title": "E-Mail-Zusammenfassung",
+    "page.digest.smtp_not_configured": "Es ist kein SMTP-Server konfiguriert, Zusammenfassungen werden erst gesendet, wenn der Administrator einen festlegt.",
+    "page.digest.last_sent": "Letzte Zusammenfassung:",
+    "page.published_feeds.title": "Öffentliche Feeds",
+    "page.published_feeds.help": "Jeder, der die Adresse eines öffentlichen Feeds kennt, kann ihn ohne Konto lesen. Widerrufen Sie einen Feed, um seine Adresse zu deaktivieren.",
+    "page.published_feeds.no_feed": "Sie haben noch keinen Feed veröffentlicht.",
+    "page.published_feeds.table.title": "Titel",
+    "page.published_feeds.table.links": "Adressen",
+    "page.published_feeds.table.date": "Datum",
+    "page.published_feeds.table.actions": "Aktionen",
+    "page.published_feeds.new": "Einen neuen Feed veröffentlichen",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpunkt",
     "page.integration.miniflux_api_username": "Benutzername",
     "page.integration.miniflux_api_password": "Passwort",
     "page.integration.miniflux_api_password_value": "Ihr Konto Passwort",
+    "page.integration.newsletter": "Newsletter",
+    "page.integration.newsletter.help": "Abonnieren Sie E-Mail-Newsletter mit dieser Adresse. Ersetzen Sie „name“ durch ein beliebiges Wort, um Ihre Abonnements zu ordnen, jeder Absender wird zu einem Abonnement.",
+    "page.integration.newsletter.address": "E-Mail-Adresse",
+    "page.integration.newsletter.unsubscribe": "Entfernen Sie das erzeugte Abonnement, um einen Newsletter nicht mehr zu erhalten.",
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Mit Miniflux abonnieren",
     "page.integration.bookmarklet.instructions": "Ziehen Sie diesen Link in Ihre Lesezeichen.",
     "page.integration.bookmarklet.help": "Dieser spezielle Link ermöglicht es, eine Webseite direkt über ein Lesezeichen im Browser zu abonnieren.",
+    "page.integration.deliveries": "Letzte Übermittlungen",
+    "page.integration.deliveries.table.date": "Datum",
+    "page.integration.deliveries.table.entry": "Artikel",
+    "page.integration.deliveries.table.service": "Dienst",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Aktionen",
+    "page.integration.deliveries.status.pending": "Ausstehend",
+    "page.integration.deliveries.status.success": "Gesendet",
+    "page.integration.deliveries.status.failed": "Fehlgeschlagen",
     "page.sessions.title": "Sitzungen",
     "page.sessions.table.date": "Datum",
     "page.sessions.table.ip": "IP Addresse",
     "page.sessions.table.user_agent": "Benutzeragent",
     "page.sessions.table.actions": "Aktionen",
     "page.sessions.table.current_session": "Aktuelle Sitzung",
+    "page.shared_entries.title": "Geteilte Artikel",
+    "page.shared_entries.table.title": "Titel",
+    "page.shared_entries.table.actions": "Aktionen",
+    "alert.no_shared_entry": "Es gibt keinen geteilten Artikel.",
     "alert.no_bookmark": "Es existiert derzeit kein Lesezeichen.",
+    "alert.no_snoozed_entry": "Es gibt keine zurückgestellten Artikel.",
     "alert.no_category": "Es ist keine Kategorie vorhanden.",
     "alert.no_category_entry": "Es befindet sich kein Artikel in dieser Kategorie.",
     "alert.no_feed_entry": "Es existiert kein Artikel für dieses Abonnement.",
@@ -185,6 +320,7 @@ var translations = map[string]string{
     "alert.no_history": "Es existiert zur Zeit kein Verlauf.",
     "alert.feed_error": "Es gibt ein Problem mit diesem Abonnement",
     "alert.no_search_result": "Es gibt kein Ergebnis für diese Suche.",
+    "alert.no_saved_search": "Es gibt keine gespeicherte Suche.",
     "alert.no_unread_entry": "Es existiert kein ungelesener Artikel.",
     "alert.no_user": "Sie sind der einzige Benutzer.",
     "alert.account_unlinked": "Ihr externer Account ist jetzt getrennt!",
@@ -202,6 +338,16 @@ var translations = map[string]string{
     "error.user_already_exists": "Dieser Benutzer existiert bereits.",
     "error.unable_to_create_user": "Dieser Benutzer kann nicht erstellt werden.",
     "error.unable_to_update_user": "Dieser Benutzer konnte nicht aktualisiert werden.",
+    "error.invalid_email": "Diese E-Mail-Adresse ist ungültig.",
+    "error.invalid_digest_frequency": "Die Häufigkeit der Zusammenfassung muss täglich oder wöchentlich sein.",
+    "error.invalid_digest_schedule": "Der Versandzeitpunkt der Zusammenfassung ist ungültig.",
+    "error.unable_to_update_digest": "Die Einstellungen der Zusammenfassung konnten nicht aktualisiert werden.",
+    "error.invalid_published_feed_source": "Bitte wählen Sie die zu veröffentlichenden Artikel aus.",
+    "error.unable_to_create_published_feed": "Dieser Feed konnte nicht veröffentlicht werden.",
+    "error.opml_subscription_url_required": "Die URL der OPML-Datei ist obligatorisch.",
+    "error.invalid_opml_subscription_url": "Die URL der OPML-Datei ist ungültig.",
+    "error.opml_subscription_already_exists": "Sie folgen dieser OPML-Datei bereits.",
+    "error.unable_to_create_opml_subscription": "Dieser OPML-Datei kann nicht gefolgt werden.",
     "error.unable_to_update_feed": "Dieses Abonnement konnte nicht aktualisiert werden.",
     "error.subscription_not_found": "Es wurden keine Abonnements gefunden.",
     "error.empty_file": "Diese Datei ist leer.",
@@ -211,8 +357,18 @@ var translations = map[string]string{
     "error.different_passwords": "Passwörter stimmen nicht überein.",
     "error.password_min_length": "Wenigstens 6 Zeichen müssen genutzt werden.",
     "error.settings_mandatory_fields": "Die Felder für Benutzername, Thema, Sprache und Zeitzone sind obligatorisch.",
+    "error.invalid_search_language": "Diese Suchsprache wird nicht unterstützt.",
+    "error.invalid_retention_policy": "Die Aufbewahrungseinstellungen müssen positive Zahlen sein, oder -1, um sie zu deaktivieren.",
     "error.feed_mandatory_fields": "Die URL und die Kategorie sind obligatorisch.",
+    "error.item_selector_mandatory": "Der Artikel-Selektor ist obligatorisch.",
     "error.user_mandatory_fields": "Der Benutzername ist obligatorisch.",
+    "error.webhook_url_required": "Die Webhook-URL ist erforderlich.",
+    "error.saved_search_query_required": "Die Suchanfrage ist obligatorisch.",
+    "error.invalid_saved_search_status": "Dieser Status wird nicht unterstützt.",
+    "error.saved_search_already_exists": "Diese gespeicherte Suche existiert bereits.",
+    "error.unable_to_create_saved_search": "Diese gespeicherte Suche kann nicht erstellt werden.",
+    "error.unable_to_update_saved_search": "Diese gespeicherte Suche kann nicht aktualisiert werden.",
+    "error.category_not_found": "Diese Kategorie existiert nicht oder gehört nicht zu diesem Benutzer.",
     "form.feed.label.title": "Titel",
     "form.feed.label.site_url": "Webseite-URL",
     "form.feed.label.feed_url": "Abonnement-URL",
@@ -223,8 +379,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Standardbenutzeragenten überschreiben",
     "form.feed.label.scraper_rules": "Extraktionsregeln",
     "form.feed.label.rewrite_rules": "Umschreiberegeln",
+    "form.feed.label.item_selector": "Artikel-Selektor",
+    "form.feed.label.title_selector": "Titel-Selektor",
+    "form.feed.label.link_selector": "Link-Selektor",
+    "form.feed.label.date_selector": "Datum-Selektor",
+    "form.feed.label.content_selector": "Inhalt-Selektor",
     "form.feed.label.disabled": "Dieses Abonnement nicht aktualisieren",
+    "form.feed.label.notify": "Benachrichtigungen für neue Artikel senden (Matrix, Telegram)",
+    "form.feed.label.search_language": "Sprache der Artikel für die Suche",
+    "form.feed.select.search_language_automatic": "Automatisch (Suchsprache der Einstellungen)",
+    "form.feed.select.search_language_detected": "Automatisch (vom Abonnement angegeben: %s)",
     "form.category.label.title": "Titel",
+    "form.retention.title": "Aufbewahrungsrichtlinie",
+    "form.retention.help": "Lassen Sie ein Feld leer, um die Einstellung der Kategorie oder die globale Einstellung zu übernehmen, verwenden Sie -1, um sie zu deaktivieren. Markierte, geteilte, zurückgestellte, kommentierte und importierte Artikel werden immer behalten.",
+    "form.retention.label.archive_read_days": "Gelesene Artikel nach dieser Anzahl von Tagen entfernen",
+    "form.retention.label.max_entries": "Höchstens diese Anzahl von Artikeln behalten",
+    "form.retention.label.mark_as_read_days": "Ungelesene Artikel nach dieser Anzahl von Tagen als gelesen markieren",
+    "form.saved_search.label.title": "Titel",
+    "form.saved_search.label.query": "Suchanfrage",
+    "form.saved_search.label.status": "Artikel",
+    "form.saved_search.label.category": "Kategorie",
+    "form.saved_search.select.all_entries": "Alle Artikel",
+    "form.saved_search.select.unread_entries": "Ungelesene Artikel",
+    "form.saved_search.select.read_entries": "Gelesene Artikel",
+    "form.saved_search.select.all_categories": "Alle Kategorien",
     "form.user.label.username": "Benutzername",
     "form.user.label.password": "Passwort",
     "form.user.label.confirmation": "Passwort Bestätigung",
@@ -235,9 +413,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Sortierung der Artikel",
     "form.prefs.select.older_first": "Älteste Artikel zuerst",
     "form.prefs.select.recent_first": "Neueste Artikel zuerst",
+    "form.prefs.label.search_language": "Suchsprache, für Abonnements ohne Sprachangabe",
+    "form.prefs.select.search_language_automatic": "Sprache der Benutzeroberfläche",
     "form.prefs.label.keyboard_shortcuts": "Tastaturkürzel aktivieren",
+    "form.digest.label.enabled": "Eine Zusammenfassung ungelesener Artikel per E-Mail senden",
+    "form.digest.label.email": "E-Mail-Adresse",
+    "form.digest.label.frequency": "Häufigkeit",
+    "form.digest.label.weekday": "Wochentag (wöchentliche Zusammenfassung)",
+    "form.digest.label.hour": "Uhrzeit (in Ihrer Zeitzone)",
+    "form.digest.label.categories": "Kategorien (alle Kategorien, wenn keine ausgewählt ist)",
+    "form.digest.label.mark_as_read": "Artikel nach dem Versand als gelesen markieren",
+    "form.digest.select.daily": "Täglich",
+    "form.digest.select.weekly": "Wöchentlich",
+    "form.published_feed.label.source": "Artikel",
+    "form.published_feed.select.starred": "Lesezeichen",
+    "form.published_feed.select.category": "Artikel einer Kategorie",
+    "form.published_feed.label.category": "Kategorie",
+    "form.published_feed.select.tag": "Artikel mit einem Schlagwort",
+    "form.published_feed.label.tag": "Schlagwort",
+    "form.published_feed.help.tag": "Schlagwörter stammen aus den Kategorien, die Feeds ihren Artikeln zuweisen.",
+    "form.published_feed.label.title": "Titel (optional)",
+    "weekday.monday": "Montag",
+    "weekday.tuesday": "Dienstag",
+    "weekday.wednesday": "Mittwoch",
+    "weekday.thursday": "Donnerstag",
+    "weekday.friday": "Freitag",
+    "weekday.saturday": "Samstag",
+    "weekday.sunday": "Sonntag",
+    "email.digest.subject": "Miniflux-Zusammenfassung: %d ungelesene Artikel",
+    "email.digest.marked_as_read": "Diese Artikel wurden als gelesen markiert.",
+    "email.digest.open": "Miniflux öffnen",
     "form.import.label.file": "OPML Datei",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "URL der OPML-Datei",
+    "form.opml_subscription.label.remove_missing": "Aus der Datei entfernte Abonnements löschen",
     "form.integration.fever_activate": "Fever API aktivieren",
     "form.integration.fever_username": "Fever Benutzername",
     "form.integration.fever_password": "Fever Passwort",
@@ -262,6 +471,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Artikel in Nunux Keeper speichern",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API-Endpunkt",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-Schlüssel",
+    "form.integration.linkding_activate": "Artikel in Linkding speichern",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API-Schlüssel",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Lesezeichen als ungelesen markieren",
+    "form.integration.shaarli_activate": "Artikel in Shaarli speichern",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API-Geheimnis",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Links als privat markieren",
+    "form.integration.matrix_activate": "Neue Artikel an einen Matrix-Raum senden",
+    "form.integration.matrix_homeserver": "URL des Matrix-Homeservers",
+    "form.integration.matrix_access_token": "Matrix Zugriffstoken",
+    "form.integration.matrix_room_id": "Matrix Raum-ID",
+    "form.integration.telegram_activate": "Neue Artikel an einen Telegram-Chat senden",
+    "form.integration.telegram_bot_token": "Telegram Bot-Token",
+    "form.integration.telegram_chat_id": "Telegram Chat-ID",
+    "form.integration.notifications_help": "Es werden nur neue Artikel von Abonnements mit aktivierten Benachrichtigungen gesendet.",
+    "form.integration.webhook_activate": "Ereignisse an einen Webhook senden",
+    "form.integration.webhook_url": "Webhook-URL",
+    "form.integration.webhook_new_entries": "Neue Artikel senden",
+    "form.integration.webhook_save_entry": "Gespeicherte Artikel senden",
+    "form.integration.webhook_secret": "Webhook-Geheimnis zum Signieren der Anfragen:",
+    "form.integration.automatic_delivery": "Automatische Übermittlung",
+    "form.integration.automatic_on_bookmark": "Artikel automatisch senden, wenn sie zu den Lesezeichen hinzugefügt werden",
+    "form.integration.automatic_categories": "Neue Artikel aus diesen Kategorien senden",
+    "form.integration.automatic_feeds": "Neue Artikel aus diesen Abonnements senden",
+    "form.integration.automatic_keywords": "Neue Artikel mit diesen Stichwörtern senden (durch Kommas getrennt)",
     "form.submit.loading": "Lade...",
     "form.submit.saving": "Speichern...",
     "time_elapsed.not_yet": "noch nicht",
@@ -301,12 +538,22 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Atom Abonnement konnte nicht gelesen werden: %q",
     "Unable to parse JSON feed: %q": "JSON Abonnement konnte nicht gelesen werden: %q",
     "Unable to parse RDF feed: %q": "RDF Abonnement konnte nicht gelesen werden: %q",
+    "The item selector is mandatory": "Der Artikel-Selektor ist obligatorisch",
+    "Unable to parse this web page: %q": "Diese Webseite konnte nicht gelesen werden: %q",
+    "No item found with the selector %q": "Kein Artikel mit dem Selektor %q gefunden",
     "Unable to normalize encoding: %q": "Zeichenkodierung konnte nicht normalisiert werden: %q",
     "This feed is empty": "Dieses Abonnement ist leer",
     "This web page is empty": "Diese Webseite ist leer",
     "Invalid SSL certificate (original error: %q)": "Ungültiges SSL-Zertifikat (ursprünglicher Fehler: %q)",
     "This website is temporarily unreachable (original error: %q)": "Diese Webseite ist vorübergehend nicht erreichbar (ursprünglicher Fehler: %q)",
     "This website is permanently unreachable (original error: %q)": "Diese Webseite ist dauerhaft nicht erreichbar (ursprünglicher Fehler: %q)",
+    "Access to this network address is not allowed: %s": "Der Zugriff auf diese Netzwerkadresse ist nicht erlaubt: %s",
+    "Invalid search query: a quotation mark is not closed": "Ungültige Suchanfrage: ein Anführungszeichen wird nicht geschlossen",
+    "Invalid search query: OR must be placed between two search terms": "Ungültige Suchanfrage: OR muss zwischen zwei Suchbegriffen stehen",
+    "Invalid search query: unknown state %q, use is:unread, is:read or is:starred": "Ungültige Suchanfrage: unbekannter Status %q, verwenden Sie is:unread, is:read oder is:starred",
+    "Invalid search query: the date %q must use the format YYYY-MM-DD": "Ungültige Suchanfrage: das Datum %q muss das Format JJJJ-MM-TT verwenden",
+    "Invalid search query: the filter %s: requires a value": "Ungültige Suchanfrage: der Filter %s: benötigt einen Wert",
+    "Invalid search query: the filter %s: cannot be excluded": "Ungültige Suchanfrage: der Filter %s: kann nicht ausgeschlossen werden",
     "Website unreachable, the request timed out after %d seconds": "Webseite nicht erreichbar, die Anfrage endete nach %d Sekunden",
     "You are not authorized to access this resource (invalid username/password)": "Sie sind nicht berechtigt, auf diese Ressource zuzugreifen (Benutzername/Passwort ungültig)",
     "Unable to fetch this resource (Status Code = %d)": "Ressource konnte nicht abgerufen werden (code=%d)",
@@ -321,6 +568,7 @@ var translations = map[string]string{
     "action.subscribe": "Subscribe",
     "action.save": "Save",
     "action.or": "or",
+    "action.preview": "Preview",
     "action.cancel": "cancel",
     "action.remove": "Remove",
     "action.remove_feed": "Remove this feed",
@@ -330,6 +578,10 @@ var translations = map[string]string{
     "action.import": "Import",
     "action.login": "Login",
     "action.home_screen": "Add to home screen",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "Keyboard Shortcut: %s",
     "tooltip.logged_user": "Logged as %s",
     "menu.unread": "Unread",
@@ -341,11 +593,15 @@ var translations = map[string]string{
     "menu.logout": "Logout",
     "menu.preferences": "Preferences",
     "menu.integrations": "Integrations",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "Sessions",
     "menu.users": "Users",
     "menu.about": "About",
     "menu.export": "Export",
     "menu.import": "Import",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "Create a category",
     "menu.mark_page_as_read": "Mark this page as read",
     "menu.mark_all_as_read": "Mark all as read",
@@ -356,11 +612,35 @@ var translations = map[string]string{
     "menu.edit_feed": "Edit",
     "menu.edit_category": "Edit",
     "menu.add_feed": "Add subscription",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "Add user",
     "menu.flush_history": "Flush history",
     "menu.feed_entries": "Entries",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "Search",
     "search.placeholder": "Search...",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "Next",
     "pagination.previous": "Previous",
     "entry.status.unread": "Unread",
@@ -368,10 +648,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "Marked as unread",
     "entry.status.toast.read": "Marked as read",
     "entry.status.title": "Change entry status",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "Star",
     "entry.bookmark.toggle.off": "Unstar",
     "entry.bookmark.toast.on": "Starred",
     "entry.bookmark.toast.off": "Unstarred",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "Saving...",
     "entry.state.loading": "Loading...",
     "entry.save.label": "Save",
@@ -384,8 +675,13 @@ var translations = map[string]string{
     "entry.original.label": "Original",
     "entry.comments.label": "Comments",
     "entry.comments.title": "View Comments",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "Unread",
     "page.starred.title": "Starred",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "Categories",
     "page.categories.no_feed": "No feed.",
     "page.categories.feeds": "See subscriptions",
@@ -396,6 +692,10 @@ var translations = map[string]string{
     "page.new_category.title": "New Category",
     "page.new_user.title": "New User",
     "page.edit_category.title": "Edit Category: %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "Edit User: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Last check:",
@@ -407,6 +707,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "History",
     "page.import.title": "Import",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.search.title": "Search Results",
     "page.about.title": "About",
     "page.about.credits": "Credits",
@@ -420,6 +749,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Find a subscription",
     "page.add_feed.legend.advanced_options": "Advanced Options",
     "page.add_feed.choose_feed": "Choose a Subscription",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "Edit Feed: %s",
     "page.edit_feed.last_check": "Last check:",
     "page.edit_feed.last_modified_header": "LastModified header:",
@@ -427,6 +759,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "None",
     "page.edit_feed.last_parsing_error": "Last Parsing Error",
     "page.entry.attachments": "Attachments",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "Keyboard Shortcuts",
     "page.keyboard_shortcuts.subtitle.sections": "Sections Navigation",
     "page.keyboard_shortcuts.subtitle.items": "Items Navigation",
@@ -454,6 +806,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Download original content",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Toggle bookmark",
     "page.keyboard_shortcuts.save_article": "Save article",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "Remove this feed",
     "page.keyboard_shortcuts.go_to_search": "Set focus on search form",
     "page.keyboard_shortcuts.close_modal": "Close modal dialog",
@@ -471,22 +824,51 @@ var translations = map[string]string{
     "page.login.title": "Sign In",
     "page.login.google_signin": "Sign in with Google",
     "page.integrations.title": "Integrations",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
     "page.integration.miniflux_api_username": "Username",
     "page.integration.miniflux_api_password": "Password",
     "page.integration.miniflux_api_password_value": "Your account password",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Add to Miniflux",
     "page.integration.bookmarklet.instructions": "Drag and drop this link to your bookmarks.",
     "page.integration.bookmarklet.help": "This special link allows you to subscribe to a website directly by using a bookmark in your web browser.",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "Sessions",
     "page.sessions.table.date": "Date",
     "page.sessions.table.ip": "IP Address",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "Actions",
     "page.sessions.table.current_session": "Current Session",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "There is no bookmark at the moment.",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "There is no category.",
     "alert.no_category_entry": "There are no articles in this category.",
     "alert.no_feed_entry": "There are no articles for this feed.",
@@ -495,6 +877,7 @@ var translations = map[string]string{
     "alert.no_history": "There is no history at the moment.",
     "alert.feed_error": "There is a problem with this feed",
     "alert.no_search_result": "There are no results for this search.",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "There are no unread articles.",
     "alert.no_user": "You are the only user.",
     "alert.account_unlinked": "Your external account is now dissociated!",
@@ -512,6 +895,16 @@ var translations = map[string]string{
     "error.user_already_exists": "This user already exists.",
     "error.unable_to_create_user": "Unable to create this user.",
     "error.unable_to_update_user": "Unable to update this user.",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "Unable to update this feed.",
     "error.subscription_not_found": "Unable to find any subscription.",
     "error.empty_file": "This file is empty.",
@@ -521,8 +914,18 @@ var translations = map[string]string{
     "error.different_passwords": "Passwords are not the same.",
     "error.password_min_length": "The password must have at least 6 characters.",
     "error.settings_mandatory_fields": "The username, theme, language and timezone fields are mandatory.",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "The URL and the category are mandatory.",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "The username is mandatory.",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "Title",
     "form.feed.label.site_url": "Site URL",
     "form.feed.label.feed_url": "Feed URL",
@@ -533,8 +936,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Override Default User Agent",
     "form.feed.label.scraper_rules": "Scraper Rules",
     "form.feed.label.rewrite_rules": "Rewrite Rules",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "Do not refresh this feed",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "Title",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "Username",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Password Confirmation",
@@ -545,9 +970,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Entry Sorting",
     "form.prefs.select.older_first": "Older entries first",
     "form.prefs.select.recent_first": "Recent entries first",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.prefs.label.keyboard_shortcuts": "Enable keyboard shortcuts",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.import.label.file": "OPML file",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "Activate Fever API",
     "form.integration.fever_username": "Fever Username",
     "form.integration.fever_password": "Fever Password",
@@ -572,6 +1028,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Save articles to Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "Loading...",
     "form.submit.saving": "Saving...",
     "time_elapsed.not_yet": "not yet",
@@ -611,6 +1095,7 @@ var translations = map[string]string{
     "action.subscribe": "Suscribir",
     "action.save": "Guardar",
     "action.or": "o",
+    "action.preview": "Preview",
     "action.cancel": "Cancelar",
     "action.remove": "Quitar",
     "action.remove_feed": "Quitar esta fuente",
@@ -620,6 +1105,10 @@ var translations = map[string]string{
     "action.import": "Importar",
     "action.login": "Iniciar sesión",
     "action.home_screen": "Añadir a la pantalla principal",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "Atajo de teclado: %s",
     "tooltip.logged_user": "Registrado como %s",
     "menu.unread": "No leídos",
@@ -631,11 +1120,15 @@ var translations = map[string]string{
     "menu.logout": "Cerrar sesión",
     "menu.preferences": "Preferencias",
     "menu.integrations": "Integraciones",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "Sesiones",
     "menu.users": "Usuarios",
     "menu.about": "Acerca de",
     "menu.export": "Exportar",
     "menu.import": "Importar",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "Crear una categoría",
     "menu.mark_page_as_read": "Marcar esta pagína como leída",
     "menu.mark_all_as_read": "Marcar todos como leídos",
@@ -646,11 +1139,35 @@ var translations = map[string]string{
     "menu.edit_feed": "Editar",
     "menu.edit_category": "Editar",
     "menu.add_feed": "Agregar suscripción",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "Agregar usuario",
     "menu.flush_history": "Borrar historial",
     "menu.feed_entries": "Artículos",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "Buscar",
     "search.placeholder": "Búsqueda...",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "Siguiente",
     "pagination.previous": "Anterior",
     "entry.status.unread": "No leído",
@@ -658,10 +1175,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "Marcado como no leído",
     "entry.status.toast.read": "Marcado como leído",
     "entry.status.title": "Cambiar estado de entrada",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "Marcar",
     "entry.bookmark.toggle.off": "Desmarcar",
     "entry.bookmark.toast.on": "Sembrado de estrellas",
     "entry.bookmark.toast.off": "Sin estrellas",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "Guardando...",
     "entry.state.loading": "Cargando...",
     "entry.save.label": "Guardar",
@@ -674,8 +1202,13 @@ var translations = map[string]string{
     "entry.original.label": "Original",
     "entry.comments.label": "Comentarios",
     "entry.comments.title": "Ver comentarios",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "No leídos",
     "page.starred.title": "Marcadores",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "Categorias",
     "page.categories.no_feed": "No fuente.",
     "page.categories.feeds": "Ver suscripciones",
@@ -686,6 +1219,10 @@ var translations = map[string]string{
     "page.new_category.title": "Nueva categoría",
     "page.new_user.title": "Nuevo usario",
     "page.edit_category.title": "Editar categoría: %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "Editar usuario: %s",
     "page.feeds.title": "Fuentes",
     "page.feeds.last_check": "Última verificación:",
@@ -697,6 +1234,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "Historial",
     "page.import.title": "Importar",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.search.title": "Resultados de la búsqueda",
     "page.about.title": "Acerca de",
     "page.about.credits": "Creditos",
@@ -710,6 +1276,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Encontrar una suscripción",
     "page.add_feed.legend.advanced_options": "Opciones avanzadas",
     "page.add_feed.choose_feed": "Elegir una suscripción",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "Editar fuente: %s",
     "page.edit_feed.last_check": "Última verificación:",
     "page.edit_feed.last_modified_header": "Cabecera de LastModified:",
@@ -717,6 +1286,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Sin cabecera",
     "page.edit_feed.last_parsing_error": "Último error de análisis",
     "page.entry.attachments": "Archivos adjuntos",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "Atajos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegación de secciones",
     "page.keyboard_shortcuts.subtitle.items": "Navegación de artículos",
@@ -744,6 +1333,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Descargar el contento original",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Agregar o quitar marcador",
     "page.keyboard_shortcuts.save_article": "Guardar artículo",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "Quitar esta fuente",
     "page.keyboard_shortcuts.go_to_search": "Centrarse en el cuadro de búsqueda",
     "page.keyboard_shortcuts.close_modal": "Cerrar el cuadro de diálogo modal",
@@ -761,22 +1351,51 @@ var translations = map[string]string{
     "page.login.title": "Iniciar sesión",
     "page.login.google_signin": "Iniciar sesión con tu cuenta de Google",
     "page.integrations.title": "Integraciones",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "API de Miniflux",
     "page.integration.miniflux_api_endpoint": "Extremo de API",
     "page.integration.miniflux_api_username": "Nombre de usuario",
     "page.integration.miniflux_api_password": "Contraseña",
     "page.integration.miniflux_api_password_value": "Contraseña de tu cuenta",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Agregar a Miniflux",
     "page.integration.bookmarklet.instructions": "Arrastrar y soltar este enlace a tus marcadores del navegador.",
     "page.integration.bookmarklet.help": "Este enlace especial te permite suscribirte a un sitio de web directamente usando un marcador del navegador.",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "Sesiones",
     "page.sessions.table.date": "Fecha",
     "page.sessions.table.ip": "Dirección de IP",
     "page.sessions.table.user_agent": "Agente de usuario",
     "page.sessions.table.actions": "Acciones",
     "page.sessions.table.current_session": "Sesión actual",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "No hay marcador en este momento.",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "No hay categoría.",
     "alert.no_category_entry": "No hay artículos en esta categoria.",
     "alert.no_feed_entry": "No hay artículos para esta fuente.",
@@ -785,6 +1404,7 @@ var translations = map[string]string{
     "alert.no_history": "No hay historial en este momento.",
     "alert.feed_error": "Hay un problema con esta fuente.",
     "alert.no_search_result": "No hay resultados para esta búsqueda.",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "No hay artículos sin leer.",
     "alert.no_user": "Eres el unico usuario.",
     "alert.account_unlinked": "¡Tu cuenta externa ya está desvinculada!",
@@ -802,6 +1422,16 @@ var translations = map[string]string{
     "error.user_already_exists": "Este usuario ya existe.",
     "error.unable_to_create_user": "Incapaz de crear este usuario.",
     "error.unable_to_update_user": "Incapaz de actualizar este usuario.",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "Incapaz de actualizar esta fuente.",
     "error.subscription_not_found": "Incapaz de encontrar ninguna suscripción.",
     "error.empty_file": "Este archivo está vacío.",
@@ -811,8 +1441,18 @@ var translations = map[string]string{
     "error.different_passwords": "Las contraseñas no son las mismas.",
     "error.password_min_length": "La contraseña debería tener al menos 6 caracteres.",
     "error.settings_mandatory_fields": "Los campos de nombre de usuario, tema, idioma y zona horaria son obligatorios.",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "Los campos de URL y categoría son obligatorios.",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "El nombre de usuario es obligatorio.",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "Título",
     "form.feed.label.site_url": "URL del sitio",
     "form.feed.label.feed_url": "URL de la fuente",
@@ -823,8 +1463,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Invalidar el agente de usuario predeterminado",
     "form.feed.label.scraper_rules": "Reglas de raspador",
     "form.feed.label.rewrite_rules": "Reglas de reescribir",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "No actualice este feed",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "Título",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "Nombre de usuario",
     "form.user.label.password": "Contraseña",
     "form.user.label.confirmation": "Confirmación de contraseña",
@@ -835,9 +1497,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Clasificación de entradas",
     "form.prefs.select.older_first": "Entradas más viejas primero",
     "form.prefs.select.recent_first": "Entradas recientes primero",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atajos de teclado",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.import.label.file": "Archivo OPML",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "Activar API de Fever",
     "form.integration.fever_username": "Nombre de usuario de Fever",
     "form.integration.fever_password": "Contraseña de Fever",
@@ -862,6 +1555,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Guardar artículos a Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Extremo de API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clave de API de Nunux Keeper",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "Cargando...",
     "form.submit.saving": "Guardando...",
     "time_elapsed.not_yet": "todavía no",
@@ -901,6 +1622,7 @@ var translations = map[string]string{
     "action.subscribe": "S'abonner",
     "action.save": "Sauvegarder",
     "action.or": "ou",
+    "action.preview": "Aperçu",
     "action.cancel": "annuler",
     "action.remove": "Supprimer",
     "action.remove_feed": "Supprimer ce flux",
@@ -910,6 +1632,10 @@ var translations = map[string]string{
     "action.import": "Importer",
     "action.login": "Se connecter",
     "action.home_screen": "Ajouter à l'écran d'accueil",
+    "action.retry": "Réessayer",
+    "action.publish": "Publier",
+    "action.revoke": "Révoquer",
+    "action.sync": "Synchroniser",
     "tooltip.keyboard_shortcuts": "Raccourci clavier : %s",
     "tooltip.logged_user": "Connecté en tant que %s",
     "menu.unread": "Non lus",
@@ -921,11 +1647,15 @@ var translations = map[string]string{
     "menu.logout": "Se déconnecter",
     "menu.preferences": "Préférences",
     "menu.integrations": "Intégrations",
+    "menu.digest": "Résumé par courriel",
+    "menu.published_feeds": "Flux publics",
+    "menu.shared_entries": "Articles partagés",
     "menu.sessions": "Sessions",
     "menu.users": "Utilisateurs",
     "menu.about": "A propos",
     "menu.export": "Export",
     "menu.import": "Import",
+    "menu.opml_subscriptions": "Abonnements OPML",
     "menu.create_category": "Créer une catégorie",
     "menu.mark_page_as_read": "Marquer cette page comme lu",
     "menu.mark_all_as_read": "Tout marquer comme lu",
@@ -936,22 +1666,57 @@ var translations = map[string]string{
     "menu.edit_feed": "Modifier",
     "menu.edit_category": "Modifier",
     "menu.add_feed": "Ajouter un abonnement",
+    "menu.scrape_page": "Ajouter à partir d'une page web",
     "menu.add_user": "Ajouter un utilisateur",
     "menu.flush_history": "Supprimer l'historique",
     "menu.feed_entries": "Articles",
+    "menu.saved_searches": "Recherches enregistrées",
+    "menu.snoozed_entries": "Articles reportés (%d)",
+    "menu.save_search": "Enregistrer cette recherche",
+    "menu.create_saved_search": "Enregistrer une recherche",
+    "menu.edit_saved_search": "Modifier",
+    "menu.show_saved_search_entries": "Articles",
     "search.label": "Recherche",
     "search.placeholder": "Recherche...",
+    "search.syntax": "Utilisez des guillemets pour les expressions, -mot pour exclure, OR entre les mots, et les filtres feed:, category:, author:, is:unread, is:read, is:starred, before:AAAA-MM-JJ et after:AAAA-MM-JJ",
+    "search_language.danish": "Danois",
+    "search_language.dutch": "Néerlandais",
+    "search_language.english": "Anglais",
+    "search_language.finnish": "Finnois",
+    "search_language.french": "Français",
+    "search_language.german": "Allemand",
+    "search_language.hungarian": "Hongrois",
+    "search_language.italian": "Italien",
+    "search_language.norwegian": "Norvégien",
+    "search_language.portuguese": "Portugais",
+    "search_language.romanian": "Roumain",
+    "search_language.russian": "Russe",
+    "search_language.simple": "Autre langue (sans racinisation)",
+    "search_language.spanish": "Espagnol",
+    "search_language.swedish": "Suédois",
+    "search_language.turkish": "Turc",
     "pagination.next": "Suivant",
     "pagination.previous": "Précédent",
     "entry.status.unread": "Non lu",
     "entry.status.read": "Lu",
     "entry.status.title": "Changer le statut de l'entrée",
+    "entry.snooze.title": "Masquer cet article des articles non lus jusqu'à demain matin",
+    "entry.snooze.label": "Reporter",
+    "entry.snooze.later_today": "Plus tard aujourd'hui",
+    "entry.snooze.tomorrow": "Demain matin",
+    "entry.snooze.next_week": "La semaine prochaine",
+    "entry.snooze.custom": "Reporter à cette date",
+    "entry.snooze.toast": "Reporté",
+    "entry.unsnooze.label": "Annuler le report",
+    "entry.unsnooze.toast": "Report annulé",
     "entry.status.toast.unread": "Marqué comme non lu",
     "entry.status.toast.read": "Marqué comme lu",
     "entry.bookmark.toggle.on": "Favoris",
     "entry.bookmark.toggle.off": "Enlever favoris",
     "entry.bookmark.toast.on": "Ajouté aux favoris",
     "entry.bookmark.toast.off": "Enlevé des favoris",
+    "entry.played.toggle.on": "Marquer comme écouté",
+    "entry.played.toggle.off": "Marquer comme non écouté",
     "entry.state.saving": "Sauvegarde en cours...",
     "entry.state.loading": "Chargement...",
     "entry.save.label": "Sauvegarder",
@@ -964,8 +1729,13 @@ var translations = map[string]string{
     "entry.original.label": "Original",
     "entry.comments.label": "Commentaires",
     "entry.comments.title": "Voir les commentaires",
+    "entry.share.label": "Partager",
+    "entry.share.title": "Créer un lien public vers cet article",
+    "entry.shared_entry.label": "Lien public",
+    "entry.shared_entry.title": "Ouvrir le lien public",
     "page.unread.title": "Non lus",
     "page.starred.title": "Favoris",
+    "page.snoozed.title": "Articles reportés",
     "page.categories.title": "Catégories",
     "page.categories.no_feed": "Aucun abonnement.",
     "page.categories.feeds": "Voir les abonnements",
@@ -976,6 +1746,10 @@ var translations = map[string]string{
     "page.new_category.title": "Nouvelle catégorie",
     "page.new_user.title": "Nouvel Utilisateur",
     "page.edit_category.title": "Modification de la catégorie : %s",
+    "page.saved_searches.title": "Recherches enregistrées",
+    "page.saved_searches.unread_count": "Articles non lus",
+    "page.new_saved_search.title": "Nouvelle recherche enregistrée",
+    "page.edit_saved_search.title": "Modification de la recherche enregistrée : %s",
     "page.edit_user.title": "Modification de l'utilisateur : %s",
     "page.feeds.title": "Abonnements",
     "page.feeds.last_check": "Dernière vérification :",
@@ -987,6 +1761,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "Historique",
     "page.import.title": "Importation",
+    "page.import.report.summary": "%d abonnements importés, %d ignorés car ils existent déjà, %d en erreur.",
+    "page.import.report.table.feed": "Abonnement",
+    "page.import.report.table.category": "Catégorie",
+    "page.import.report.table.status": "Statut",
+    "page.import.report.status.created": "Importé",
+    "page.import.report.status.duplicate": "Déjà abonné",
+    "page.import.report.status.failed": "Erreur",
+    "page.import.formats": "Fichiers supportés : OPML, export JSON de Tiny Tiny RSS, export de FreshRSS (favoris et étiquettes) et starred.json de Google Takeout.",
+    "page.import.entries_report.summary": "%d articles importés, %d articles mis à jour, %d nouveaux abonnements.",
+    "page.import.entries_report.archived": "%d articles n'indiquent pas leur flux d'origine et ont été enregistrés dans un flux d'archive.",
+    "page.import.archive_feed_title": "Articles importés (%s)",
+    "page.opml_subscriptions.title": "Abonnements OPML",
+    "page.opml_subscriptions.help": "Les flux listés dans un fichier OPML distant sont ajoutés à vos abonnements et déplacés dans les catégories du fichier. Le fichier est vérifié périodiquement.",
+    "page.opml_subscriptions.no_subscription": "Il n'y a aucun abonnement OPML.",
+    "page.opml_subscriptions.new": "Suivre un fichier OPML",
+    "page.opml_subscriptions.changes": "Changements récents",
+    "page.opml_subscriptions.remove_missing": "Les flux retirés du fichier sont désabonnés.",
+    "page.opml_subscriptions.never_checked": "Jamais",
+    "page.opml_subscriptions.table.url": "Fichier OPML",
+    "page.opml_subscriptions.table.checked_at": "Dernière vérification",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Flux",
+    "page.opml_subscriptions.table.category": "Catégorie",
+    "page.opml_subscriptions.table.action": "Changement",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Ajouté",
+    "page.opml_subscriptions.action.removed": "Supprimé",
+    "page.opml_subscriptions.action.moved": "Déplacé depuis %s",
+    "page.opml_subscriptions.action.failed": "Échec",
     "page.search.title": "Résultats de la recherche",
     "page.about.title": "A propos",
     "page.about.credits": "Crédits",
@@ -1000,6 +1803,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Trouver un abonnement",
     "page.add_feed.legend.advanced_options": "Options avancées",
     "page.add_feed.choose_feed": "Choisissez un abonnement",
+    "page.scrape_feed.title": "Nouvel abonnement à partir d'une page web",
+    "page.scrape_feed.help": "Les articles sont extraits de la page web avec des sélecteurs CSS. Les sélecteurs du titre, du lien, de la date et du contenu sont relatifs à chaque article, le premier lien de l'article est utilisé par défaut.",
+    "page.add_feed.scrape_suggestion": "Aucun flux n'a été trouvé, vous pouvez en créer un à partir de cette page web avec des sélecteurs CSS.",
     "page.edit_feed.title": "Modification de l'abonnement : %s",
     "page.edit_feed.last_check": "Dernière vérification :",
     "page.edit_feed.last_modified_header": "En-tête LastModified :",
@@ -1007,6 +1813,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Aucune",
     "page.edit_feed.last_parsing_error": "Dernière erreur d'analyse",
     "page.entry.attachments": "Pièces Jointes",
+    "page.entry.snooze": "Reporter",
+    "page.entry.snoozed_until": "Reporté jusqu'au %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Saison %d",
+    "page.entry.podcast.episode": "Épisode %s",
+    "page.entry.podcast.chapters": "Chapitres",
+    "page.entry.podcast.transcripts": "Transcriptions",
+    "page.entry.podcast.transcript": "Transcription",
+    "page.entry.podcast.captions": "Sous-titres",
+    "page.entry.podcast.persons": "Intervenants",
+    "page.entry.podcast.funding": "Soutenir ce podcast :",
+    "page.entry.annotations": "Notes et surlignages",
+    "page.entry.highlights": "Passages surlignés",
+    "page.entry.highlight.label": "Surligner le texte sélectionné",
+    "page.entry.highlight.title": "Sélectionnez un passage de l'article, puis cliquez ici pour le surligner",
+    "page.entry.highlight.empty_selection": "Sélectionnez d'abord un passage de l'article",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Note privée, la syntaxe Markdown est supportée",
+    "page.entry.note.save": "Enregistrer la note",
+    "page.entry.note.toast.saved": "Note enregistrée",
     "page.keyboard_shortcuts.title": "Raccourcis clavier",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguation entre les sections",
     "page.keyboard_shortcuts.subtitle.items": "Naviguation entre les éléments",
@@ -1034,6 +1860,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Télécharger le contenu original",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Ajouter/Enlever favoris",
     "page.keyboard_shortcuts.save_article": "Sauvegarder l'article",
+    "page.keyboard_shortcuts.snooze_entry": "Reporter à demain matin",
     "page.keyboard_shortcuts.remove_feed": "Supprimer ce flux",
     "page.keyboard_shortcuts.go_to_search": "Mettre le focus sur le champ de recherche",
     "page.keyboard_shortcuts.close_modal": "Fermer la boite de dialogue",
@@ -1051,22 +1878,51 @@ var translations = map[string]string{
     "page.login.title": "Connexion",
     "page.login.google_signin": "Se connecter avec Google",
     "page.integrations.title": "Intégrations",
+    "page.digest.title": "Résumé par courriel",
+    "page.digest.smtp_not_configured": "Aucun serveur SMTP n'est configuré, les résumés ne seront pas envoyés tant que l'administrateur n'en définit pas un.",
+    "page.digest.last_sent": "Dernier résumé :",
+    "page.published_feeds.title": "Flux publics",
+    "page.published_feeds.help": "Toute personne connaissant l'adresse d'un flux public peut le lire sans compte. Révoquez un flux pour désactiver son adresse.",
+    "page.published_feeds.no_feed": "Vous n'avez encore publié aucun flux.",
+    "page.published_feeds.table.title": "Titre",
+    "page.published_feeds.table.links": "Adresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publier un nouveau flux",
     "page.integration.miniflux_api": "API de Miniflux",
     "page.integration.miniflux_api_endpoint": "Point de terminaison de l'API",
     "page.integration.miniflux_api_username": "Nom d'utilisateur",
     "page.integration.miniflux_api_password": "Mot de passe",
     "page.integration.miniflux_api_password_value": "Le mot de passe de votre compte",
+    "page.integration.newsletter": "Lettres d'information",
+    "page.integration.newsletter.help": "Abonnez-vous à des lettres d'information avec cette adresse. Remplacez « name » par le mot de votre choix pour classer vos abonnements, chaque expéditeur devient un abonnement.",
+    "page.integration.newsletter.address": "Adresse email",
+    "page.integration.newsletter.unsubscribe": "Supprimez l'abonnement généré pour ne plus recevoir une lettre d'information.",
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Ajouter à Miniflux",
     "page.integration.bookmarklet.instructions": "Glisser-déposer ce lien dans vos favoris.",
     "page.integration.bookmarklet.help": "Ce lien spécial vous permet de vous abonner à un site web directement en utilisant un marque page dans votre navigateur web.",
+    "page.integration.deliveries": "Envois récents",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Statut",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "En attente",
+    "page.integration.deliveries.status.success": "Envoyé",
+    "page.integration.deliveries.status.failed": "Échec",
     "page.sessions.title": "Sessions",
     "page.sessions.table.date": "Date",
     "page.sessions.table.ip": "Adresse IP",
     "page.sessions.table.user_agent": "Navigateur Web",
     "page.sessions.table.actions": "Actions",
     "page.sessions.table.current_session": "Session actuelle",
+    "page.shared_entries.title": "Articles partagés",
+    "page.shared_entries.table.title": "Titre",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "Il n'y a aucun article partagé.",
     "alert.no_bookmark": "Il n'y a aucun favoris pour le moment.",
+    "alert.no_snoozed_entry": "Il n'y a aucun article reporté.",
     "alert.no_category": "Il n'y a aucune catégorie.",
     "alert.no_category_entry": "Il n'y a aucun article dans cette catégorie.",
     "alert.no_feed_entry": "Il n'y a aucun article pour cet abonnement.",
@@ -1075,6 +1931,7 @@ var translations = map[string]string{
     "alert.no_history": "Il n'y a aucun historique pour le moment.",
     "alert.feed_error": "Il y a un problème avec cet abonnement",
     "alert.no_search_result": "Il n'y a aucun résultat pour cette recherche.",
+    "alert.no_saved_search": "Il n'y a aucune recherche enregistrée.",
     "alert.no_unread_entry": "Il n'y a rien de nouveau à lire.",
     "alert.no_user": "Vous êtes le seul utilisateur.",
     "alert.account_unlinked": "Votre compte externe est maintenant dissocié !",
@@ -1092,6 +1949,16 @@ var translations = map[string]string{
     "error.user_already_exists": "Cet utilisateur existe déjà.",
     "error.unable_to_create_user": "Impossible de créer cet utilisateur.",
     "error.unable_to_update_user": "Impossible de mettre à jour cet utilisateur.",
+    "error.invalid_email": "Cette adresse de courriel n'est pas valide.",
+    "error.invalid_digest_frequency": "La fréquence du résumé doit être quotidienne ou hebdomadaire.",
+    "error.invalid_digest_schedule": "L'heure d'envoi du résumé n'est pas valide.",
+    "error.unable_to_update_digest": "Impossible de mettre à jour les paramètres du résumé.",
+    "error.invalid_published_feed_source": "Veuillez sélectionner les articles à publier.",
+    "error.unable_to_create_published_feed": "Impossible de publier ce flux.",
+    "error.opml_subscription_url_required": "L'URL du fichier OPML est obligatoire.",
+    "error.invalid_opml_subscription_url": "L'URL du fichier OPML n'est pas valide.",
+    "error.opml_subscription_already_exists": "Vous suivez déjà ce fichier OPML.",
+    "error.unable_to_create_opml_subscription": "Impossible de suivre ce fichier OPML.",
     "error.unable_to_update_feed": "Impossible de mettre à jour cet abonnement.",
     "error.subscription_not_found": "Impossible de trouver un abonnement.",
     "error.empty_file": "Ce fichier est vide.",
@@ -1101,8 +1968,18 @@ var translations = map[string]string{
     "error.different_passwords": "Les mots de passe ne sont pas les mêmes.",
     "error.password_min_length": "Vous devez utiliser au moins 6 caractères pour le mot de passe.",
     "error.settings_mandatory_fields": "Le nom d'utilisateur, le thème, la langue et le fuseau horaire sont obligatoire.",
+    "error.invalid_search_language": "Cette langue de recherche n'est pas supportée.",
+    "error.invalid_retention_policy": "Les paramètres de rétention doivent être des nombres positifs, ou -1 pour les désactiver.",
     "error.feed_mandatory_fields": "L'URL et la catégorie sont obligatoire.",
+    "error.item_selector_mandatory": "Le sélecteur des articles est obligatoire.",
     "error.user_mandatory_fields": "Le nom d'utilisateur est obligatoire.",
+    "error.webhook_url_required": "L'URL du webhook est obligatoire.",
+    "error.saved_search_query_required": "La requête de recherche est obligatoire.",
+    "error.invalid_saved_search_status": "Ce statut n'est pas supporté.",
+    "error.saved_search_already_exists": "Cette recherche enregistrée existe déjà.",
+    "error.unable_to_create_saved_search": "Impossible de créer cette recherche enregistrée.",
+    "error.unable_to_update_saved_search": "Impossible de mettre à jour cette recherche enregistrée.",
+    "error.category_not_found": "Cette catégorie n'existe pas ou n'appartient pas à cet utilisateur.",
     "form.feed.label.title": "Titre",
     "form.feed.label.site_url": "URL du site web",
     "form.feed.label.feed_url": "URL du flux",
@@ -1113,8 +1990,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Remplacer l'agent utilisateur par défaut",
     "form.feed.label.scraper_rules": "Règles pour récupérer le contenu original",
     "form.feed.label.rewrite_rules": "Règles de réécriture",
+    "form.feed.label.item_selector": "Sélecteur des articles",
+    "form.feed.label.title_selector": "Sélecteur du titre",
+    "form.feed.label.link_selector": "Sélecteur du lien",
+    "form.feed.label.date_selector": "Sélecteur de la date",
+    "form.feed.label.content_selector": "Sélecteur du contenu",
     "form.feed.label.disabled": "Ne pas actualiser ce flux",
+    "form.feed.label.notify": "Envoyer des notifications pour les nouveaux articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Langue des articles pour la recherche",
+    "form.feed.select.search_language_automatic": "Automatique (langue de recherche des préférences)",
+    "form.feed.select.search_language_detected": "Automatique (déclarée par le flux : %s)",
     "form.category.label.title": "Titre",
+    "form.retention.title": "Politique de rétention",
+    "form.retention.help": "Laissez un champ vide pour hériter du paramètre de la catégorie ou du paramètre global, utilisez -1 pour le désactiver. Les articles favoris, partagés, reportés, annotés et importés sont toujours conservés.",
+    "form.retention.label.archive_read_days": "Supprimer les articles lus après ce nombre de jours",
+    "form.retention.label.max_entries": "Conserver au plus ce nombre d'articles",
+    "form.retention.label.mark_as_read_days": "Marquer les articles non lus comme lus après ce nombre de jours",
+    "form.saved_search.label.title": "Titre",
+    "form.saved_search.label.query": "Requête de recherche",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Catégorie",
+    "form.saved_search.select.all_entries": "Tous les articles",
+    "form.saved_search.select.unread_entries": "Articles non lus",
+    "form.saved_search.select.read_entries": "Articles lus",
+    "form.saved_search.select.all_categories": "Toutes les catégories",
     "form.user.label.username": "Nom d'utilisateur",
     "form.user.label.password": "Mot de passe",
     "form.user.label.confirmation": "Confirmation du mot de passe",
@@ -1125,9 +2024,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Ordre des éléments",
     "form.prefs.select.older_first": "Ancien éléments en premier",
     "form.prefs.select.recent_first": "Éléments récents en premier",
+    "form.prefs.label.search_language": "Langue de recherche, utilisée pour les flux sans langue",
+    "form.prefs.select.search_language_automatic": "Langue de l'interface",
     "form.prefs.label.keyboard_shortcuts": "Activer les raccourcis clavier",
+    "form.digest.label.enabled": "M'envoyer un résumé des articles non lus par courriel",
+    "form.digest.label.email": "Adresse de courriel",
+    "form.digest.label.frequency": "Fréquence",
+    "form.digest.label.weekday": "Jour de la semaine (résumé hebdomadaire)",
+    "form.digest.label.hour": "Heure (dans votre fuseau horaire)",
+    "form.digest.label.categories": "Catégories (toutes les catégories si aucune n'est sélectionnée)",
+    "form.digest.label.mark_as_read": "Marquer les articles comme lus une fois envoyés",
+    "form.digest.select.daily": "Quotidien",
+    "form.digest.select.weekly": "Hebdomadaire",
+    "form.published_feed.label.source": "Articles",
+    "form.published_feed.select.starred": "Articles favoris",
+    "form.published_feed.select.category": "Articles d'une catégorie",
+    "form.published_feed.label.category": "Catégorie",
+    "form.published_feed.select.tag": "Articles avec une étiquette",
+    "form.published_feed.label.tag": "Étiquette",
+    "form.published_feed.help.tag": "Les étiquettes proviennent des catégories que les flux attribuent à leurs articles.",
+    "form.published_feed.label.title": "Titre (facultatif)",
+    "weekday.monday": "Lundi",
+    "weekday.tuesday": "Mardi",
+    "weekday.wednesday": "Mercredi",
+    "weekday.thursday": "Jeudi",
+    "weekday.friday": "Vendredi",
+    "weekday.saturday": "Samedi",
+    "weekday.sunday": "Dimanche",
+    "email.digest.subject": "Résumé Miniflux : %d articles non lus",
+    "email.digest.marked_as_read": "Ces articles ont été marqués comme lus.",
+    "email.digest.open": "Ouvrir Miniflux",
     "form.import.label.file": "Fichier OPML",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "URL du fichier OPML",
+    "form.opml_subscription.label.remove_missing": "Se désabonner des flux retirés du fichier",
     "form.integration.fever_activate": "Activer l'API de Fever",
     "form.integration.fever_username": "Nom d'utilisateur pour l'API de Fever",
     "form.integration.fever_password": "Mot de passe pour l'API de Fever",
@@ -1152,6 +2082,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Sauvegarder les articles vers Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "URL de l'API de Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "Clé d'API de Nunux Keeper",
+    "form.integration.linkding_activate": "Sauvegarder les articles vers Linkding",
+    "form.integration.linkding_endpoint": "URL de Linkding",
+    "form.integration.linkding_api_key": "Clé d'API de Linkding",
+    "form.integration.linkding_tags": "Libellés de Linkding",
+    "form.integration.linkding_bookmark": "Marquer le lien comme non lu",
+    "form.integration.shaarli_activate": "Sauvegarder les articles vers Shaarli",
+    "form.integration.shaarli_endpoint": "URL de Shaarli",
+    "form.integration.shaarli_api_secret": "Secret de l'API de Shaarli",
+    "form.integration.shaarli_tags": "Libellés de Shaarli",
+    "form.integration.shaarli_private": "Marquer les liens comme privés",
+    "form.integration.matrix_activate": "Envoyer les nouveaux articles vers un salon Matrix",
+    "form.integration.matrix_homeserver": "URL du serveur Matrix",
+    "form.integration.matrix_access_token": "Jeton d'accès Matrix",
+    "form.integration.matrix_room_id": "Identifiant du salon Matrix",
+    "form.integration.telegram_activate": "Envoyer les nouveaux articles vers une discussion Telegram",
+    "form.integration.telegram_bot_token": "Jeton du bot Telegram",
+    "form.integration.telegram_chat_id": "Identifiant de la discussion Telegram",
+    "form.integration.notifications_help": "Seuls les nouveaux articles des abonnements avec les notifications activées sont envoyés.",
+    "form.integration.webhook_activate": "Envoyer les événements vers un webhook",
+    "form.integration.webhook_url": "URL du webhook",
+    "form.integration.webhook_new_entries": "Envoyer les nouveaux articles",
+    "form.integration.webhook_save_entry": "Envoyer les articles sauvegardés",
+    "form.integration.webhook_secret": "Secret du webhook utilisé pour signer les requêtes :",
+    "form.integration.automatic_delivery": "Envoi automatique",
+    "form.integration.automatic_on_bookmark": "Envoyer automatiquement les articles ajoutés aux favoris",
+    "form.integration.automatic_categories": "Envoyer les nouveaux articles de ces catégories",
+    "form.integration.automatic_feeds": "Envoyer les nouveaux articles de ces abonnements",
+    "form.integration.automatic_keywords": "Envoyer les nouveaux articles contenant ces mots-clés (séparés par des virgules)",
     "form.submit.loading": "Chargement...",
     "form.submit.saving": "Sauvegarde en cours...",
     "time_elapsed.not_yet": "pas encore",
@@ -1191,12 +2149,22 @@ var translations = map[string]string{
     "Unable to parse Atom feed: %q": "Impossible de lire ce flux Atom : %q",
     "Unable to parse JSON feed: %q": "Impossible de lire ce flux JSON : %q",
     "Unable to parse RDF feed: %q": "Impossible de lire ce flux RDF : %q",
+    "The item selector is mandatory": "Le sélecteur des articles est obligatoire",
+    "Unable to parse this web page: %q": "Impossible de lire cette page web : %q",
+    "No item found with the selector %q": "Aucun article trouvé avec le sélecteur %q",
     "Unable to normalize encoding: %q": "Impossible de normaliser l'encodage : %q",
     "This feed is empty": "Cet abonnement est vide",
     "This web page is empty": "Cette page web est vide",
     "Invalid SSL certificate (original error: %q)": "Certificat SSL invalide (erreur originale : %q)",
     "This website is temporarily unreachable (original error: %q)": "Ce site web est temporairement injoignable (erreur originale : %q)",
     "This website is permanently unreachable (original error: %q)": "Ce site web n'est pas joignable de façon permanente (erreur originale : %q)",
+    "Access to this network address is not allowed: %s": "L'accès à cette adresse réseau n'est pas autorisé : %s",
+    "Invalid search query: a quotation mark is not closed": "Requête de recherche invalide : un guillemet n'est pas fermé",
+    "Invalid search query: OR must be placed between two search terms": "Requête de recherche invalide : OR doit être placé entre deux termes de recherche",
+    "Invalid search query: unknown state %q, use is:unread, is:read or is:starred": "Requête de recherche invalide : état %q inconnu, utilisez is:unread, is:read ou is:starred",
+    "Invalid search query: the date %q must use the format YYYY-MM-DD": "Requête de recherche invalide : la date %q doit utiliser le format AAAA-MM-JJ",
+    "Invalid search query: the filter %s: requires a value": "Requête de recherche invalide : le filtre %s: nécessite une valeur",
+    "Invalid search query: the filter %s: cannot be excluded": "Requête de recherche invalide : le filtre %s: ne peut pas être exclu",
     "Website unreachable, the request timed out after %d seconds": "Site web injoignable, la requête à échouée après %d secondes",
     "You are not authorized to access this resource (invalid username/password)": "Vous n'êtes pas autorisé à accéder à cette ressource (nom d'utilisateur / mot de passe incorrect)",
     "Unable to fetch this resource (Status Code = %d)": "Impossible de récupérer cette ressource (code=%d)",
@@ -1211,6 +2179,7 @@ var translations = map[string]string{
     "action.subscribe": "Abbonati",
     "action.save": "Salva",
     "action.or": "o",
+    "action.preview": "Preview",
     "action.cancel": "cancella",
     "action.remove": "Elimina",
     "action.remove_feed": "Elimina questo feed",
@@ -1220,6 +2189,10 @@ var translations = map[string]string{
     "action.import": "Importa",
     "action.login": "Accedi",
     "action.home_screen": "Aggiungere alla schermata Home",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "Scorciatoia da tastiera: %s",
     "tooltip.logged_user": "Autenticato come %s",
     "menu.unread": "Da leggere",
@@ -1231,11 +2204,15 @@ var translations = map[string]string{
     "menu.logout": "Esci",
     "menu.preferences": "Preferenze",
     "menu.integrations": "Integrazioni",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "Sessioni",
     "menu.users": "Utenti",
     "menu.about": "Informazioni",
     "menu.export": "Esporta",
     "menu.import": "Importa",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "Aggiungi una categoria",
     "menu.mark_page_as_read": "Segna questa pagina come letta",
     "menu.mark_all_as_read": "Segna tutti gli articoli come letti",
@@ -1246,11 +2223,35 @@ var translations = map[string]string{
     "menu.edit_feed": "Modifica",
     "menu.edit_category": "Modifica",
     "menu.add_feed": "Aggiungi feed",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "Aggiungi utente",
     "menu.flush_history": "Svuota la cronologia",
     "menu.feed_entries": "Articoli",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "Cerca",
     "search.placeholder": "Cerca...",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "Successivo",
     "pagination.previous": "Precedente",
     "entry.status.unread": "Da leggere",
@@ -1258,10 +2259,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "Contrassegnato come non letto",
     "entry.status.toast.read": "Contrassegnato come letto",
     "entry.status.title": "Cambia lo stato dell'articolo",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "Aggiungi ai preferiti",
     "entry.bookmark.toggle.off": "Rimuovi dai preferiti",
     "entry.bookmark.toast.on": "Ha recitato",
     "entry.bookmark.toast.off": "Non speciali",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "Salvataggio in corso...",
     "entry.state.loading": "Caricamento in corso...",
     "entry.save.label": "Salva",
@@ -1274,8 +2286,13 @@ var translations = map[string]string{
     "entry.original.label": "Contenuto originale",
     "entry.comments.label": "Commenti",
     "entry.comments.title": "Mostra i commenti",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "Da leggere",
     "page.starred.title": "Preferiti",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "Categorie",
     "page.categories.no_feed": "Nessun feed.",
     "page.categories.feeds": "Vedi abbonamenti",
@@ -1286,6 +2303,10 @@ var translations = map[string]string{
     "page.new_category.title": "Nuova categoria",
     "page.new_user.title": "Nuovo utente",
     "page.edit_category.title": "Modifica categoria: %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "Modifica utente: %s",
     "page.feeds.title": "Feed",
     "page.feeds.last_check": "Ultimo controllo:",
@@ -1297,6 +2318,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "Cronologia",
     "page.import.title": "Importa",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.search.title": "Risultati della ricerca",
     "page.about.title": "Informazioni",
     "page.about.credits": "Crediti",
@@ -1310,6 +2360,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Abbonati al feed",
     "page.add_feed.legend.advanced_options": "Opzioni avanzate",
     "page.add_feed.choose_feed": "Scegli un feed",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "Modifica feed: %s",
     "page.edit_feed.last_check": "Ultimo controllo:",
     "page.edit_feed.last_modified_header": "Header LastModified:",
@@ -1317,6 +2370,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Nessun header",
     "page.edit_feed.last_parsing_error": "Ultimo errore di parsing",
     "page.entry.attachments": "Allegati",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "Scorciatoie da tastiera",
     "page.keyboard_shortcuts.subtitle.sections": "Navigazione sezioni",
     "page.keyboard_shortcuts.subtitle.items": "Navigazione articoli",
@@ -1344,6 +2417,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Scarica il contenuto integrale",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Aggiungi/rimuovi dai preferiti",
     "page.keyboard_shortcuts.save_article": "Salva l'articolo",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "Rimuovi questo feed",
     "page.keyboard_shortcuts.go_to_search": "Apri la casella di ricerca",
     "page.keyboard_shortcuts.close_modal": "Chiudi la finestra di dialogo",
@@ -1361,22 +2435,51 @@ var translations = map[string]string{
     "page.login.title": "Accedi",
     "page.login.google_signin": "Accedi tramite Google",
     "page.integrations.title": "Integrazioni",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "API di Miniflux",
     "page.integration.miniflux_api_endpoint": "Endpoint dell'API di Miniflux",
     "page.integration.miniflux_api_username": "Nome utente",
     "page.integration.miniflux_api_password": "Password",
     "page.integration.miniflux_api_password_value": "La password del tuo account",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "Segnalibro",
     "page.integration.bookmarklet.name": "Aggiungi a Miniflux",
     "page.integration.bookmarklet.instructions": "Trascina questo collegamento sui tuoi segnalibri.",
     "page.integration.bookmarklet.help": "Questo collegamento speciale ti consente di abbonarti ad un sito web semplicemente usando un segnalibro del tuo browser.",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "Sessioni",
     "page.sessions.table.date": "Data",
     "page.sessions.table.ip": "Indirizzo IP",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "Azioni",
     "page.sessions.table.current_session": "Sessione corrente",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "Nessun preferito disponibile.",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "Nessuna categoria disponibile.",
     "alert.no_category_entry": "Questa categoria non contiene alcun articolo.",
     "alert.no_feed_entry": "Questo feed non contiene alcun articolo.",
@@ -1385,6 +2488,7 @@ var translations = map[string]string{
     "alert.no_history": "La tua cronologia al momento è vuota.",
     "alert.feed_error": "Sembra ci sia un problema con questo feed",
     "alert.no_search_result": "La ricerca non ha prodotto risultati.",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "Nessun articolo da leggere.",
     "alert.no_user": "Tu sei l'unico utente.",
     "alert.account_unlinked": "Il tuo account esterno ora è scollegato!",
@@ -1402,6 +2506,16 @@ var translations = map[string]string{
     "error.user_already_exists": "Questo utente esiste già.",
     "error.unable_to_create_user": "Non sono riuscito ad aggiungere questo user.",
     "error.unable_to_update_user": "Non sono riuscito ad aggiornare questo utente.",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "Non sono riuscito ad aggiornare questo feed.",
     "error.subscription_not_found": "Non ho trovato nessun feed.",
     "error.empty_file": "Questo file è vuoto.",
@@ -1411,8 +2525,18 @@ var translations = map[string]string{
     "error.different_passwords": "Le password non coincidono.",
     "error.password_min_length": "La password deve contenere almeno 6 caratteri.",
     "error.settings_mandatory_fields": "Il nome utente, il tema, la lingua ed il fuso orario sono campi obbligatori.",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "L'URL e la categoria sono obbligatori.",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "Il nome utente è obbligatorio.",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "Titolo",
     "form.feed.label.site_url": "URL del sito",
     "form.feed.label.feed_url": "URL del feed",
@@ -1423,8 +2547,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Usa user agent personalizzato",
     "form.feed.label.scraper_rules": "Regole di estrazione del contenuto",
     "form.feed.label.rewrite_rules": "Regole di impaginazione del contenuto",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "Non aggiornare questo feed",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "Titolo",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "Nome utente",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Conferma password",
@@ -1435,9 +2581,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Ordinamento articoli",
     "form.prefs.select.older_first": "Prima i più recenti",
     "form.prefs.select.recent_first": "Prima i più vecchi",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.prefs.label.keyboard_shortcuts": "Abilita le scorciatoie da tastiera",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.import.label.file": "File OPML",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "Abilita l'API di Fever",
     "form.integration.fever_username": "Nome utente dell'account Fever",
     "form.integration.fever_password": "Password dell'account Fever",
@@ -1462,6 +2639,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Salva gli articoli su Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Endpoint dell'API di Nunux Keeper",
     "form.integration.nunux_keeper_api_key": "API key dell'account Nunux Keeper",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "Caricamento in corso...",
     "form.submit.saving": "Salvataggio in corso...",
     "time_elapsed.not_yet": "non ancora",
@@ -1501,6 +2706,7 @@ var translations = map[string]string{
     "action.subscribe": "フィードを購読",
     "action.save": "保存",
     "action.or": "または",
+    "action.preview": "Preview",
     "action.cancel": "取り消し",
     "action.remove": "削除",
     "action.remove_feed": "このフィードを削除",
@@ -1510,6 +2716,10 @@ var translations = map[string]string{
     "action.import": "インポート",
     "action.login": "ログイン",
     "action.home_screen": "ホームスクリーンに追加",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "キーボード・ショートカット: %s",
     "tooltip.logged_user": "%s としてログイン中",
     "menu.unread": "未読",
@@ -1521,11 +2731,15 @@ var translations = map[string]string{
     "menu.logout": "ログアウト",
     "menu.preferences": "設定情報",
     "menu.integrations": "関連付け",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "セッション",
     "menu.users": "ユーザー一覧",
     "menu.about": "ソフトウエア情報",
     "menu.export": "エクスポート",
     "menu.import": "インポート",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "カテゴリを作成",
     "menu.mark_page_as_read": "このページを既読にする",
     "menu.mark_all_as_read": "全て既読にする",
@@ -1536,11 +2750,35 @@ var translations = map[string]string{
     "menu.edit_feed": "編集",
     "menu.edit_category": "編集",
     "menu.add_feed": "フィードを購読する",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "ユーザーを追加",
     "menu.flush_history": "履歴を更新",
     "menu.feed_entries": "記事一覧",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "検索",
     "search.placeholder": "…を検索",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "次",
     "pagination.previous": "前",
     "entry.status.unread": "未読",
@@ -1548,10 +2786,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "未読にする",
     "entry.status.toast.read": "既読にする",
     "entry.status.title": "記事の状態を変更",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "星を付ける",
     "entry.bookmark.toggle.off": "星を外す",
     "entry.bookmark.toast.on": "星付き",
     "entry.bookmark.toast.off": "星無し",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "保存中…",
     "entry.state.loading": "読み込み中…",
     "entry.save.label": "保存",
@@ -1564,8 +2813,13 @@ var translations = map[string]string{
     "entry.original.label": "オリジナル",
     "entry.comments.label": "コメント",
     "entry.comments.title": "コメントを見る",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "未読",
     "page.starred.title": "星付き",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "カテゴリ",
     "page.categories.no_feed": "フィード無し",
     "page.categories.feeds": "フィード購読を見る",
@@ -1576,6 +2830,10 @@ var translations = map[string]string{
     "page.new_category.title": "新規カテゴリ",
     "page.new_user.title": "新規ユーザー",
     "page.edit_category.title": "カテゴリーを編集: %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "ユーザーを編集: %s",
     "page.feeds.title": "フィード一覧",
     "page.feeds.last_check": "最終チェック:",
@@ -1587,6 +2845,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "履歴",
     "page.import.title": "インポート",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.search.title": "検索結果",
     "page.about.title": "ソフトウエア情報",
     "page.about.credits": "著作権表示",
@@ -1600,6 +2887,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "購読フィードを探して追加",
     "page.add_feed.legend.advanced_options": "追加の設定",
     "page.add_feed.choose_feed": "購読を選択",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "フィード(%s)を編集",
     "page.edit_feed.last_check": "最終チェック:",
     "page.edit_feed.last_modified_header": "最後に更新されたヘッダー:",
@@ -1607,6 +2897,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": " なし",
     "page.edit_feed.last_parsing_error": "最新の解析エラー",
     "page.entry.attachments": "添付物",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "キーボード・ショートカット",
     "page.keyboard_shortcuts.subtitle.sections": "セクション 移動",
     "page.keyboard_shortcuts.subtitle.items": "アイテム 移動",
@@ -1634,6 +2944,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "オリジナルの内容をダウンロード",
     "page.keyboard_shortcuts.toggle_bookmark_status": "星を付ける/外す",
     "page.keyboard_shortcuts.save_article": "記事を保存",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "このフィードを削除",
     "page.keyboard_shortcuts.go_to_search": "検索フォームにフォーカスを移す",
     "page.keyboard_shortcuts.close_modal": "モーダルダイアログを閉じる",
@@ -1651,22 +2962,51 @@ var translations = map[string]string{
     "page.login.title": "ログイン",
     "page.login.google_signin": "Google アカウントでログイン",
     "page.integrations.title": "関連付け",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
     "page.integration.miniflux_api_username": "ユーザー名",
     "page.integration.miniflux_api_password": "パスワード",
     "page.integration.miniflux_api_password_value": "アカウントのパスワード",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "ブックマークレット",
     "page.integration.bookmarklet.name": "Miniflux に追加",
     "page.integration.bookmarklet.instructions": "このリンクをブラウザのブックマークへドラッグしてください。",
     "page.integration.bookmarklet.help": "この特別なリンクを使ってブラウザから直接ウェブサイトのフィードを購読できます。",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "セッション",
     "page.sessions.table.date": "日付",
     "page.sessions.table.ip": "IP アドレス",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "アクション",
     "page.sessions.table.current_session": "現在のセッション",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "現在星付きはありません。",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "カテゴリが存在しません。",
     "alert.no_category_entry": "このカテゴリには記事がありません。",
     "alert.no_feed_entry": "このフィードには記事がありません。",
@@ -1675,6 +3015,7 @@ var translations = map[string]string{
     "alert.no_history": "現時点では履歴がありません。",
     "alert.feed_error": "このフィードには問題があります。",
     "alert.no_search_result": "検索で何も見つかりませんでした。",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "未読の記事はありません。",
     "alert.no_user": "あなたが唯一のユーザーです。",
     "alert.account_unlinked": "外部アカウントとのリンクが解除されました!",
@@ -1692,6 +3033,16 @@ var translations = map[string]string{
     "error.user_already_exists": "このユーザーは既に存在します。",
     "error.unable_to_create_user": "このユーザーを作ることはできません。",
     "error.unable_to_update_user": "このユーザーを更新することはできません。",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "このフィードを更新することはできません。",
     "error.subscription_not_found": "購読フィードが見つかりません。",
     "error.empty_file": "このファイルは空です。",
@@ -1701,8 +3052,18 @@ var translations = map[string]string{
     "error.different_passwords": "パスワードが一致しません。",
     "error.password_min_length": "パスワードは6文字以上である必要があります。",
     "error.settings_mandatory_fields": "ユーザー名、テーマ、言語、タイムゾーンの全てが必要です。",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "URL と カテゴリが必要です。",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "ユーザー名が必要です。",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "タイトル",
     "form.feed.label.site_url": "サイト URL",
     "form.feed.label.feed_url": "フィード URL",
@@ -1713,8 +3074,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "ディフォルトの User Agent を上書きする",
     "form.feed.label.scraper_rules": "スクラップルール",
     "form.feed.label.rewrite_rules": "Rewrite ルール",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "このフィードを更新しない",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "タイトル",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "ユーザー名",
     "form.user.label.password": "パスワード",
     "form.user.label.confirmation": "パスワード確認",
@@ -1725,9 +3108,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "記事の並べ替え",
     "form.prefs.select.older_first": "古い記事を最初に",
     "form.prefs.select.recent_first": "新しい記事を最初に",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.prefs.label.keyboard_shortcuts": "キーボード・ショートカットを有効にする",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.import.label.file": "OPML ファイル",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "Fever API を有効にする",
     "form.integration.fever_username": "Fever の ユーザー名",
     "form.integration.fever_password": "Fever の パスワード",
@@ -1752,6 +3166,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Nunux Keeper に記事を保存する",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper の API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper の API key",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "読み込み中…",
     "form.submit.saving": "保存中…",
     "time_elapsed.not_yet": "未来",
@@ -1791,6 +3233,7 @@ var translations = map[string]string{
     "action.subscribe": "Abboneren",
     "action.save": "Opslaan",
     "action.or": "of",
+    "action.preview": "Preview",
     "action.cancel": "annuleren",
     "action.remove": "Verwijderen",
     "action.remove_feed": "Verwijder deze feed",
@@ -1800,6 +3243,10 @@ var translations = map[string]string{
     "action.import": "Importeren",
     "action.login": "Inloggen",
     "action.home_screen": "Toevoegen aan startscherm",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "Sneltoets: %s",
     "tooltip.logged_user": "Ingelogd als %s",
     "menu.unread": "Ongelezen",
@@ -1811,11 +3258,15 @@ var translations = map[string]string{
     "menu.logout": "Uitloggen",
     "menu.preferences": "Voorkeuren",
     "menu.integrations": "Integraties",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "Sessies",
     "menu.users": "Users",
     "menu.about": "Over",
     "menu.export": "Exporteren",
     "menu.import": "Importeren",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "Categorie toevoegen",
     "menu.mark_page_as_read": "Markeer deze pagina als gelezen",
     "menu.mark_all_as_read": "Markeer alle items als gelezen",
@@ -1826,11 +3277,35 @@ var translations = map[string]string{
     "menu.edit_feed": "Bewerken",
     "menu.edit_category": "Bewerken",
     "menu.add_feed": "Feed toevoegen",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "Gebruiker toevoegen",
     "menu.flush_history": "Verwijder geschiedenis",
     "menu.feed_entries": "Lidwoord",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "Zoeken",
     "search.placeholder": "Zoeken...",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "Volgende",
     "pagination.previous": "Vorige",
     "entry.status.unread": "Ongelezen",
@@ -1838,10 +3313,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "Gemarkeerd als ongelezen",
     "entry.status.toast.read": "Gemarkeerd als gelezen",
     "entry.status.title": "Verander status van item",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "Ster toevoegen",
     "entry.bookmark.toggle.off": "Ster weghalen",
     "entry.bookmark.toast.on": "Met ster",
     "entry.bookmark.toast.off": "Ster verwijderd",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "Opslaag...",
     "entry.state.loading": "Laden...",
     "entry.save.label": "Opslaan",
@@ -1854,8 +3340,13 @@ var translations = map[string]string{
     "entry.original.label": "Origineel",
     "entry.comments.label": "Comments",
     "entry.comments.title": "Bekijk de reacties",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "Ongelezen",
     "page.starred.title": "Favorieten",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "Categorieën",
     "page.categories.no_feed": "Geen feeds.",
     "page.categories.feeds": "Zie abonnementen",
@@ -1866,6 +3357,10 @@ var translations = map[string]string{
     "page.new_category.title": "Nieuwe categorie",
     "page.new_user.title": "Nieuwe gebruiker",
     "page.edit_category.title": "Bewerken van categorie: %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "Bewerk gebruiker: %s",
     "page.feeds.title": "Feeds",
     "page.feeds.last_check": "Laatste update:",
@@ -1877,6 +3372,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "Geschiedenis",
     "page.import.title": "Importeren",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.login.title": "Inloggen",
     "page.search.title": "Zoekresultaten",
     "page.about.title": "Over",
@@ -1891,6 +3415,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Feed zoeken",
     "page.add_feed.legend.advanced_options": "Geavanceerde mogelijkheden",
     "page.add_feed.choose_feed": "Feed kiezen",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "Bewerken van feed: %s",
     "page.edit_feed.last_check": "Laatste update:",
     "page.edit_feed.last_modified_header": "LastModified-header:",
@@ -1898,6 +3425,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Geen",
     "page.edit_feed.last_parsing_error": "Laatste parse error",
     "page.entry.attachments": "Bijlagen",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "Sneltoetsen",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguatie tussen menu's",
     "page.keyboard_shortcuts.subtitle.items": "Navigatie tussen items",
@@ -1925,6 +3472,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Download originele content",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Ster toevoegen/weghalen",
     "page.keyboard_shortcuts.save_article": "Artikel opslaan",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "Verwijder deze feed",
     "page.keyboard_shortcuts.go_to_search": "Focus instellen op zoekformulier",
     "page.keyboard_shortcuts.close_modal": "Sluit dialoogscherm",
@@ -1941,22 +3489,51 @@ var translations = map[string]string{
     "page.settings.unlink_google_account": "Ontkoppel mijn Google-account",
     "page.login.google_signin": "Inloggen via Google",
     "page.integrations.title": "Integraties",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API-URL",
     "page.integration.miniflux_api_username": "Gebruikersnaam",
     "page.integration.miniflux_api_password": "Wachtwoord",
     "page.integration.miniflux_api_password_value": "Wachtwoord van jouw account",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Toevoegen aan Miniflux",
     "page.integration.bookmarklet.instructions": "Sleep deze link naar je bookmarks.",
     "page.integration.bookmarklet.help": "Gebruik deze link als bookmark in je browser om je direct te abboneren op een website.",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "Sessies",
     "page.sessions.table.date": "Datum",
     "page.sessions.table.ip": "IP-adres",
     "page.sessions.table.user_agent": "User-agent",
     "page.sessions.table.actions": "Acties",
     "page.sessions.table.current_session": "Huidige sessie",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "Er zijn op dit moment geen favorieten.",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "Er zijn geen categorieën.",
     "alert.no_category_entry": "Deze categorie bevat geen feeds.",
     "alert.no_feed_entry": "Er zijn geen artikelen in deze feed.",
@@ -1965,6 +3542,7 @@ var translations = map[string]string{
     "alert.no_history": "Geschiedenis is op dit moment leeg.",
     "alert.feed_error": "Er is een probleem met deze feed",
     "alert.no_search_result": "Er is geen resultaat voor deze zoekopdracht.",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "Er zijn geen ongelezen artikelen.",
     "alert.no_user": "Je bent de enige gebruiker.",
     "alert.account_unlinked": "Uw externe account is nu gedissocieerd!",
@@ -1982,6 +3560,16 @@ var translations = map[string]string{
     "error.user_already_exists": "Deze gebruiker bestaat al.",
     "error.unable_to_create_user": "Kan deze gebruiker niet maken.",
     "error.unable_to_update_user": "Kan deze gebruiker niet updaten.",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "Kan deze feed niet bijwerken.",
     "error.subscription_not_found": "Kon geen feeds vinden.",
     "error.empty_file": "Dit bestand is leeg.",
@@ -1991,8 +3579,18 @@ var translations = map[string]string{
     "error.different_passwords": "Wachtwoorden zijn niet hetzelfde.",
     "error.password_min_length": "Je moet minstens 6 tekens gebruiken.",
     "error.settings_mandatory_fields": "Gebruikersnaam, skin, taal en tijdzone zijn verplicht.",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "The URL en de categorie zijn verplicht.",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "Gebruikersnaam is verplicht",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "Naam",
     "form.feed.label.site_url": "Website URL",
     "form.feed.label.feed_url": "Feed URL",
@@ -2003,8 +3601,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Standaard User Agent overschrijven",
     "form.feed.label.scraper_rules": "Scraper regels",
     "form.feed.label.rewrite_rules": "Rewrite regels",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "Vernieuw deze feed niet",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "Naam",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "Gebruikersnaam",
     "form.user.label.password": "Wachtwoord",
     "form.user.label.confirmation": "Bevestig wachtwoord",
@@ -2015,9 +3635,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Volgorde van items",
     "form.prefs.select.older_first": "Oudere items eerst",
     "form.prefs.select.recent_first": "Recente items eerst",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.prefs.label.keyboard_shortcuts": "Schakel sneltoetsen in",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.import.label.file": "OPML-bestand",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "Activeer Fever API",
     "form.integration.fever_username": "Fever gebruikersnaam",
     "form.integration.fever_password": "Fever wachtwoord",
@@ -2042,6 +3693,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Opslaan naar Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API-sleutel",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "Laden...",
     "form.submit.saving": "Opslaag...",
     "time_elapsed.not_yet": "in de toekomst",
@@ -2099,6 +3778,7 @@ var translations = map[string]string{
     "action.subscribe": "Subskrypcja",
     "action.save": "Zapisz",
     "action.or": "lub",
+    "action.preview": "Preview",
     "action.cancel": "anuluj",
     "action.remove": "Usuń",
     "action.remove_feed": "Usuń ten kanał",
@@ -2108,6 +3788,10 @@ var translations = map[string]string{
     "action.import": "Importuj",
     "action.login": "Zaloguj się",
     "action.home_screen": "Dodaj do ekranu głównego",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "Skróty klawiszowe: %s",
     "tooltip.logged_user": "Zalogowany jako %s",
     "menu.unread": "Nieprzeczytane",
@@ -2119,11 +3803,15 @@ var translations = map[string]string{
     "menu.logout": "Wyloguj się",
     "menu.preferences": "Preferencje",
     "menu.integrations": "Usługi",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "Sesje",
     "menu.users": "Użytkownicy",
     "menu.about": "O stronie",
     "menu.export": "Eksportuj",
     "menu.import": "Importuj",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "Utwórz kategorię",
     "menu.mark_page_as_read": "Oznacz jako przeczytane",
     "menu.mark_all_as_read": "Oznacz wszystko jako przeczytane",
@@ -2134,11 +3822,35 @@ var translations = map[string]string{
     "menu.edit_feed": "Edytuj",
     "menu.edit_category": "Edytuj",
     "menu.add_feed": "Dodaj subskrypcję",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "Dodaj użytkownika",
     "menu.flush_history": "Usuń historię",
     "menu.feed_entries": "Artykuły",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "Szukaj",
     "search.placeholder": "Szukaj...",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "Następny",
     "pagination.previous": "Poprzedni",
     "entry.status.unread": "Nieprzeczytane",
@@ -2146,10 +3858,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "Oznaczone jako nieprzeczytane",
     "entry.status.toast.read": "Oznaczone jako przeczytane",
     "entry.status.title": "Zmień status artykułu",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "Oznacz gwiazdką",
     "entry.bookmark.toggle.off": "Usuń gwiazdkę",
     "entry.bookmark.toast.on": "Oznaczone gwiazdką",
     "entry.bookmark.toast.off": "Bez gwiazdek",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "Zapisywanie...",
     "entry.state.loading": "Ładowanie...",
     "entry.save.label": "Zapisz",
@@ -2162,8 +3885,13 @@ var translations = map[string]string{
     "entry.original.label": "Oryginalny artykuł",
     "entry.comments.label": "Komentarze",
     "entry.comments.title": "Zobacz komentarze",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "Nieprzeczytane",
     "page.starred.title": "Oznaczone gwiazdką",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "Kategorie",
     "page.categories.no_feed": "Brak kanałów.",
     "page.categories.feeds": "Zobacz subskrypcje",
@@ -2175,6 +3903,10 @@ var translations = map[string]string{
     "page.new_category.title": "Nowa kategoria",
     "page.new_user.title": "Nowy użytkownik",
     "page.edit_category.title": "Edycja Kategorii: %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "Edytuj użytkownika: %s",
     "page.feeds.title": "Kanały",
     "page.feeds.last_check": "Ostatnia aktualizacja:",
@@ -2187,6 +3919,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "Historia",
     "page.import.title": "Importuj",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.search.title": "Wyniki wyszukiwania",
     "page.about.title": "O",
     "page.about.credits": "Prawa autorskie",
@@ -2200,6 +3961,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Znajdź subskrypcję",
     "page.add_feed.legend.advanced_options": "Zaawansowane opcje",
     "page.add_feed.choose_feed": "Wybierz subskrypcję",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "Edytuj kanał: %s",
     "page.edit_feed.last_check": "Ostatnia aktualizacja:",
     "page.edit_feed.last_modified_header": "Ostatnio zmienione:",
@@ -2207,6 +3971,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Brak",
     "page.edit_feed.last_parsing_error": "Ostatni błąd analizy",
     "page.entry.attachments": "Załączniki",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "Skróty klawiszowe",
     "page.keyboard_shortcuts.subtitle.sections": "Nawigacja między punktami menu",
     "page.keyboard_shortcuts.subtitle.items": "Nawigacja między artykułami",
@@ -2234,6 +4018,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Pobierz oryginalną zawartość",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Dodaj/usuń zakładki",
     "page.keyboard_shortcuts.save_article": "Zapisz artykuł",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "Usuń ten kanał",
     "page.keyboard_shortcuts.go_to_search": "Ustaw fokus na formularzu wyszukiwania",
     "page.keyboard_shortcuts.close_modal": "Zamknij listę skrótów klawiszowych",
@@ -2251,22 +4036,51 @@ var translations = map[string]string{
     "page.login.title": "Zaloguj się",
     "page.login.google_signin": "Zaloguj przez Google",
     "page.integrations.title": "Usługi",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "Punkt końcowy API",
     "page.integration.miniflux_api_username": "Nazwa Użytkownika",
     "page.integration.miniflux_api_password": "Hasło",
     "page.integration.miniflux_api_password_value": "Hasło konta",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "Bookmarklet",
     "page.integration.bookmarklet.name": "Dodaj do Miniflux",
     "page.integration.bookmarklet.instructions": "Przeciągnij i upuść to łącze do zakładek.",
     "page.integration.bookmarklet.help": "Ten link umożliwia subskrypcję strony internetowej bezpośrednio za pomocą zakładki w przeglądarce internetowej.",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "Sesje",
     "page.sessions.table.date": "Data",
     "page.sessions.table.ip": "Adres IP",
     "page.sessions.table.user_agent": "Agent użytkownika",
     "page.sessions.table.actions": "Działania",
     "page.sessions.table.current_session": "Bieżąca sesja",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "Obecnie nie ma żadnych zakładek.",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "Nie ma żadnej kategorii!",
     "alert.no_category_entry": "W tej kategorii nie ma żadnych artykułów",
     "alert.no_feed_entry": "Nie ma artykułu dla tego kanału.",
@@ -2275,6 +4089,7 @@ var translations = map[string]string{
     "alert.no_history": "Obecnie nie ma żadnej historii.",
     "alert.feed_error": "Z tym kanałem jest problem",
     "alert.no_search_result": "Brak wyników dla tego wyszukiwania.",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "Nie ma żadnych nieprzeczytanych artykułów.",
     "alert.no_user": "Jesteś jedynym użytkownikiem.",
     "alert.account_unlinked": "Twoje konto zewnętrzne jest teraz zdysocjowane!",
@@ -2292,6 +4107,16 @@ var translations = map[string]string{
     "error.user_already_exists": "Ten użytkownik już istnieje.",
     "error.unable_to_create_user": "Nie można utworzyć tego użytkownika.",
     "error.unable_to_update_user": "Nie można zaktualizować tego użytkownika.",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "Nie można zaktualizować tego kanału.",
     "error.subscription_not_found": "Nie znaleziono żadnych subskrypcji.",
     "error.empty_file": "Ten plik jest pusty.",
@@ -2301,8 +4126,18 @@ var translations = map[string]string{
     "error.different_passwords": "Hasła nie są identyczne.",
     "error.password_min_length": "Musisz użyć co najmniej 6 znaków.",
     "error.settings_mandatory_fields": "Pola nazwy użytkownika, tematu, języka i strefy czasowej są obowiązkowe.",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "URL i kategoria są obowiązkowe.",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "Nazwa użytkownika jest obowiązkowa.",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "Tytuł",
     "form.feed.label.site_url": "URL strony",
     "form.feed.label.feed_url": "URL kanału",
@@ -2313,8 +4148,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Zastąp domyślny agent użytkownika",
     "form.feed.label.scraper_rules": "Zasady ekstrakcji",
     "form.feed.label.rewrite_rules": "Reguły zapisu",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "Tytuł",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "Nazwa użytkownika",
     "form.user.label.password": "Hasło",
     "form.user.label.confirmation": "Potwierdzenie hasła",
@@ -2325,9 +4182,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Sortowanie artykułów",
     "form.prefs.select.older_first": "Najstarsze wpisy jako pierwsze",
     "form.prefs.label.keyboard_shortcuts": "Włącz skróty klawiaturowe",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.prefs.select.recent_first": "Najnowsze wpisy jako pierwsze",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.import.label.file": "Plik OPML",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "Aktywuj Fever API",
     "form.integration.fever_username": "Login do Fever",
     "form.integration.fever_password": "Hasło do Fever",
@@ -2352,6 +4240,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Zapisz artykuly do Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper URL",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "Ładowanie...",
     "form.submit.saving": "Zapisywanie...",
     "time_elapsed.not_yet": "jeszcze nie",
@@ -2415,6 +4331,7 @@ var translations = map[string]string{
     "action.subscribe": "Подписаться",
     "action.save": "Сохранить",
     "action.or": "или",
+    "action.preview": "Preview",
     "action.cancel": "закрыть",
     "action.remove": "Удалить",
     "action.remove_feed": "Удалить эту подписку",
@@ -2424,6 +4341,10 @@ var translations = map[string]string{
     "action.import": "Импорт",
     "action.login": "Войти",
     "action.home_screen": "Добавить на домашний экран",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "Сочетания клавиш: %s",
     "tooltip.logged_user": "Авторизован как %s",
     "menu.unread": "Непрочитанное",
@@ -2435,11 +4356,15 @@ var translations = map[string]string{
     "menu.logout": "Выйти",
     "menu.preferences": "Предпочтения",
     "menu.integrations": "Интеграции",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "Сессии",
     "menu.users": "Пользователи",
     "menu.about": "О приложении",
     "menu.export": "Экспорт",
     "menu.import": "Импорт",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "Создать категорию",
     "menu.mark_page_as_read": "Отметить эту страницу прочитанной",
     "menu.mark_all_as_read": "Отметить всё как прочитанное",
@@ -2450,11 +4375,35 @@ var translations = map[string]string{
     "menu.edit_feed": "Изменить",
     "menu.edit_category": "Изменить",
     "menu.add_feed": "Добавить подписку",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "Добавить пользователя",
     "menu.flush_history": "Отчистить историю",
     "menu.feed_entries": "статьи",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "Поиск",
     "search.placeholder": "Поиск…",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "Следующая",
     "pagination.previous": "Предыдущая",
     "entry.status.unread": "Непрочитано",
@@ -2462,10 +4411,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "Помечено как непрочитанное",
     "entry.status.toast.read": "Помечено как прочитанное",
     "entry.status.title": "Изменить статус записи",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "Добавить в Избранное",
     "entry.bookmark.toggle.off": "Удалить из Избранного",
     "entry.bookmark.toast.on": "Помеченные",
     "entry.bookmark.toast.off": "Без пометок",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "Сохранение…",
     "entry.state.loading": "Загрузка…",
     "entry.save.label": "Сохранить",
@@ -2478,8 +4438,13 @@ var translations = map[string]string{
     "entry.original.label": "Оригинал",
     "entry.comments.label": "Комментарии",
     "entry.comments.title": "Показать комментарии",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "Непрочитанное",
     "page.starred.title": "Избранное",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "Категории",
     "page.categories.no_feed": "Нет подписок.",
     "page.categories.feeds": "Посмотреть подписку",
@@ -2491,6 +4456,10 @@ var translations = map[string]string{
     "page.new_category.title": "Новая категория",
     "page.new_user.title": "Новый пользователь",
     "page.edit_category.title": "Изменить категорию: %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "Изменить пользователя: %s",
     "page.feeds.title": "Подписки",
     "page.feeds.last_check": "Последняя проверка:",
@@ -2503,6 +4472,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "История",
     "page.import.title": "Импорт",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.search.title": "Результаты поиска",
     "page.about.title": "О приложении",
     "page.about.credits": "Авторы",
@@ -2516,6 +4514,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Найти подписку",
     "page.add_feed.legend.advanced_options": "Расширенные настройки",
     "page.add_feed.choose_feed": "Выбрать подписку",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "Изменить подписку: %s",
     "page.edit_feed.last_check": "Последняя проверка:",
     "page.edit_feed.last_modified_header": "Заголовок LastModified:",
@@ -2523,6 +4524,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Отсутствует",
     "page.edit_feed.last_parsing_error": "Последняя ошибка парсинга",
     "page.entry.attachments": "Вложения",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "Сочетания клавиш",
     "page.keyboard_shortcuts.subtitle.sections": "Навигация по секциям",
     "page.keyboard_shortcuts.subtitle.items": "Навигация по элементам",
@@ -2550,6 +4571,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "Загрузить оригинальное содержимое",
     "page.keyboard_shortcuts.toggle_bookmark_status": "Переключатель избранного",
     "page.keyboard_shortcuts.save_article": "Сохранить статью",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "Удалить эту подписку",
     "page.keyboard_shortcuts.go_to_search": "Установить фокус в поисковой форме",
     "page.keyboard_shortcuts.close_modal": "Закрыть модальный диалог",
@@ -2567,22 +4589,51 @@ var translations = map[string]string{
     "page.login.title": "Войти",
     "page.login.google_signin": "Войти с помощью Google",
     "page.integrations.title": "Интеграции",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "Конечная точка API",
     "page.integration.miniflux_api_username": "Имя пользователя",
     "page.integration.miniflux_api_password": "Пароль",
     "page.integration.miniflux_api_password_value": "Пароль вашего аккаунта",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "Букмарклет",
     "page.integration.bookmarklet.name": "Добавить в Miniflux",
     "page.integration.bookmarklet.instructions": "Перетащите эту ссылку в ваши закладки.",
     "page.integration.bookmarklet.help": "Эта специальная ссылка позволит вам подписаться на сайт, используя обыкновенную закладку в вашем браузере.",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "Сессии",
     "page.sessions.table.date": "Время",
     "page.sessions.table.ip": "IP адрес",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "Действия",
     "page.sessions.table.current_session": "Текущая сессия",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "Нет закладок на данный момент.",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "Категории отсутствуют.",
     "alert.no_category_entry": "В этой категории нет статей.",
     "alert.no_feed_entry": "В этой подписке отсутствуют статьи.",
@@ -2591,6 +4642,7 @@ var translations = map[string]string{
     "alert.no_history": "Истории пока нет.",
     "alert.feed_error": "С этой подпиской есть проблема",
     "alert.no_search_result": "Нет результатов для данного поискового запроса.",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_unread_entry": "Нет непрочитанных статей.",
     "alert.no_user": "Вы единственный пользователь.",
     "alert.account_unlinked": "Ваш внешний аккаунт теперь отвязан!",
@@ -2608,6 +4660,16 @@ var translations = map[string]string{
     "error.user_already_exists": "Этот пользователь уже существует.",
     "error.unable_to_create_user": "Не удается создать этого пользователя.",
     "error.unable_to_update_user": "Не удается обновить этого пользователя.",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "Не удается обновить эту подписку.",
     "error.subscription_not_found": "Не удается найти подписки.",
     "error.empty_file": "Этот файл пуст.",
@@ -2617,8 +4679,18 @@ var translations = map[string]string{
     "error.different_passwords": "Пароли не совпадают.",
     "error.password_min_length": "Вы должны использовать минимум 6 символов.",
     "error.settings_mandatory_fields": "Имя пользователя, тема, язык и часовой пояс обязательны.",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "URL и категория обязательны.",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "Имя пользователя обязательно.",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "Название",
     "form.feed.label.site_url": "URL сайта",
     "form.feed.label.feed_url": "URL подписки",
@@ -2629,8 +4701,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "Переопределить User Agent по умолчанию",
     "form.feed.label.scraper_rules": "Правила Scraper",
     "form.feed.label.rewrite_rules": "Правила Rewrite",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "Название",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "Имя пользователя",
     "form.user.label.password": "Пароль",
     "form.user.label.confirmation": "Подтверждение пароля",
@@ -2641,9 +4735,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "Сортировка записей",
     "form.prefs.select.older_first": "Сначала старые записи",
     "form.prefs.select.recent_first": "Сначала последние записи",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.prefs.label.keyboard_shortcuts": "Включить сочетания клавиш",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.import.label.file": "OPML файл",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "Активировать Fever API",
     "form.integration.fever_username": "Имя пользователя Fever",
     "form.integration.fever_password": "Пароль Fever",
@@ -2668,6 +4793,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "Сохранять статьи в Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Конечная точка Nunux Keeper API",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API key",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "Загрузка…",
     "form.submit.saving": "Сохранение…",
     "time_elapsed.not_yet": "ещё нет",
@@ -2713,6 +4866,7 @@ var translations = map[string]string{
     "action.subscribe": "订阅",
     "action.save": "保存",
     "action.or": "或",
+    "action.preview": "Preview",
     "action.cancel": "取消",
     "action.remove": "删除",
     "action.remove_feed": "删除此源",
@@ -2722,6 +4876,10 @@ var translations = map[string]string{
     "action.import": "导入",
     "action.login": "登陆",
     "action.home_screen": "添加到主屏幕",
+    "action.retry": "Retry",
+    "action.publish": "Publish",
+    "action.revoke": "Revoke",
+    "action.sync": "Synchronize",
     "tooltip.keyboard_shortcuts": "快捷键: %s",
     "tooltip.logged_user": "当前登录 %s",
     "menu.unread": "未读",
@@ -2733,11 +4891,15 @@ var translations = map[string]string{
     "menu.logout": "登出",
     "menu.preferences": "设置",
     "menu.integrations": "集成",
+    "menu.digest": "Email Digest",
+    "menu.published_feeds": "Public Feeds",
+    "menu.shared_entries": "Shared Articles",
     "menu.sessions": "会话",
     "menu.users": "用户",
     "menu.about": "关于",
     "menu.export": "导出",
     "menu.import": "导入",
+    "menu.opml_subscriptions": "OPML Subscriptions",
     "menu.create_category": "新建分类",
     "menu.mark_page_as_read": "标记为已读",
     "menu.mark_all_as_read": "全部标为已读",
@@ -2748,11 +4910,35 @@ var translations = map[string]string{
     "menu.edit_feed": "编辑",
     "menu.edit_category": "编辑",
     "menu.add_feed": "新增订阅",
+    "menu.scrape_page": "Add from a web page",
     "menu.add_user": "新建用户",
     "menu.flush_history": "清理历史",
     "menu.feed_entries": "文章",
+    "menu.saved_searches": "Saved searches",
+    "menu.snoozed_entries": "Snoozed entries (%d)",
+    "menu.save_search": "Save this search",
+    "menu.create_saved_search": "Save a search",
+    "menu.edit_saved_search": "Edit",
+    "menu.show_saved_search_entries": "Entries",
     "search.label": "搜索",
     "search.placeholder": "搜索…",
+    "search.syntax": "Use quotes for phrases, -word to exclude, OR between words, and the filters feed:, category:, author:, is:unread, is:read, is:starred, before:YYYY-MM-DD and after:YYYY-MM-DD",
+    "search_language.danish": "Danish",
+    "search_language.dutch": "Dutch",
+    "search_language.english": "English",
+    "search_language.finnish": "Finnish",
+    "search_language.french": "French",
+    "search_language.german": "German",
+    "search_language.hungarian": "Hungarian",
+    "search_language.italian": "Italian",
+    "search_language.norwegian": "Norwegian",
+    "search_language.portuguese": "Portuguese",
+    "search_language.romanian": "Romanian",
+    "search_language.russian": "Russian",
+    "search_language.simple": "Other language (no stemming)",
+    "search_language.spanish": "Spanish",
+    "search_language.swedish": "Swedish",
+    "search_language.turkish": "Turkish",
     "pagination.next": "下一页",
     "pagination.previous": "上一页",
     "entry.status.unread": "未读",
@@ -2760,10 +4946,21 @@ var translations = map[string]string{
     "entry.status.toast.unread": "已标为未读",
     "entry.status.toast.read": "已标为已读",
     "entry.status.title": "更改状态",
+    "entry.snooze.title": "Hide this entry from unread entries until tomorrow morning",
+    "entry.snooze.label": "Snooze",
+    "entry.snooze.later_today": "Later today",
+    "entry.snooze.tomorrow": "Tomorrow morning",
+    "entry.snooze.next_week": "Next week",
+    "entry.snooze.custom": "Snooze until this date",
+    "entry.snooze.toast": "Snoozed",
+    "entry.unsnooze.label": "Cancel snooze",
+    "entry.unsnooze.toast": "Snooze cancelled",
     "entry.bookmark.toggle.on": "标记星标",
     "entry.bookmark.toggle.off": "去掉星标",
     "entry.bookmark.toast.on": "已标记星标",
     "entry.bookmark.toast.off": "已去掉星标",
+    "entry.played.toggle.on": "Mark as played",
+    "entry.played.toggle.off": "Mark as unplayed",
     "entry.state.saving": "保存中…",
     "entry.state.loading": "载入中…",
     "entry.save.label": "保存",
@@ -2776,8 +4973,13 @@ var translations = map[string]string{
     "entry.original.label": "原始内容",
     "entry.comments.label": "评论",
     "entry.comments.title": "查看评论",
+    "entry.share.label": "Share",
+    "entry.share.title": "Create a public link to this article",
+    "entry.shared_entry.label": "Public link",
+    "entry.shared_entry.title": "Open the public link",
     "page.unread.title": "未读",
     "page.starred.title": "星标",
+    "page.snoozed.title": "Snoozed entries",
     "page.categories.title": "分类",
     "page.categories.no_feed": "没有源",
     "page.categories.feeds": "查看订阅",
@@ -2787,6 +4989,10 @@ var translations = map[string]string{
     "page.new_category.title": "新分类",
     "page.new_user.title": "新用户",
     "page.edit_category.title": "编辑分类 : %s",
+    "page.saved_searches.title": "Saved searches",
+    "page.saved_searches.unread_count": "Unread articles",
+    "page.new_saved_search.title": "New saved search",
+    "page.edit_saved_search.title": "Edit saved search: %s",
     "page.edit_user.title": "编辑用户 : %s",
     "page.feeds.title": "源",
     "page.feeds.last_check": "最后检查时间：",
@@ -2797,6 +5003,35 @@ var translations = map[string]string{
     ],
     "page.history.title": "历史",
     "page.import.title": "导入",
+    "page.import.report.summary": "%d feeds imported, %d skipped because they already exist, %d failed.",
+    "page.import.report.table.feed": "Feed",
+    "page.import.report.table.category": "Category",
+    "page.import.report.table.status": "Status",
+    "page.import.report.status.created": "Imported",
+    "page.import.report.status.duplicate": "Already subscribed",
+    "page.import.report.status.failed": "Failed",
+    "page.import.formats": "Supported files: OPML, Tiny Tiny RSS JSON export, FreshRSS export (starred entries and labels) and Google Takeout starred.json.",
+    "page.import.entries_report.summary": "%d entries imported, %d entries updated, %d new feeds.",
+    "page.import.entries_report.archived": "%d entries do not indicate the feed they come from and have been saved in an archive feed.",
+    "page.import.archive_feed_title": "Imported articles (%s)",
+    "page.opml_subscriptions.title": "OPML Subscriptions",
+    "page.opml_subscriptions.help": "Feeds listed in a remote OPML file are added to your subscriptions and moved to the categories of the file. The file is checked periodically.",
+    "page.opml_subscriptions.no_subscription": "There is no OPML subscription.",
+    "page.opml_subscriptions.new": "Follow an OPML file",
+    "page.opml_subscriptions.changes": "Recent changes",
+    "page.opml_subscriptions.remove_missing": "Feeds removed from the file are unsubscribed.",
+    "page.opml_subscriptions.never_checked": "Never",
+    "page.opml_subscriptions.table.url": "OPML File",
+    "page.opml_subscriptions.table.checked_at": "Last Check",
+    "page.opml_subscriptions.table.actions": "Actions",
+    "page.opml_subscriptions.table.feed": "Feed",
+    "page.opml_subscriptions.table.category": "Category",
+    "page.opml_subscriptions.table.action": "Change",
+    "page.opml_subscriptions.table.date": "Date",
+    "page.opml_subscriptions.action.added": "Added",
+    "page.opml_subscriptions.action.removed": "Removed",
+    "page.opml_subscriptions.action.moved": "Moved from %s",
+    "page.opml_subscriptions.action.failed": "Failed",
     "page.search.title": "搜索结果",
     "page.about.title": "关于",
     "page.about.credits": "版权",
@@ -2810,6 +5045,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "查找订阅",
     "page.add_feed.legend.advanced_options": "高级选项",
     "page.add_feed.choose_feed": "选择一个订阅",
+    "page.scrape_feed.title": "New Subscription from a Web Page",
+    "page.scrape_feed.help": "Entries are extracted from the web page with CSS selectors. Title, link, date and content selectors are relative to each item, the first link of the item is used by default.",
+    "page.add_feed.scrape_suggestion": "No feed has been found, you can create one from this web page with CSS selectors.",
     "page.edit_feed.title": "编辑源 : %s",
     "page.edit_feed.last_check": "最后检查时间：",
     "page.edit_feed.last_modified_header": "最后修改的 Header：",
@@ -2817,6 +5055,26 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "无",
     "page.edit_feed.last_parsing_error": "最后一次解析错误",
     "page.entry.attachments": "附件",
+    "page.entry.snooze": "Snooze",
+    "page.entry.snoozed_until": "Snoozed until %s",
+    "page.entry.podcast": "Podcast",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.captions": "Captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
+    "page.entry.annotations": "Notes and highlights",
+    "page.entry.highlights": "Highlights",
+    "page.entry.highlight.label": "Highlight the selected text",
+    "page.entry.highlight.title": "Select a passage of the article, then click here to highlight it",
+    "page.entry.highlight.empty_selection": "Select a passage of the article first",
+    "page.entry.note": "Note",
+    "page.entry.note.placeholder": "Private note, Markdown is supported",
+    "page.entry.note.save": "Save the note",
+    "page.entry.note.toast.saved": "Note saved",
     "page.keyboard_shortcuts.title": "快捷键",
     "page.keyboard_shortcuts.subtitle.sections": "分区导航",
     "page.keyboard_shortcuts.subtitle.items": "条目导航",
@@ -2844,6 +5102,7 @@ var translations = map[string]string{
     "page.keyboard_shortcuts.download_content": "下载原始内容",
     "page.keyboard_shortcuts.toggle_bookmark_status": "切换收藏状态",
     "page.keyboard_shortcuts.save_article": "保存文章",
+    "page.keyboard_shortcuts.snooze_entry": "Snooze until tomorrow morning",
     "page.keyboard_shortcuts.remove_feed": "删除此Feed",
     "page.keyboard_shortcuts.go_to_search": "将重点放在搜索表单上",
     "page.keyboard_shortcuts.close_modal": "关闭模态对话窗口",
@@ -2861,22 +5120,51 @@ var translations = map[string]string{
     "page.login.title": "登陆",
     "page.login.google_signin": "使用 Google 登陆",
     "page.integrations.title": "集成",
+    "page.digest.title": "Email Digest",
+    "page.digest.smtp_not_configured": "No SMTP server is configured, digests will not be sent until the administrator defines one.",
+    "page.digest.last_sent": "Last digest:",
+    "page.published_feeds.title": "Public Feeds",
+    "page.published_feeds.help": "Anyone who knows the address of a public feed can read it without an account. Revoke a feed to disable its address.",
+    "page.published_feeds.no_feed": "You have not published any feed yet.",
+    "page.published_feeds.table.title": "Title",
+    "page.published_feeds.table.links": "Addresses",
+    "page.published_feeds.table.date": "Date",
+    "page.published_feeds.table.actions": "Actions",
+    "page.published_feeds.new": "Publish a new feed",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
     "page.integration.miniflux_api_username": "用户名",
     "page.integration.miniflux_api_password": "密码",
     "page.integration.miniflux_api_password_value": "您账户的密码",
+    "page.integration.newsletter": "Newsletters",
+    "page.integration.newsletter.help": "Subscribe to email newsletters with this address. Replace \"name\" by any word to sort your subscriptions, each sender becomes a feed.",
+    "page.integration.newsletter.address": "Email address",
+    "page.integration.newsletter.unsubscribe": "Remove the generated feed to stop receiving a newsletter.",
     "page.integration.bookmarklet": "书签小应用",
     "page.integration.bookmarklet.name": "新增到Miniflux",
     "page.integration.bookmarklet.instructions": "拖动这个链接到书签",
     "page.integration.bookmarklet.help": "你可以打开这个特殊的书签来直接订阅网站",
+    "page.integration.deliveries": "Recent Deliveries",
+    "page.integration.deliveries.table.date": "Date",
+    "page.integration.deliveries.table.entry": "Article",
+    "page.integration.deliveries.table.service": "Service",
+    "page.integration.deliveries.table.status": "Status",
+    "page.integration.deliveries.table.actions": "Actions",
+    "page.integration.deliveries.status.pending": "Pending",
+    "page.integration.deliveries.status.success": "Sent",
+    "page.integration.deliveries.status.failed": "Failed",
     "page.sessions.title": "会话",
     "page.sessions.table.date": "日期",
     "page.sessions.table.ip": "IP 地址",
     "page.sessions.table.user_agent": "User-Agent",
     "page.sessions.table.actions": "操作",
     "page.sessions.table.current_session": "当前会话",
+    "page.shared_entries.title": "Shared Articles",
+    "page.shared_entries.table.title": "Title",
+    "page.shared_entries.table.actions": "Actions",
+    "alert.no_shared_entry": "There is no shared article.",
     "alert.no_bookmark": "目前没有书签",
+    "alert.no_snoozed_entry": "There is no snoozed entry.",
     "alert.no_category": "目前没有分类",
     "alert.no_category_entry": "该分类下没有文章",
     "alert.no_feed_entry": "该源中没有文章",
@@ -2884,6 +5172,7 @@ var translations = map[string]string{
     "alert.no_history": "目前没有历史",
     "alert.feed_error": "该源存在问题",
     "alert.no_search_result": "该搜索没有结果",
+    "alert.no_saved_search": "There is no saved search.",
     "alert.no_feed_in_category": "没有该类别的订阅。",
     "alert.no_unread_entry": "目前没有未读文章",
     "alert.no_user": "您是目前仅有的用户",
@@ -2902,6 +5191,16 @@ var translations = map[string]string{
     "error.user_already_exists": "用户已存在",
     "error.unable_to_create_user": "无法创建此用户",
     "error.unable_to_update_user": "无法更新此用户",
+    "error.invalid_email": "This email address is not valid.",
+    "error.invalid_digest_frequency": "The digest frequency must be daily or weekly.",
+    "error.invalid_digest_schedule": "The delivery time of the digest is not valid.",
+    "error.unable_to_update_digest": "Unable to update the digest settings.",
+    "error.invalid_published_feed_source": "Please select the entries to publish.",
+    "error.unable_to_create_published_feed": "Unable to publish this feed.",
+    "error.opml_subscription_url_required": "The URL of the OPML file is mandatory.",
+    "error.invalid_opml_subscription_url": "The URL of the OPML file is not valid.",
+    "error.opml_subscription_already_exists": "You already follow this OPML file.",
+    "error.unable_to_create_opml_subscription": "Unable to follow this OPML file.",
     "error.unable_to_update_feed": "无法更新此源",
     "error.subscription_not_found": "找不到任何订阅",
     "error.empty_file": "该文件为空",
@@ -2911,8 +5210,18 @@ var translations = map[string]string{
     "error.different_passwords": "两次输入的密码不同",
     "error.password_min_length": "请至少使用6个字符",
     "error.settings_mandatory_fields": "必须填写用户名、主题、语言以及时区",
+    "error.invalid_search_language": "This search language is not supported.",
+    "error.invalid_retention_policy": "The retention settings must be positive numbers, or -1 to disable them.",
     "error.feed_mandatory_fields": "必须填写 URL 和分类",
+    "error.item_selector_mandatory": "The item selector is mandatory.",
     "error.user_mandatory_fields": "必须填写用户名",
+    "error.webhook_url_required": "The webhook URL is mandatory.",
+    "error.saved_search_query_required": "The search query is mandatory.",
+    "error.invalid_saved_search_status": "This status is not supported.",
+    "error.saved_search_already_exists": "This saved search already exists.",
+    "error.unable_to_create_saved_search": "Unable to create this saved search.",
+    "error.unable_to_update_saved_search": "Unable to update this saved search.",
+    "error.category_not_found": "This category does not exist or does not belong to this user.",
     "form.feed.label.title": "标题",
     "form.feed.label.site_url": "站点 URL",
     "form.feed.label.feed_url": "源 URL",
@@ -2923,8 +5232,30 @@ var translations = map[string]string{
     "form.feed.label.user_agent": "覆盖默认 User-Agent",
     "form.feed.label.scraper_rules": "Scraper 规则",
     "form.feed.label.rewrite_rules": "重写规则",
+    "form.feed.label.item_selector": "Item Selector",
+    "form.feed.label.title_selector": "Title Selector",
+    "form.feed.label.link_selector": "Link Selector",
+    "form.feed.label.date_selector": "Date Selector",
+    "form.feed.label.content_selector": "Content Selector",
     "form.feed.label.disabled": "请勿刷新此Feed",
+    "form.feed.label.notify": "Send notifications for new articles (Matrix, Telegram)",
+    "form.feed.label.search_language": "Language of the articles for the search",
+    "form.feed.select.search_language_automatic": "Automatic (search language of the settings)",
+    "form.feed.select.search_language_detected": "Automatic (declared by the feed: %s)",
     "form.category.label.title": "标题",
+    "form.retention.title": "Retention policy",
+    "form.retention.help": "Leave a field empty to inherit the setting of the category or the global setting, use -1 to disable it. Starred, shared, snoozed, annotated and imported articles are always kept.",
+    "form.retention.label.archive_read_days": "Remove read articles after this number of days",
+    "form.retention.label.max_entries": "Keep at most this number of articles",
+    "form.retention.label.mark_as_read_days": "Mark unread articles as read after this number of days",
+    "form.saved_search.label.title": "Title",
+    "form.saved_search.label.query": "Search query",
+    "form.saved_search.label.status": "Articles",
+    "form.saved_search.label.category": "Category",
+    "form.saved_search.select.all_entries": "All articles",
+    "form.saved_search.select.unread_entries": "Unread articles",
+    "form.saved_search.select.read_entries": "Read articles",
+    "form.saved_search.select.all_categories": "All categories",
     "form.user.label.username": "用户名",
     "form.user.label.password": "密码",
     "form.user.label.confirmation": "确认",
@@ -2935,9 +5266,40 @@ var translations = map[string]string{
     "form.prefs.label.entry_sorting": "内容排序",
     "form.prefs.select.older_first": "旧->新",
     "form.prefs.select.recent_first": "新->旧",
+    "form.prefs.label.search_language": "Search language, used for the feeds without language",
+    "form.prefs.select.search_language_automatic": "Language of the interface",
     "form.prefs.label.keyboard_shortcuts": "启用键盘快捷键",
+    "form.digest.label.enabled": "Send me a digest of unread articles by email",
+    "form.digest.label.email": "Email address",
+    "form.digest.label.frequency": "Frequency",
+    "form.digest.label.weekday": "Day of the week (weekly digest)",
+    "form.digest.label.hour": "Hour (in your timezone)",
+    "form.digest.label.categories": "Categories (all categories if none selected)",
+    "form.digest.label.mark_as_read": "Mark articles as read once sent",
+    "form.digest.select.daily": "Daily",
+    "form.digest.select.weekly": "Weekly",
+    "form.published_feed.label.source": "Entries",
+    "form.published_feed.select.starred": "Starred entries",
+    "form.published_feed.select.category": "Entries of a category",
+    "form.published_feed.label.category": "Category",
+    "form.published_feed.select.tag": "Entries with a tag",
+    "form.published_feed.label.tag": "Tag",
+    "form.published_feed.help.tag": "Tags come from the categories that feeds assign to their entries.",
+    "form.published_feed.label.title": "Title (optional)",
+    "weekday.monday": "Monday",
+    "weekday.tuesday": "Tuesday",
+    "weekday.wednesday": "Wednesday",
+    "weekday.thursday": "Thursday",
+    "weekday.friday": "Friday",
+    "weekday.saturday": "Saturday",
+    "weekday.sunday": "Sunday",
+    "email.digest.subject": "Miniflux digest: %d unread articles",
+    "email.digest.marked_as_read": "These articles have been marked as read.",
+    "email.digest.open": "Open Miniflux",
     "form.import.label.file": "OPML 文件",
     "form.import.label.url": "URL",
+    "form.opml_subscription.label.url": "OPML File URL",
+    "form.opml_subscription.label.remove_missing": "Unsubscribe from feeds removed from the file",
     "form.integration.fever_activate": "启用 Fever API",
     "form.integration.fever_username": "Fever 用户名",
     "form.integration.fever_password": "Fever 密码",
@@ -2962,6 +5324,34 @@ var translations = map[string]string{
     "form.integration.nunux_keeper_activate": "保存文章到 Nunux Keeper",
     "form.integration.nunux_keeper_endpoint": "Nunux Keeper API Endpoint",
     "form.integration.nunux_keeper_api_key": "Nunux Keeper API 密钥",
+    "form.integration.linkding_activate": "Save articles to Linkding",
+    "form.integration.linkding_endpoint": "Linkding URL",
+    "form.integration.linkding_api_key": "Linkding API key",
+    "form.integration.linkding_tags": "Linkding Tags",
+    "form.integration.linkding_bookmark": "Mark bookmark as unread",
+    "form.integration.shaarli_activate": "Save articles to Shaarli",
+    "form.integration.shaarli_endpoint": "Shaarli URL",
+    "form.integration.shaarli_api_secret": "Shaarli API secret",
+    "form.integration.shaarli_tags": "Shaarli Tags",
+    "form.integration.shaarli_private": "Mark links as private",
+    "form.integration.matrix_activate": "Send new articles to a Matrix room",
+    "form.integration.matrix_homeserver": "Matrix homeserver URL",
+    "form.integration.matrix_access_token": "Matrix access token",
+    "form.integration.matrix_room_id": "Matrix room ID",
+    "form.integration.telegram_activate": "Send new articles to a Telegram chat",
+    "form.integration.telegram_bot_token": "Telegram bot token",
+    "form.integration.telegram_chat_id": "Telegram chat ID",
+    "form.integration.notifications_help": "Only new articles from feeds with notifications enabled are sent.",
+    "form.integration.webhook_activate": "Send events to a webhook",
+    "form.integration.webhook_url": "Webhook URL",
+    "form.integration.webhook_new_entries": "Send new entries",
+    "form.integration.webhook_save_entry": "Send saved entries",
+    "form.integration.webhook_secret": "Webhook secret used to sign requests:",
+    "form.integration.automatic_delivery": "Automatic delivery",
+    "form.integration.automatic_on_bookmark": "Send articles automatically when they are starred",
+    "form.integration.automatic_categories": "Send new articles from these categories",
+    "form.integration.automatic_feeds": "Send new articles from these feeds",
+    "form.integration.automatic_keywords": "Send new articles containing these keywords (comma-separated)",
     "form.submit.loading": "载入中…",
     "form.submit.saving": "保存中…",
     "time_elapsed.not_yet": "尚未",
@@ -3009,14 +5399,14 @@ var translations = map[string]string{
 }
 
 var translationsChecksums = map[string]string{
-	"de_DE": "2269a754f4af398fe6af44324eda8ed7daa708a11eb50f7bb0b779d6ed482ad8",
-	"en_US": "5256a170a5be7ba8e79ed0897475c416ce755797e9ab1173375dc5113515c2d8",
-	"es_ES": "19f48e44422712789a3736399e5d5fe9f88cc7fa3e4c228fdceec03f5d3666cd",
-	"fr_FR": "e6032bfec564e86f12182ea79f0ed61ec133ed0c04525571ab71e923cc5de276",
-	"it_IT": "39a466b969ffadf27e4bc3054ab36fe8b2bceb0d9c0a68d940d76a418d999073",
-	"ja_JP": "598e7257528a90125c14c5169663d44d2a7a0afb86354fe654bc68469216251d",
-	"nl_NL": "fc10720566f37e88da60add9eaefa6f79cb6b021e9f3c192e50dfc5720553d69",
-	"pl_PL": "fc99fbde29904f3680e95ed337e7d9b2c0755cc8137c2694d8b781c91007ae19",
-	"ru_RU": "a01fc70baedd9555370e29827ef8c9aba32a4fb8f07942feb7474bcac232a2fe",
-	"zh_CN": "3bd2c9841413c072d1977dc500d8adecef4f947b28f3a8d3e8d4f0e5c39584ad",
+	"de_DE": "25cc9e7416746498a00bc2105d4b9990c4d74be0bfc0ddf7746d865441eaf5d6",
+	"en_US": "937918c3a02b65321561660f1025f7b36c0fcf1597863c567398a32240340184",
+	"es_ES": "10c6b5a4fdd91dbc3f76ddf2159d8dca0812568e873e75bd3ce33a9de42e7248",
+	"fr_FR": "d799974c51758d10c7c160052d02b537425a1ad8b4659106aa9085b2163eb86e",
+	"it_IT": "e1d806585f7455e50111e68be1641fdd162cc37c91a7b363bf1806dbf602eaaa",
+	"ja_JP": "7663127017f74c4a5de46285dca72f1e9df6f86b71c0df25bd7ae796118cf9f0",
+	"nl_NL": "64b7e9c244c49ec0a4327532eac25787dbb940a253371a3144ba9ee2e0ba4d4c",
+	"pl_PL": "7059f1f2576a49ab9e5b5fba718537cd7879f4680245a94380afac95e0589b63",
+	"ru_RU": "5f42e80285f48593644cb3197467510b30b6be47acc156a41f1badd1ce1ec8c2",
+	"zh_CN": "e5fa947124baaea916a9e8d4fa5275a60253486279addfeacfa23194b34dc58d",
 }
@@ -0,0 +1,114 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mailer // import "miniflux.app/mailer"
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+)
+
+// Message represents an email with an HTML and a plain text version.
+type Message struct {
+	To       string
+	Subject  string
+	HTMLBody []byte
+	TextBody []byte
+}
+
+// IsConfigured returns true if a SMTP server is defined.
+func IsConfigured() bool {
+	return config.Opts.SMTPHost() != ""
+}
+
+// Send delivers the message to the configured SMTP server.
+func Send(message *Message) error {
+	if !IsConfigured() {
+		return fmt.Errorf("mailer: no SMTP server configured")
+	}
+
+	data, err := message.Bytes(config.Opts.SMTPFrom())
+	if err != nil {
+		return err
+	}
+
+	host := config.Opts.SMTPHost()
+	addr := net.JoinHostPort(host, strconv.Itoa(config.Opts.SMTPPort()))
+
+	var auth smtp.Auth
+	if config.Opts.SMTPUsername() != "" {
+		auth = smtp.PlainAuth("", config.Opts.SMTPUsername(), config.Opts.SMTPPassword(), host)
+	}
+
+	if err := smtp.SendMail(addr, auth, config.Opts.SMTPFrom(), []string{message.To}, data); err != nil {
+		return fmt.Errorf("mailer: unable to send email to %q: %v", message.To, err)
+	}
+
+	return nil
+}
+
+// Bytes returns the message encoded as a multipart MIME document.
+func (m *Message) Bytes(from string) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	headers := []struct{ key, value string }{
+		{"From", from},
+		{"To", m.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", m.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@miniflux>", crypto.GenerateRandomString(16))},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", fmt.Sprintf(`multipart/alternative; boundary="%s"`, writer.Boundary())},
+	}
+
+	for _, header := range headers {
+		fmt.Fprintf(&buffer, "%s: %s\r\n", header.key, header.value)
+	}
+	buffer.WriteString("\r\n")
+
+	// The preferred version comes last.
+	if err := writePart(writer, "text/plain", m.TextBody); err != nil {
+		return nil, err
+	}
+
+	if err := writePart(writer, "text/html", m.HTMLBody); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("mailer: unable to encode message: %v", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func writePart(writer *multipart.Writer, contentType string, body []byte) error {
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Type", contentType+"; charset=utf-8")
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return fmt.Errorf("mailer: unable to create %s part: %v", contentType, err)
+	}
+
+	encoder := quotedprintable.NewWriter(part)
+	if _, err := encoder.Write(body); err != nil {
+		return fmt.Errorf("mailer: unable to encode %s part: %v", contentType, err)
+	}
+
+	return encoder.Close()
+}
@@ -0,0 +1,141 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package mailer // import "miniflux.app/mailer"
+
+import (
+	"bufio"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+// startSMTPSink accepts a single SMTP transaction and sends the received data to the channel.
+func startSMTPSink(t *testing.T) (string, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan string, 1)
+	go func() {
+		defer listener.Close()
+
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost ESMTP")
+
+		var data strings.Builder
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			command := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(command, "DATA"):
+				reply("354 End data with <CR><LF>.<CR><LF>")
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil || line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				reply("250 OK")
+			case strings.HasPrefix(command, "QUIT"):
+				reply("221 Bye")
+				received <- data.String()
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), received
+}
+
+func TestSend(t *testing.T) {
+	addr, received := startSMTPSink(t)
+	host, port, _ := net.SplitHostPort(addr)
+
+	os.Clearenv()
+	os.Setenv("SMTP_HOST", host)
+	os.Setenv("SMTP_PORT", port)
+	os.Setenv("SMTP_FROM", "miniflux@example.org")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := &Message{
+		To:       "user@example.org",
+		Subject:  "Résumé",
+		HTMLBody: []byte("<p>Hello</p>"),
+		TextBody: []byte("Hello"),
+	}
+
+	if err := Send(message); err != nil {
+		t.Fatal(err)
+	}
+
+	email, err := mail.ReadMessage(strings.NewReader(<-received))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if email.Header.Get("From") != "miniflux@example.org" || email.Header.Get("To") != "user@example.org" {
+		t.Errorf(`Unexpected headers: %v`, email.Header)
+	}
+
+	subject, _ := new(mime.WordDecoder).DecodeHeader(email.Header.Get("Subject"))
+	if subject != "Résumé" {
+		t.Errorf(`Unexpected subject: %q`, subject)
+	}
+
+	_, params, err := mime.ParseMediaType(email.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader := multipart.NewReader(email.Body, params["boundary"])
+	for _, expected := range []string{"Hello", "<p>Hello</p>"} {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, _ := ioutil.ReadAll(part)
+		if string(body) != expected {
+			t.Errorf(`Unexpected part content: %q`, body)
+		}
+	}
+}
+
+func TestSendWithoutSMTPServer(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	if err := Send(&Message{To: "user@example.org"}); err == nil {
+		t.Error(`An error should be returned when no SMTP server is configured`)
+	}
+}
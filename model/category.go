@@ -15,6 +15,10 @@ type Category struct {
 	Title     string `json:"title,omitempty"`
 	UserID    int64  `json:"user_id,omitempty"`
 	FeedCount int    `json:"nb_feeds,omitempty"`
+
+	ArchiveReadDays int `json:"archive_read_days"`
+	MaxEntries      int `json:"max_entries"`
+	MarkAsReadDays  int `json:"mark_as_read_days"`
 }
 
 func (c *Category) String() string {
@@ -31,7 +35,7 @@ func (c Category) ValidateCategoryCreation() error {
 		return errors.New("The userID is mandatory")
 	}
 
-	return nil
+	return ValidateRetentionPolicy(c.ArchiveReadDays, c.MaxEntries, c.MarkAsReadDays)
 }
 
 // ValidateCategoryModification validates a category during the modification.
@@ -48,7 +52,7 @@ func (c Category) ValidateCategoryModification() error {
 		return errors.New("The ID is mandatory")
 	}
 
-	return nil
+	return ValidateRetentionPolicy(c.ArchiveReadDays, c.MaxEntries, c.MarkAsReadDays)
 }
 
 // Categories represents a list of categories.
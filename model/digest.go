@@ -0,0 +1,93 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"sort"
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// Digest frequencies.
+const (
+	DigestFrequencyDaily  = "daily"
+	DigestFrequencyWeekly = "weekly"
+)
+
+// DigestMaxEntries is the maximum number of entries included in a digest.
+const DigestMaxEntries = 200
+
+// Digest represents the email digest settings of a user.
+type Digest struct {
+	UserID      int64
+	Enabled     bool
+	Email       string
+	Frequency   string
+	Hour        int
+	Weekday     int
+	CategoryIDs []int64
+	MarkAsRead  bool
+	LastEntryID int64
+	LastSentAt  *time.Time
+	Timezone    string
+	Language    string
+}
+
+// HasCategory returns true if the entries of the given category are included in the digest.
+func (d *Digest) HasCategory(categoryID int64) bool {
+	return containsID(d.CategoryIDs, categoryID)
+}
+
+// ScheduledAt returns the most recent delivery time before the given date, in the timezone of the user.
+func (d *Digest) ScheduledAt(now time.Time) time.Time {
+	now = timezone.Convert(d.Timezone, now)
+	scheduledAt := time.Date(now.Year(), now.Month(), now.Day(), d.Hour, 0, 0, 0, now.Location())
+	if scheduledAt.After(now) {
+		scheduledAt = scheduledAt.AddDate(0, 0, -1)
+	}
+
+	if d.Frequency == DigestFrequencyWeekly {
+		for scheduledAt.Weekday() != time.Weekday(d.Weekday) {
+			scheduledAt = scheduledAt.AddDate(0, 0, -1)
+		}
+	}
+
+	return scheduledAt
+}
+
+// IsDue returns true if the digest has not been sent since the last scheduled delivery.
+func (d *Digest) IsDue(now time.Time) bool {
+	if !d.Enabled || d.Email == "" {
+		return false
+	}
+
+	return d.LastSentAt == nil || d.LastSentAt.Before(d.ScheduledAt(now))
+}
+
+// NextBatch returns the entries to send in the digest, the oldest first, and the ID of the last one.
+// Entries beyond DigestMaxEntries are left for the next digest.
+func (d *Digest) NextBatch(entries Entries) (Entries, int64) {
+	batch := make(Entries, 0, len(entries))
+	for _, entry := range entries {
+		if entry.ID > d.LastEntryID {
+			batch = append(batch, entry)
+		}
+	}
+
+	sort.Slice(batch, func(i, j int) bool { return batch[i].ID < batch[j].ID })
+	if len(batch) > DigestMaxEntries {
+		batch = batch[:DigestMaxEntries]
+	}
+
+	if len(batch) == 0 {
+		return batch, d.LastEntryID
+	}
+
+	return batch, batch[len(batch)-1].ID
+}
+
+// Digests represents a list of digest settings.
+type Digests []*Digest
@@ -0,0 +1,105 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDigestScheduledAtDaily(t *testing.T) {
+	digest := &Digest{Frequency: DigestFrequencyDaily, Hour: 8, Timezone: "Europe/Paris"}
+
+	// 2019-03-12 09:30 in Paris.
+	now := time.Date(2019, 3, 12, 8, 30, 0, 0, time.UTC)
+	expected := time.Date(2019, 3, 12, 7, 0, 0, 0, time.UTC)
+	if result := digest.ScheduledAt(now); !result.Equal(expected) {
+		t.Errorf(`Unexpected scheduled date: %v instead of %v`, result, expected)
+	}
+
+	// 2019-03-12 07:30 in Paris, the digest of the day is not yet due.
+	now = time.Date(2019, 3, 12, 6, 30, 0, 0, time.UTC)
+	expected = time.Date(2019, 3, 11, 7, 0, 0, 0, time.UTC)
+	if result := digest.ScheduledAt(now); !result.Equal(expected) {
+		t.Errorf(`Unexpected scheduled date: %v instead of %v`, result, expected)
+	}
+}
+
+func TestDigestScheduledAtWeekly(t *testing.T) {
+	digest := &Digest{Frequency: DigestFrequencyWeekly, Hour: 18, Weekday: int(time.Friday), Timezone: "UTC"}
+
+	// Tuesday 2019-03-12.
+	now := time.Date(2019, 3, 12, 8, 30, 0, 0, time.UTC)
+	expected := time.Date(2019, 3, 8, 18, 0, 0, 0, time.UTC)
+	if result := digest.ScheduledAt(now); !result.Equal(expected) {
+		t.Errorf(`Unexpected scheduled date: %v instead of %v`, result, expected)
+	}
+}
+
+func TestDigestIsDue(t *testing.T) {
+	now := time.Date(2019, 3, 12, 8, 30, 0, 0, time.UTC)
+	lastSentAt := time.Date(2019, 3, 11, 8, 0, 0, 0, time.UTC)
+	digest := &Digest{Enabled: true, Email: "user@example.org", Frequency: DigestFrequencyDaily, Hour: 8, Timezone: "UTC", LastSentAt: &lastSentAt}
+
+	if !digest.IsDue(now) {
+		t.Error(`The digest should be due`)
+	}
+
+	lastSentAt = time.Date(2019, 3, 12, 8, 1, 0, 0, time.UTC)
+	if digest.IsDue(now) {
+		t.Error(`The digest has already been sent today`)
+	}
+
+	digest.LastSentAt = nil
+	digest.Enabled = false
+	if digest.IsDue(now) {
+		t.Error(`A disabled digest should never be due`)
+	}
+}
+
+func TestDigestNextBatchWithMoreEntriesThanLimit(t *testing.T) {
+	digest := &Digest{LastEntryID: 10}
+
+	var entries Entries
+	for id := int64(DigestMaxEntries + 60); id > 5; id-- {
+		entries = append(entries, &Entry{ID: id})
+	}
+
+	batch, lastEntryID := digest.NextBatch(entries)
+	if len(batch) != DigestMaxEntries {
+		t.Fatalf(`Unexpected number of entries: got %d instead of %d`, len(batch), DigestMaxEntries)
+	}
+
+	if batch[0].ID != 11 {
+		t.Errorf(`The digest should start with the oldest entry not sent yet, got #%d`, batch[0].ID)
+	}
+
+	if lastEntryID != batch[len(batch)-1].ID || lastEntryID != DigestMaxEntries+10 {
+		t.Errorf(`The last entry ID should be the last entry sent, got #%d`, lastEntryID)
+	}
+
+	digest.LastEntryID = lastEntryID
+	batch, lastEntryID = digest.NextBatch(entries)
+	if len(batch) != 50 {
+		t.Fatalf(`The next digest should contain the remaining entries, got %d entries`, len(batch))
+	}
+
+	if batch[0].ID != DigestMaxEntries+11 || lastEntryID != DigestMaxEntries+60 {
+		t.Errorf(`Unexpected next digest: first entry #%d, last entry #%d`, batch[0].ID, lastEntryID)
+	}
+}
+
+func TestDigestNextBatchWithoutEntries(t *testing.T) {
+	digest := &Digest{LastEntryID: 42}
+
+	batch, lastEntryID := digest.NextBatch(Entries{&Entry{ID: 40}})
+	if len(batch) != 0 {
+		t.Errorf(`Entries already sent should not be included again`)
+	}
+
+	if lastEntryID != 42 {
+		t.Errorf(`The last entry ID should not change, got #%d`, lastEntryID)
+	}
+}
@@ -4,15 +4,31 @@
 
 package model // import "miniflux.app/model"
 
+import "fmt"
+
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int64  `json:"size"`
+	ID               int64              `json:"id"`
+	UserID           int64              `json:"user_id"`
+	EntryID          int64              `json:"entry_id"`
+	URL              string             `json:"url"`
+	MimeType         string             `json:"mime_type"`
+	Size             int64              `json:"size"`
+	MediaProgression int64              `json:"media_progression"`
+	Played           bool               `json:"played"`
+	ChaptersURL      string             `json:"chapters_url,omitempty"`
+	Chapters         PodcastChapters    `json:"chapters,omitempty"`
+	Transcripts      PodcastTranscripts `json:"transcripts,omitempty"`
 }
 
 // EnclosureList represents a list of attachments.
 type EnclosureList []*Enclosure
+
+// ValidateMediaProgression makes sure the media progression is a positive number of seconds.
+func ValidateMediaProgression(mediaProgression int64) error {
+	if mediaProgression < 0 {
+		return fmt.Errorf(`The media progression must be a positive number`)
+	}
+
+	return nil
+}
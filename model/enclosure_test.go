@@ -0,0 +1,19 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateMediaProgression(t *testing.T) {
+	for _, mediaProgression := range []int64{0, 42} {
+		if err := ValidateMediaProgression(mediaProgression); err != nil {
+			t.Errorf(`The media progression %d should be valid: %v`, mediaProgression, err)
+		}
+	}
+
+	if err := ValidateMediaProgression(-1); err == nil {
+		t.Error(`Negative media progressions should be rejected`)
+	}
+}
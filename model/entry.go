@@ -20,20 +20,26 @@ const (
 
 // Entry represents a feed item in the system.
 type Entry struct {
-	ID          int64         `json:"id"`
-	UserID      int64         `json:"user_id"`
-	FeedID      int64         `json:"feed_id"`
-	Status      string        `json:"status"`
-	Hash        string        `json:"hash"`
-	Title       string        `json:"title"`
-	URL         string        `json:"url"`
-	CommentsURL string        `json:"comments_url"`
-	Date        time.Time     `json:"published_at"`
-	Content     string        `json:"content"`
-	Author      string        `json:"author"`
-	Starred     bool          `json:"starred"`
-	Enclosures  EnclosureList `json:"enclosures,omitempty"`
-	Feed        *Feed         `json:"feed,omitempty"`
+	ID           int64           `json:"id"`
+	UserID       int64           `json:"user_id"`
+	FeedID       int64           `json:"feed_id"`
+	Status       string          `json:"status"`
+	Hash         string          `json:"hash"`
+	Title        string          `json:"title"`
+	URL          string          `json:"url"`
+	CommentsURL  string          `json:"comments_url"`
+	Date         time.Time       `json:"published_at"`
+	Content      string          `json:"content"`
+	Author       string          `json:"author"`
+	Starred      bool            `json:"starred"`
+	ShareCode    string          `json:"share_code"`
+	Tags         []string        `json:"tags,omitempty"`
+	SnoozedUntil *time.Time      `json:"snoozed_until,omitempty"`
+	Podcast      *PodcastEpisode `json:"podcast,omitempty"`
+	Enclosures   EnclosureList   `json:"enclosures,omitempty"`
+	Note         *EntryNote      `json:"note,omitempty"`
+	Highlights   EntryHighlights `json:"highlights,omitempty"`
+	Feed         *Feed           `json:"feed,omitempty"`
 }
 
 // Entries represents a list of entries.
@@ -52,11 +58,11 @@ func ValidateEntryStatus(status string) error {
 // ValidateEntryOrder makes sure the sorting order is valid.
 func ValidateEntryOrder(order string) error {
 	switch order {
-	case "id", "status", "changed_at", "published_at", "category_title", "category_id":
+	case "id", "status", "changed_at", "published_at", "category_title", "category_id", "snoozed_until":
 		return nil
 	}
 
-	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "changed_at", "published_at", "category_title", "category_id"`)
+	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "changed_at", "published_at", "category_title", "category_id", "snoozed_until"`)
 }
 
 // ValidateDirection makes sure the sorting direction is valid.
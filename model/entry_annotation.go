@@ -0,0 +1,59 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxAnnotationLength is the maximum number of characters of a note or a highlight.
+const MaxAnnotationLength = 20000
+
+// EntryNote represents the private note of a user about an entry, written in Markdown.
+type EntryNote struct {
+	EntryID   int64     `json:"entry_id"`
+	UserID    int64     `json:"user_id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// ValidateEntryNote validates the content of a note, an empty note removes the existing one.
+func (n EntryNote) ValidateEntryNote() error {
+	if utf8.RuneCountInString(n.Content) > MaxAnnotationLength {
+		return fmt.Errorf("The note must not exceed %d characters", MaxAnnotationLength)
+	}
+
+	return nil
+}
+
+// EntryHighlight represents a passage of the content of an entry highlighted by a user.
+type EntryHighlight struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	EntryID   int64     `json:"entry_id"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ValidateEntryHighlight validates the text of a highlight.
+func (h EntryHighlight) ValidateEntryHighlight() error {
+	if strings.TrimSpace(h.Text) == "" {
+		return errors.New("The highlighted text is mandatory")
+	}
+
+	if utf8.RuneCountInString(h.Text) > MaxAnnotationLength {
+		return fmt.Errorf("The highlighted text must not exceed %d characters", MaxAnnotationLength)
+	}
+
+	return nil
+}
+
+// EntryHighlights represents a list of highlights.
+type EntryHighlights []*EntryHighlight
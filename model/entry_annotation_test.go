@@ -0,0 +1,38 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateEntryNote(t *testing.T) {
+	if err := (EntryNote{Content: ""}).ValidateEntryNote(); err != nil {
+		t.Errorf(`An empty note should be valid: %v`, err)
+	}
+
+	if err := (EntryNote{Content: "**Important**"}).ValidateEntryNote(); err != nil {
+		t.Errorf(`A note should be valid: %v`, err)
+	}
+
+	if err := (EntryNote{Content: strings.Repeat("é", MaxAnnotationLength+1)}).ValidateEntryNote(); err == nil {
+		t.Error(`A note that is too long should be rejected`)
+	}
+}
+
+func TestValidateEntryHighlight(t *testing.T) {
+	if err := (EntryHighlight{Text: " \n "}).ValidateEntryHighlight(); err == nil {
+		t.Error(`An empty highlight should be rejected`)
+	}
+
+	if err := (EntryHighlight{Text: "a passage"}).ValidateEntryHighlight(); err != nil {
+		t.Errorf(`A highlight should be valid: %v`, err)
+	}
+
+	if err := (EntryHighlight{Text: strings.Repeat("a", MaxAnnotationLength+1)}).ValidateEntryHighlight(); err == nil {
+		t.Error(`A highlight that is too long should be rejected`)
+	}
+}
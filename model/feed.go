@@ -6,6 +6,7 @@ package model // import "miniflux.app/model"
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"miniflux.app/http/client"
@@ -30,6 +31,18 @@ type Feed struct {
 	Username           string    `json:"username"`
 	Password           string    `json:"password"`
 	Disabled           bool      `json:"disabled"`
+	Notify             bool      `json:"notify"`
+	Language           string    `json:"language"`
+	SearchLanguage     string    `json:"search_language"`
+	ItemSelector       string    `json:"item_selector"`
+	TitleSelector      string    `json:"title_selector"`
+	LinkSelector       string    `json:"link_selector"`
+	DateSelector       string    `json:"date_selector"`
+	ContentSelector    string    `json:"content_selector"`
+	ArchiveReadDays    int       `json:"archive_read_days"`
+	MaxEntries         int       `json:"max_entries"`
+	MarkAsReadDays     int       `json:"mark_as_read_days"`
+	Podcast            *Podcast  `json:"podcast,omitempty"`
 	Category           *Category `json:"category,omitempty"`
 	Entries            Entries   `json:"entries,omitempty"`
 	Icon               *FeedIcon `json:"icon"`
@@ -48,6 +61,25 @@ func (f *Feed) String() string {
 	)
 }
 
+// IsNewsletter returns true if the feed is generated from email newsletters.
+func (f *Feed) IsNewsletter() bool {
+	return strings.HasPrefix(f.FeedURL, NewsletterFeedURLPrefix)
+}
+
+// IsScraped returns true if the entries are extracted from a web page with CSS selectors.
+func (f *Feed) IsScraped() bool {
+	return f.ItemSelector != ""
+}
+
+// WithSelectors defines the CSS selectors used to extract entries from a web page.
+func (f *Feed) WithSelectors(item, title, link, date, content string) {
+	f.ItemSelector = item
+	f.TitleSelector = title
+	f.LinkSelector = link
+	f.DateSelector = date
+	f.ContentSelector = content
+}
+
 // WithClientResponse updates feed attributes from an HTTP request.
 func (f *Feed) WithClientResponse(response *client.Response) {
 	f.EtagHeader = response.ETag
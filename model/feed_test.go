@@ -107,3 +107,27 @@ func TestFeedCheckedNow(t *testing.T) {
 		t.Error(`The checked date must be set`)
 	}
 }
+
+func TestFeedIsNewsletter(t *testing.T) {
+	feed := &Feed{FeedURL: "newsletter:news@example.org"}
+	if !feed.IsNewsletter() {
+		t.Error(`The feed should be a newsletter`)
+	}
+
+	feed.FeedURL = "https://example.org/feed"
+	if feed.IsNewsletter() {
+		t.Error(`The feed should not be a newsletter`)
+	}
+}
+
+func TestFeedIsScraped(t *testing.T) {
+	feed := &Feed{}
+	if feed.IsScraped() {
+		t.Error(`The feed should not be scraped`)
+	}
+
+	feed.WithSelectors("article", "h2", "", "time", "")
+	if !feed.IsScraped() || feed.TitleSelector != "h2" || feed.DateSelector != "time" {
+		t.Errorf(`The selectors are not defined properly: %+v`, feed)
+	}
+}
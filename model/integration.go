@@ -30,4 +30,26 @@ type Integration struct {
 	PocketEnabled        bool
 	PocketAccessToken    string
 	PocketConsumerKey    string
+	WebhookEnabled       bool
+	WebhookURL           string
+	WebhookSecret        string
+	WebhookNewEntries    bool
+	WebhookSaveEntry     bool
+	LinkdingEnabled      bool
+	LinkdingURL          string
+	LinkdingAPIKey       string
+	LinkdingTags         string
+	LinkdingMarkAsUnread bool
+	ShaarliEnabled       bool
+	ShaarliURL           string
+	ShaarliAPISecret     string
+	ShaarliTags          string
+	ShaarliPrivate       bool
+	MatrixEnabled        bool
+	MatrixURL            string
+	MatrixAccessToken    string
+	MatrixRoomID         string
+	TelegramEnabled      bool
+	TelegramBotToken     string
+	TelegramChatID       string
 }
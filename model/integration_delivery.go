@@ -0,0 +1,84 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// Integration delivery statuses.
+const (
+	IntegrationDeliveryStatusPending    = "pending"
+	IntegrationDeliveryStatusProcessing = "processing"
+	IntegrationDeliveryStatusSuccess    = "success"
+	IntegrationDeliveryStatusFailed     = "failed"
+)
+
+// IntegrationDeliveryMaxAttempts is the number of attempts before giving up on a delivery.
+const IntegrationDeliveryMaxAttempts = 6
+
+// IntegrationDelivery represents an entry queued to be sent to a third-party service.
+type IntegrationDelivery struct {
+	ID            int64
+	UserID        int64
+	EntryID       int64
+	EntryTitle    string
+	EntryURL      string
+	Service       string
+	Status        string
+	Attempts      int
+	ErrorMsg      string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// WithSuccess marks the delivery as successful.
+func (d *IntegrationDelivery) WithSuccess() {
+	d.Attempts++
+	d.Status = IntegrationDeliveryStatusSuccess
+	d.ErrorMsg = ""
+}
+
+// WithError records a failed attempt and schedules the next one with an exponential backoff.
+func (d *IntegrationDelivery) WithError(message string) {
+	d.Attempts++
+	d.ErrorMsg = message
+
+	if d.Attempts >= IntegrationDeliveryMaxAttempts {
+		d.Status = IntegrationDeliveryStatusFailed
+	} else {
+		d.Status = IntegrationDeliveryStatusPending
+		d.NextAttemptAt = time.Now().Add(d.RetryDelay())
+	}
+}
+
+// RetryDelay returns the delay before the next attempt: 1, 2, 4, 8... minutes.
+func (d *IntegrationDelivery) RetryDelay() time.Duration {
+	if d.Attempts < 1 {
+		return 0
+	}
+
+	return time.Duration(1<<uint(d.Attempts-1)) * time.Minute
+}
+
+// UseTimezone converts dates to the given timezone.
+func (d *IntegrationDelivery) UseTimezone(tz string) {
+	d.CreatedAt = timezone.Convert(tz, d.CreatedAt)
+	d.UpdatedAt = timezone.Convert(tz, d.UpdatedAt)
+	d.NextAttemptAt = timezone.Convert(tz, d.NextAttemptAt)
+}
+
+// IntegrationDeliveries represents a list of deliveries.
+type IntegrationDeliveries []*IntegrationDelivery
+
+// UseTimezone converts dates of all deliveries to the given timezone.
+func (d IntegrationDeliveries) UseTimezone(tz string) {
+	for _, delivery := range d {
+		delivery.UseTimezone(tz)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntegrationDeliveryRetryDelay(t *testing.T) {
+	scenarios := map[int]time.Duration{
+		0: 0,
+		1: time.Minute,
+		2: 2 * time.Minute,
+		3: 4 * time.Minute,
+		5: 16 * time.Minute,
+	}
+
+	for attempts, expected := range scenarios {
+		delivery := &IntegrationDelivery{Attempts: attempts}
+		if delay := delivery.RetryDelay(); delay != expected {
+			t.Errorf(`Unexpected delay for %d attempts, got %v instead of %v`, attempts, delay, expected)
+		}
+	}
+}
+
+func TestIntegrationDeliveryWithError(t *testing.T) {
+	delivery := &IntegrationDelivery{Status: IntegrationDeliveryStatusProcessing}
+	delivery.WithError("status=500")
+
+	if delivery.Status != IntegrationDeliveryStatusPending {
+		t.Errorf(`A failed delivery should be retried, got status %q`, delivery.Status)
+	}
+
+	if delivery.ErrorMsg != "status=500" || delivery.Attempts != 1 {
+		t.Errorf(`The error and the number of attempts should be recorded`)
+	}
+
+	if !delivery.NextAttemptAt.After(time.Now()) {
+		t.Errorf(`The next attempt should be scheduled in the future`)
+	}
+
+	for delivery.Attempts < IntegrationDeliveryMaxAttempts {
+		delivery.WithError("status=500")
+	}
+
+	if delivery.Status != IntegrationDeliveryStatusFailed {
+		t.Errorf(`The delivery should fail after %d attempts, got status %q`, IntegrationDeliveryMaxAttempts, delivery.Status)
+	}
+}
+
+func TestIntegrationDeliveryWithSuccess(t *testing.T) {
+	delivery := &IntegrationDelivery{Attempts: 2, ErrorMsg: "timeout"}
+	delivery.WithSuccess()
+
+	if delivery.Status != IntegrationDeliveryStatusSuccess || delivery.ErrorMsg != "" || delivery.Attempts != 3 {
+		t.Errorf(`Unexpected delivery state: %+v`, delivery)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "strings"
+
+// IntegrationRule represents the automatic delivery settings of a third-party service.
+type IntegrationRule struct {
+	UserID      int64
+	Service     string
+	OnBookmark  bool
+	FeedIDs     []int64
+	CategoryIDs []int64
+	Keywords    string
+}
+
+// HasFeed returns true if new entries of the given feed are sent automatically.
+func (r *IntegrationRule) HasFeed(feedID int64) bool {
+	return containsID(r.FeedIDs, feedID)
+}
+
+// HasCategory returns true if new entries of the given category are sent automatically.
+func (r *IntegrationRule) HasCategory(categoryID int64) bool {
+	return containsID(r.CategoryIDs, categoryID)
+}
+
+// KeywordList returns the list of comma-separated keywords.
+func (r *IntegrationRule) KeywordList() []string {
+	var keywords []string
+	for _, keyword := range strings.Split(r.Keywords, ",") {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+// IntegrationRules represents a list of automatic delivery settings.
+type IntegrationRules []*IntegrationRule
+
+// ForService returns the rule of the given service, or an empty rule if there is none.
+func (r IntegrationRules) ForService(service string) *IntegrationRule {
+	for _, rule := range r {
+		if rule.Service == service {
+			return rule
+		}
+	}
+
+	return &IntegrationRule{Service: service}
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, value := range ids {
+		if value == id {
+			return true
+		}
+	}
+	return false
+}
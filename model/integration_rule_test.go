@@ -0,0 +1,32 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestIntegrationRuleKeywordList(t *testing.T) {
+	rule := &IntegrationRule{Keywords: " golang, PostgreSQL ,,"}
+
+	keywords := rule.KeywordList()
+	if len(keywords) != 2 || keywords[0] != "golang" || keywords[1] != "postgresql" {
+		t.Errorf(`Unexpected keywords: %v`, keywords)
+	}
+
+	if keywords := (&IntegrationRule{}).KeywordList(); len(keywords) != 0 {
+		t.Errorf(`An empty rule should not have keywords: %v`, keywords)
+	}
+}
+
+func TestIntegrationRulesForService(t *testing.T) {
+	rules := IntegrationRules{&IntegrationRule{Service: "wallabag", OnBookmark: true}}
+
+	if !rules.ForService("wallabag").OnBookmark {
+		t.Error(`The rule of the service should be returned`)
+	}
+
+	if rule := rules.ForService("pinboard"); rule.Service != "pinboard" || rule.OnBookmark {
+		t.Error(`An empty rule should be returned for unknown services`)
+	}
+}
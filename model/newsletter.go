@@ -0,0 +1,18 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// NewsletterFeedURLPrefix is the prefix of the URL of feeds generated from email newsletters.
+const NewsletterFeedURLPrefix = "newsletter:"
+
+// NewsletterAttachment represents a file attached to a newsletter.
+type NewsletterAttachment struct {
+	Token    string
+	UserID   int64
+	FeedID   int64
+	Filename string
+	MimeType string
+	Content  []byte
+}
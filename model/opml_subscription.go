@@ -0,0 +1,70 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// Actions recorded in the change log of OPML subscriptions.
+const (
+	OPMLSubscriptionChangeAdded   = "added"
+	OPMLSubscriptionChangeRemoved = "removed"
+	OPMLSubscriptionChangeMoved   = "moved"
+	OPMLSubscriptionChangeFailed  = "failed"
+)
+
+// OPMLSubscriptionMaxChanges is the number of changes displayed to the user.
+const OPMLSubscriptionMaxChanges = 100
+
+// OPMLSubscription represents a remote OPML file kept in sync with the feeds of a user.
+type OPMLSubscription struct {
+	ID            int64
+	UserID        int64
+	URL           string
+	RemoveMissing bool
+	CheckedAt     *time.Time
+	ErrorMsg      string
+	CreatedAt     time.Time
+}
+
+// OPMLSubscriptions represents a list of OPML subscriptions.
+type OPMLSubscriptions []*OPMLSubscription
+
+// UseTimezone converts dates to the given timezone.
+func (o OPMLSubscriptions) UseTimezone(name string) {
+	for _, subscription := range o {
+		subscription.CreatedAt = timezone.Convert(name, subscription.CreatedAt)
+		if subscription.CheckedAt != nil {
+			checkedAt := timezone.Convert(name, *subscription.CheckedAt)
+			subscription.CheckedAt = &checkedAt
+		}
+	}
+}
+
+// OPMLSubscriptionChange represents what a synchronization did to one feed.
+type OPMLSubscriptionChange struct {
+	ID              int64
+	SubscriptionID  int64
+	SubscriptionURL string
+	Action          string
+	FeedURL         string
+	Title           string
+	Category        string
+	Details         string
+	CreatedAt       time.Time
+}
+
+// OPMLSubscriptionChanges represents a list of changes.
+type OPMLSubscriptionChanges []*OPMLSubscriptionChange
+
+// UseTimezone converts dates to the given timezone.
+func (o OPMLSubscriptionChanges) UseTimezone(name string) {
+	for _, change := range o {
+		change.CreatedAt = timezone.Convert(name, change.CreatedAt)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Podcast represents the Podcasting 2.0 elements of a feed.
+// Specs: https://github.com/Podcastindex-org/podcast-namespace
+type Podcast struct {
+	GUID        string            `json:"guid,omitempty"`
+	Locked      bool              `json:"locked"`
+	LockedOwner string            `json:"locked_owner,omitempty"`
+	Funding     []*PodcastFunding `json:"funding,omitempty"`
+}
+
+// Value converts the podcast elements to JSON.
+func (p Podcast) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// Scan converts raw JSON data.
+func (p *Podcast) Scan(src interface{}) error {
+	return scanJSON("podcast", src, p)
+}
+
+// PodcastEpisode represents the Podcasting 2.0 elements of an entry.
+// The chapters and the transcripts belong to the enclosures of the entry.
+type PodcastEpisode struct {
+	Season         int              `json:"season,omitempty"`
+	SeasonName     string           `json:"season_name,omitempty"`
+	Episode        string           `json:"episode,omitempty"`
+	EpisodeDisplay string           `json:"episode_display,omitempty"`
+	Persons        []*PodcastPerson `json:"persons,omitempty"`
+}
+
+// Value converts the episode elements to JSON.
+func (p PodcastEpisode) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// Scan converts raw JSON data.
+func (p *PodcastEpisode) Scan(src interface{}) error {
+	return scanJSON("podcast episode", src, p)
+}
+
+// PodcastFunding represents a donation or membership link.
+type PodcastFunding struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+}
+
+// PodcastChapter represents a chapter of an episode.
+type PodcastChapter struct {
+	StartTime float64 `json:"start_time"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url,omitempty"`
+	Image     string  `json:"image,omitempty"`
+}
+
+// PodcastChapters represents the chapters of an enclosure, nil when they have not been downloaded yet.
+type PodcastChapters []*PodcastChapter
+
+// Value converts the chapters to JSON.
+func (p PodcastChapters) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(p)
+}
+
+// Scan converts raw JSON data.
+func (p *PodcastChapters) Scan(src interface{}) error {
+	if src == nil {
+		*p = nil
+		return nil
+	}
+
+	return scanJSON("podcast chapters", src, p)
+}
+
+// PodcastTranscript represents a transcript or a caption file of an episode.
+type PodcastTranscript struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
+
+// PodcastTranscripts represents the transcripts of an enclosure.
+type PodcastTranscripts []*PodcastTranscript
+
+// Value converts the transcripts to JSON.
+func (p PodcastTranscripts) Value() (driver.Value, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(p)
+}
+
+// Scan converts raw JSON data.
+func (p *PodcastTranscripts) Scan(src interface{}) error {
+	if src == nil {
+		*p = nil
+		return nil
+	}
+
+	return scanJSON("podcast transcripts", src, p)
+}
+
+// PodcastPerson represents a person involved in an episode.
+type PodcastPerson struct {
+	Name  string `json:"name"`
+	Role  string `json:"role,omitempty"`
+	Group string `json:"group,omitempty"`
+	Image string `json:"image,omitempty"`
+	URL   string `json:"url,omitempty"`
+}
+
+func scanJSON(name string, src, dest interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("%s: unable to assert type of src", name)
+	}
+
+	if err := json.Unmarshal(source, dest); err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+
+	return nil
+}
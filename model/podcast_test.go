@@ -0,0 +1,38 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestPodcastChaptersValue(t *testing.T) {
+	var chapters PodcastChapters
+	if value, err := chapters.Value(); err != nil || value != nil {
+		t.Errorf(`Chapters not downloaded yet should be stored as NULL, got %v (%v)`, value, err)
+	}
+
+	value, err := PodcastChapters{}.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(value.([]byte)) != "[]" {
+		t.Errorf(`Downloaded chapters should be stored even when empty, got %s`, value)
+	}
+}
+
+func TestPodcastChaptersScan(t *testing.T) {
+	chapters := PodcastChapters{{Title: "Intro"}}
+	if err := chapters.Scan(nil); err != nil || chapters != nil {
+		t.Errorf(`NULL should be scanned as nil chapters, got %v (%v)`, chapters, err)
+	}
+
+	if err := chapters.Scan([]byte(`[{"start_time": 90, "title": "Sponsor"}]`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chapters) != 1 || chapters[0].StartTime != 90 || chapters[0].Title != "Sponsor" {
+		t.Errorf(`Unexpected chapters: %+v`, chapters)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// Sources of published feeds.
+const (
+	PublishedFeedSourceStarred  = "starred"
+	PublishedFeedSourceCategory = "category"
+	PublishedFeedSourceTag      = "tag"
+)
+
+// PublishedFeedMaxEntries is the maximum number of entries served in a published feed.
+const PublishedFeedMaxEntries = 50
+
+// PublishedFeed represents a public feed generated from the entries of a user.
+type PublishedFeed struct {
+	ID         int64
+	UserID     int64
+	Token      string
+	Title      string
+	Source     string
+	CategoryID int64
+	Tag        string
+	CreatedAt  time.Time
+}
+
+// PublishedFeeds represents a list of published feeds.
+type PublishedFeeds []*PublishedFeed
+
+// UseTimezone converts creation date to the given timezone.
+func (p PublishedFeeds) UseTimezone(name string) {
+	for _, feed := range p {
+		feed.CreatedAt = timezone.Convert(name, feed.CreatedAt)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "errors"
+
+// ValidateRetentionPolicy validates the retention settings of a feed or a category.
+// Zero inherits the setting of the category, then the global setting, -1 disables the policy.
+func ValidateRetentionPolicy(archiveReadDays, maxEntries, markAsReadDays int) error {
+	if archiveReadDays < -1 || maxEntries < -1 || markAsReadDays < -1 {
+		return errors.New("The retention settings must be positive numbers, 0 to inherit the setting or -1 to disable it")
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateRetentionPolicy(t *testing.T) {
+	for _, values := range [][3]int{{0, 0, 0}, {-1, -1, -1}, {30, 500, 7}} {
+		if err := ValidateRetentionPolicy(values[0], values[1], values[2]); err != nil {
+			t.Errorf(`The retention policy %v should be valid: %v`, values, err)
+		}
+	}
+
+	for _, values := range [][3]int{{-2, 0, 0}, {0, -5, 0}, {0, 0, -30}} {
+		if err := ValidateRetentionPolicy(values[0], values[1], values[2]); err == nil {
+			t.Errorf(`The retention policy %v should be invalid`, values)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"errors"
+	"fmt"
+
+	"miniflux.app/search"
+)
+
+// SavedSearch represents a search query saved by the user and displayed like a feed.
+type SavedSearch struct {
+	ID          int64  `json:"id"`
+	UserID      int64  `json:"user_id"`
+	Title       string `json:"title"`
+	Query       string `json:"query"`
+	Status      string `json:"status"`
+	CategoryID  int64  `json:"category_id"`
+	UnreadCount int    `json:"unread_count"`
+}
+
+func (s *SavedSearch) String() string {
+	return fmt.Sprintf("ID=%d, UserID=%d, Title=%s, Query=%s", s.ID, s.UserID, s.Title, s.Query)
+}
+
+// SearchQuery parses the query of the saved search, dates are interpreted in the given timezone.
+func (s *SavedSearch) SearchQuery(timezone string) (*search.Query, error) {
+	return search.Parse(s.Query, timezone)
+}
+
+// ValidateSavedSearch validates the fields of a saved search.
+func (s SavedSearch) ValidateSavedSearch() error {
+	if s.Title == "" {
+		return errors.New("The title is mandatory")
+	}
+
+	if s.Query == "" {
+		return errors.New("The query is mandatory")
+	}
+
+	if s.Status != "" && s.Status != EntryStatusUnread && s.Status != EntryStatusRead {
+		return fmt.Errorf("Invalid status, it must be empty, %q or %q", EntryStatusUnread, EntryStatusRead)
+	}
+
+	if _, err := s.SearchQuery("UTC"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SavedSearches represents a list of saved searches.
+type SavedSearches []*SavedSearch
@@ -0,0 +1,34 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestValidateSavedSearch(t *testing.T) {
+	savedSearch := &SavedSearch{Title: "Go", Query: "golang OR go feed:blog", Status: EntryStatusUnread}
+	if err := savedSearch.ValidateSavedSearch(); err != nil {
+		t.Errorf(`A valid saved search should not generate any errors: %v`, err)
+	}
+
+	savedSearch = &SavedSearch{Query: "golang"}
+	if err := savedSearch.ValidateSavedSearch(); err == nil {
+		t.Error(`The title should be mandatory`)
+	}
+
+	savedSearch = &SavedSearch{Title: "Go"}
+	if err := savedSearch.ValidateSavedSearch(); err == nil {
+		t.Error(`The query should be mandatory`)
+	}
+
+	savedSearch = &SavedSearch{Title: "Go", Query: "golang", Status: EntryStatusRemoved}
+	if err := savedSearch.ValidateSavedSearch(); err == nil {
+		t.Error(`An invalid status should generate an error`)
+	}
+
+	savedSearch = &SavedSearch{Title: "Go", Query: `"golang`}
+	if err := savedSearch.ValidateSavedSearch(); err == nil {
+		t.Error(`An invalid query should generate an error`)
+	}
+}
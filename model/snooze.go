@@ -0,0 +1,57 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"fmt"
+	"time"
+)
+
+// Snooze durations offered to the user, a custom date can also be given.
+const (
+	SnoozeLaterToday      = "later_today"
+	SnoozeTomorrow        = "tomorrow"
+	SnoozeNextWeek        = "next_week"
+	snoozeMorningHour     = 8
+	snoozeLaterTodayHours = 3
+	snoozeDateFormat      = "2006-01-02T15:04"
+)
+
+// SnoozeTime returns the end of a snooze: "later_today" is in 3 hours, "tomorrow" and "next_week"
+// are the next morning and the next Monday morning. Other values are custom dates formatted
+// as YYYY-MM-DDTHH:MM in the location of now, or as RFC3339, and must be in the future.
+func SnoozeTime(value string, now time.Time) (time.Time, error) {
+	morning := func(days int) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day()+days, snoozeMorningHour, 0, 0, 0, now.Location())
+	}
+
+	switch value {
+	case SnoozeLaterToday:
+		return now.Add(snoozeLaterTodayHours * time.Hour), nil
+	case SnoozeTomorrow:
+		return morning(1), nil
+	case SnoozeNextWeek:
+		days := (int(time.Monday) - int(now.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return morning(days), nil
+	}
+
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		until, err = time.ParseInLocation(snoozeDateFormat, value, now.Location())
+	}
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf(`Invalid snooze value %q, use %q, %q, %q or a date formatted as YYYY-MM-DDTHH:MM or RFC3339`, value, SnoozeLaterToday, SnoozeTomorrow, SnoozeNextWeek)
+	}
+
+	if !until.After(now) {
+		return time.Time{}, fmt.Errorf(`The snooze date %q must be in the future`, value)
+	}
+
+	return until, nil
+}
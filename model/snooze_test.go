@@ -0,0 +1,58 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnoozeTime(t *testing.T) {
+	location, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A Wednesday.
+	now := time.Date(2019, time.November, 13, 21, 30, 0, 0, location)
+
+	scenarios := map[string]time.Time{
+		SnoozeLaterToday:       time.Date(2019, time.November, 14, 0, 30, 0, 0, location),
+		SnoozeTomorrow:         time.Date(2019, time.November, 14, 8, 0, 0, 0, location),
+		SnoozeNextWeek:         time.Date(2019, time.November, 18, 8, 0, 0, 0, location),
+		"2019-12-24T18:00":     time.Date(2019, time.December, 24, 18, 0, 0, 0, location),
+		"2019-12-24T18:00:00Z": time.Date(2019, time.December, 24, 18, 0, 0, 0, time.UTC),
+	}
+
+	for value, expected := range scenarios {
+		result, err := SnoozeTime(value, now)
+		if err != nil {
+			t.Errorf(`Unexpected error for %q: %v`, value, err)
+		} else if !result.Equal(expected) {
+			t.Errorf(`Unexpected snooze time for %q: got %v instead of %v`, value, result, expected)
+		}
+	}
+}
+
+func TestSnoozeTimeNextWeekFromMonday(t *testing.T) {
+	now := time.Date(2019, time.November, 18, 7, 0, 0, 0, time.UTC)
+	result, err := SnoozeTime(SnoozeNextWeek, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := time.Date(2019, time.November, 25, 8, 0, 0, 0, time.UTC); !result.Equal(expected) {
+		t.Errorf(`Unexpected snooze time: got %v instead of %v`, result, expected)
+	}
+}
+
+func TestInvalidSnoozeTime(t *testing.T) {
+	now := time.Date(2019, time.November, 13, 21, 30, 0, 0, time.UTC)
+	for _, value := range []string{"", "someday", "2019-11-13", "2019-11-13T20:00", "2019-11-13T19:00:00Z"} {
+		if _, err := SnoozeTime(value, now); err == nil {
+			t.Errorf(`The snooze value %q should be rejected`, value)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"time"
 
+	"miniflux.app/search"
 	"miniflux.app/timezone"
 )
 
@@ -22,6 +23,7 @@ type User struct {
 	Timezone          string            `json:"timezone"`
 	EntryDirection    string            `json:"entry_sorting_direction"`
 	KeyboardShortcuts bool              `json:"keyboard_shortcuts"`
+	SearchLanguage    string            `json:"search_language"`
 	LastLoginAt       *time.Time        `json:"last_login_at,omitempty"`
 	Extra             map[string]string `json:"extra"`
 }
@@ -42,6 +44,10 @@ func (u User) ValidateUserCreation() error {
 
 // ValidateUserModification validates user modification payload.
 func (u User) ValidateUserModification() error {
+	if u.SearchLanguage != "" && !search.IsValidConfiguration(u.SearchLanguage) {
+		return errors.New("This search language is not supported")
+	}
+
 	if u.Theme != "" {
 		return ValidateTheme(u.Theme)
 	}
@@ -58,4 +58,14 @@ func TestValidateUserModification(t *testing.T) {
 	if err := user.ValidateUserModification(); err == nil {
 		t.Error(`An invalid password should generate an error`)
 	}
+
+	user = &User{SearchLanguage: "german"}
+	if err := user.ValidateUserModification(); err != nil {
+		t.Error(`A valid search language should not generate any errors`)
+	}
+
+	user = &User{SearchLanguage: "klingon"}
+	if err := user.ValidateUserModification(); err == nil {
+		t.Error(`An invalid search language should generate an error`)
+	}
 }
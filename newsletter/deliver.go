@@ -0,0 +1,218 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"errors"
+	"fmt"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/storage"
+)
+
+// ErrUnknownRecipient is returned when none of the recipients belongs to a user.
+var ErrUnknownRecipient = errors.New("newsletter: unknown recipient")
+
+// DeliverFunc stores a message sent to the given recipients.
+type DeliverFunc func(recipients []string, message *Message) error
+
+// NewDeliverFunc returns a DeliverFunc that saves messages as feed entries.
+func NewDeliverFunc(store *storage.Storage, domain string) DeliverFunc {
+	return func(recipients []string, message *Message) error {
+		delivered := make(map[string]bool)
+		for _, recipient := range recipients {
+			token, name, ok := ParseRecipient(recipient, domain)
+			if !ok || delivered[token] {
+				continue
+			}
+
+			userID, err := store.UserIDByNewsletterToken(token)
+			if err != nil {
+				return err
+			}
+
+			if userID == 0 {
+				logger.Debug("[Newsletter] No user found for %q", recipient)
+				continue
+			}
+
+			if err := deliver(store, userID, name, message); err != nil {
+				return err
+			}
+
+			delivered[token] = true
+		}
+
+		if len(delivered) == 0 {
+			return ErrUnknownRecipient
+		}
+
+		return nil
+	}
+}
+
+func deliver(store *storage.Storage, userID int64, name string, message *Message) error {
+	sender := message.Sender()
+	if sender == "" {
+		return errors.New("newsletter: the message has no sender")
+	}
+
+	feedID, found, err := store.NewsletterFeedID(userID, sender)
+	if err != nil {
+		return err
+	}
+
+	if found && feedID == 0 {
+		logger.Debug("[Newsletter] User #%d has unsubscribed from %q", userID, sender)
+		return nil
+	}
+
+	if !found {
+		feedID, err = createFeed(store, userID, name, message)
+		if err != nil {
+			return err
+		}
+	}
+
+	feed, err := store.FeedByID(userID, feedID)
+	if err != nil {
+		return err
+	}
+
+	if feed == nil {
+		return fmt.Errorf("newsletter: feed #%d not found", feedID)
+	}
+
+	entry := &model.Entry{
+		Hash:    messageHash(message),
+		Title:   message.Subject,
+		URL:     message.ListArchive,
+		Author:  message.FromName,
+		Date:    message.Date,
+		Content: sanitizer.Sanitize(feed.SiteURL, message.Content()),
+	}
+
+	newEntries, err := store.UpdateEntries(userID, feedID, model.Entries{entry}, false)
+	if err != nil {
+		return err
+	}
+
+	// A message delivered again does not create a new entry, its attachments are already stored.
+	if len(newEntries) > 0 {
+		if err := storeAttachments(store, entry, message.Attachments); err != nil {
+			return err
+		}
+
+		settings, err := store.Integration(userID)
+		if err != nil {
+			return err
+		}
+
+		if err := integration.QueueNewEntries(store, feed, newEntries, settings); err != nil {
+			logger.Error("[Newsletter] %v", err)
+		}
+
+		go integration.PushEntries(feed, newEntries, settings)
+	}
+
+	return nil
+}
+
+func storeAttachments(store *storage.Storage, entry *model.Entry, attachments []*Attachment) error {
+	for _, attachment := range attachments {
+		newsletterAttachment := &model.NewsletterAttachment{
+			UserID:   entry.UserID,
+			FeedID:   entry.FeedID,
+			Filename: attachment.Filename,
+			MimeType: attachment.MimeType,
+			Content:  attachment.Content,
+		}
+
+		if err := store.CreateNewsletterAttachment(newsletterAttachment); err != nil {
+			return err
+		}
+
+		enclosure := &model.Enclosure{
+			UserID:   entry.UserID,
+			EntryID:  entry.ID,
+			URL:      AttachmentURL(newsletterAttachment),
+			MimeType: attachment.MimeType,
+			Size:     int64(len(attachment.Content)),
+		}
+
+		if err := store.CreateEnclosure(enclosure); err != nil {
+			return err
+		}
+
+		entry.Enclosures = append(entry.Enclosures, enclosure)
+	}
+
+	return nil
+}
+
+func createFeed(store *storage.Storage, userID int64, name string, message *Message) (int64, error) {
+	var category *model.Category
+	var err error
+
+	// The name of the address selects the category when it matches an existing one.
+	if name != "" {
+		category, err = store.CategoryByTitle(userID, name)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if category == nil {
+		category, err = store.FirstCategory(userID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if category == nil {
+		return 0, fmt.Errorf("newsletter: user #%d has no category", userID)
+	}
+
+	feed := &model.Feed{
+		UserID:  userID,
+		FeedURL: model.NewsletterFeedURLPrefix + message.Sender(),
+		SiteURL: message.ListArchive,
+		Title:   message.FromName,
+	}
+
+	if feed.SiteURL == "" {
+		feed.SiteURL = "mailto:" + message.FromAddress
+	}
+
+	if feed.Title == "" {
+		feed.Title = message.Sender()
+	}
+
+	feed.WithCategoryID(category.ID)
+	if err := store.CreateNewsletterFeed(feed, message.Sender()); err != nil {
+		return 0, err
+	}
+
+	logger.Info("[Newsletter] Feed #%d created for %q (user #%d)", feed.ID, message.Sender(), userID)
+	return feed.ID, nil
+}
+
+// AttachmentURL returns the absolute URL of a newsletter attachment.
+func AttachmentURL(attachment *model.NewsletterAttachment) string {
+	return config.Opts.BaseURL() + "/newsletter/attachment/" + attachment.Token
+}
+
+func messageHash(message *Message) string {
+	if message.ID != "" {
+		return crypto.Hash(message.ID)
+	}
+
+	return crypto.Hash(message.Sender() + message.Subject + message.Date.String())
+}
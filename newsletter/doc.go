@@ -0,0 +1,15 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package newsletter receives email newsletters and turns them into feeds.
+
+Messages are accepted by a built-in SMTP server or read from a Maildir.
+They must be sent to an address like "<token>+<name>@<domain>",
+the token identifies the user and the optional name can be used to
+select the category of the generated feed.
+
+*/
+package newsletter // import "miniflux.app/newsletter"
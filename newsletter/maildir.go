@@ -0,0 +1,67 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"miniflux.app/logger"
+)
+
+// ProcessMaildir delivers the messages waiting in the "new" folder of a Maildir.
+// Processed messages are moved to the "cur" folder and flagged as seen,
+// messages that could not be stored are left in place to be retried.
+func ProcessMaildir(dir, domain string, deliver DeliverFunc) error {
+	files, err := ioutil.ReadDir(filepath.Join(dir, "new"))
+	if err != nil {
+		return fmt.Errorf("newsletter: unable to read maildir: %v", err)
+	}
+
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+
+		filename := filepath.Join(dir, "new", file.Name())
+		if err := processMaildirFile(filename, domain, deliver); err != nil {
+			logger.Error("[Newsletter:Maildir] %s: %v", file.Name(), err)
+			continue
+		}
+
+		seen := filepath.Join(dir, "cur", file.Name()+":2,S")
+		if err := os.Rename(filename, seen); err != nil {
+			return fmt.Errorf("newsletter: unable to move message to cur: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func processMaildirFile(filename, domain string, deliver DeliverFunc) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	message, err := ParseMessage(f)
+	if err != nil {
+		// A message that cannot be parsed will never be delivered.
+		logger.Error("[Newsletter:Maildir] %v", err)
+		return nil
+	}
+
+	err = deliver(message.Recipients, message)
+	if err == ErrUnknownRecipient {
+		logger.Info("[Newsletter:Maildir] No recipient found for %q", message.ID)
+		return nil
+	}
+
+	return err
+}
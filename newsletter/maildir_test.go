@@ -0,0 +1,84 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const fixtureName = "1546336800.M1P1.example"
+
+func createTestMaildir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "maildir")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, folder := range []string{"new", "cur", "tmp"} {
+		if err := os.Mkdir(filepath.Join(dir, folder), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "maildir", "new", fixtureName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "new", fixtureName), data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func TestProcessMaildir(t *testing.T) {
+	dir := createTestMaildir(t)
+	defer os.RemoveAll(dir)
+
+	r := &recorder{}
+	if err := ProcessMaildir(dir, "newsletters.example.org", r.deliver); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.messages) != 1 || r.messages[0].ID != "issue-42@weekly.example.com" {
+		t.Fatalf(`The message has not been delivered: %v`, r.messages)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "cur", fixtureName+":2,S")); err != nil {
+		t.Errorf(`The message should be moved to cur: %v`, err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "new", fixtureName)); !os.IsNotExist(err) {
+		t.Error(`The message should not be in new anymore`)
+	}
+}
+
+func TestProcessMaildirWithDeliveryError(t *testing.T) {
+	dir := createTestMaildir(t)
+	defer os.RemoveAll(dir)
+
+	deliver := func(recipients []string, message *Message) error {
+		return errors.New("database unavailable")
+	}
+
+	if err := ProcessMaildir(dir, "newsletters.example.org", deliver); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "new", fixtureName)); err != nil {
+		t.Errorf(`The message should be kept for a later retry: %v`, err)
+	}
+}
+
+func TestProcessMissingMaildir(t *testing.T) {
+	if err := ProcessMaildir("/nonexistent/maildir", "example.org", nil); err == nil {
+		t.Error(`A missing maildir should return an error`)
+	}
+}
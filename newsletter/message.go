@@ -0,0 +1,274 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// MaxAttachmentSize is the size limit of the attachments kept as enclosures.
+const MaxAttachmentSize = 10 * 1024 * 1024
+
+// Attachment represents a file attached to a message.
+type Attachment struct {
+	Filename string
+	MimeType string
+	Content  []byte
+}
+
+// Message represents a parsed email.
+type Message struct {
+	ID             string
+	EnvelopeSender string
+	FromName       string
+	FromAddress    string
+	ListID         string
+	ListArchive    string
+	Subject        string
+	Date           time.Time
+	HTML           string
+	Text           string
+	Recipients     []string
+	Attachments    []*Attachment
+}
+
+// Sender returns the identifier of the newsletter: the mailing list or the sender address.
+// The headers can be forged, so when the message comes with an envelope sender of another domain,
+// this domain is part of the identifier and the message cannot be posted in the feed of another newsletter.
+func (m *Message) Sender() string {
+	sender := m.FromAddress
+	if m.ListID != "" {
+		sender = m.ListID
+	}
+
+	if m.EnvelopeSender == "" {
+		return sender
+	}
+
+	envelopeDomain := addressDomain(m.EnvelopeSender)
+	if sender == "" {
+		return m.EnvelopeSender
+	}
+
+	if isSameDomain(addressDomain(sender), envelopeDomain) {
+		return sender
+	}
+
+	return sender + " via " + envelopeDomain
+}
+
+// Content returns the HTML content of the message.
+// Plain text messages are converted to HTML paragraphs.
+func (m *Message) Content() string {
+	if m.HTML != "" || m.Text == "" {
+		return m.HTML
+	}
+
+	var b strings.Builder
+	text := strings.Replace(m.Text, "\r\n", "\n", -1)
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		b.WriteString("<p>")
+		b.WriteString(strings.Replace(html.EscapeString(paragraph), "\n", "<br>", -1))
+		b.WriteString("</p>")
+	}
+
+	return b.String()
+}
+
+// ParseMessage parses a raw email.
+func ParseMessage(r io.Reader) (*Message, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("newsletter: unable to read message: %v", err)
+	}
+
+	decoder := &mime.WordDecoder{CharsetReader: charset.NewReaderLabel}
+	message := &Message{
+		ID:          strings.Trim(msg.Header.Get("Message-Id"), "<> "),
+		ListID:      parseListID(msg.Header.Get("List-Id")),
+		ListArchive: parseListArchive(msg.Header.Get("List-Archive")),
+	}
+
+	if subject, err := decoder.DecodeHeader(msg.Header.Get("Subject")); err == nil {
+		message.Subject = strings.TrimSpace(subject)
+	}
+
+	if from, err := (&mail.AddressParser{WordDecoder: decoder}).Parse(msg.Header.Get("From")); err == nil {
+		message.FromName = from.Name
+		message.FromAddress = strings.ToLower(from.Address)
+	}
+
+	if date, err := msg.Header.Date(); err == nil {
+		message.Date = date
+	} else {
+		message.Date = time.Now()
+	}
+
+	for _, key := range []string{"Delivered-To", "X-Original-To", "To", "Cc"} {
+		for _, value := range msg.Header[key] {
+			addresses, err := mail.ParseAddressList(value)
+			if err != nil {
+				continue
+			}
+
+			for _, address := range addresses {
+				message.Recipients = append(message.Recipients, address.Address)
+			}
+		}
+	}
+
+	if err := message.parsePart(textproto.MIMEHeader(msg.Header), msg.Body); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (m *Message) parsePart(header textproto.MIMEHeader, body io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+		params = map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("newsletter: unable to read multipart message: %v", err)
+			}
+
+			if err := m.parsePart(part.Header, part); err != nil {
+				return err
+			}
+		}
+	}
+
+	content, err := ioutil.ReadAll(decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return fmt.Errorf("newsletter: unable to decode message part: %v", err)
+	}
+
+	disposition, dispositionParams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := dispositionParams["filename"]
+	if filename == "" {
+		filename = params["name"]
+	}
+
+	isText := mediaType == "text/html" || mediaType == "text/plain"
+	if disposition == "attachment" || (!isText && filename != "") {
+		if len(content) <= MaxAttachmentSize {
+			m.Attachments = append(m.Attachments, &Attachment{Filename: filename, MimeType: mediaType, Content: content})
+		}
+		return nil
+	}
+
+	switch mediaType {
+	case "text/html":
+		if m.HTML == "" {
+			m.HTML = toUTF8(params["charset"], content)
+		}
+	case "text/plain":
+		if m.Text == "" {
+			m.Text = toUTF8(params["charset"], content)
+		}
+	}
+
+	return nil
+}
+
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+func toUTF8(label string, content []byte) string {
+	if label == "" || strings.EqualFold(label, "utf-8") || strings.EqualFold(label, "us-ascii") {
+		return string(content)
+	}
+
+	reader, err := charset.NewReaderLabel(label, bytes.NewReader(content))
+	if err != nil {
+		return string(content)
+	}
+
+	converted, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return string(content)
+	}
+
+	return string(converted)
+}
+
+// parseListID extracts the identifier of a mailing list: "Weekly News <weekly.example.org>".
+// addressDomain returns the domain of an email address, or the value itself when it is a domain (list ID).
+func addressDomain(address string) string {
+	if i := strings.LastIndex(address, "@"); i != -1 {
+		return address[i+1:]
+	}
+
+	return address
+}
+
+// isSameDomain returns true if the domains are equal or if one is a subdomain of the other.
+func isSameDomain(a, b string) bool {
+	if !strings.Contains(a, ".") || !strings.Contains(b, ".") {
+		return false
+	}
+
+	return a == b || strings.HasSuffix(a, "."+b) || strings.HasSuffix(b, "."+a)
+}
+
+func parseListID(value string) string {
+	if start := strings.LastIndex(value, "<"); start != -1 {
+		if end := strings.Index(value[start:], ">"); end != -1 {
+			return strings.ToLower(strings.TrimSpace(value[start+1 : start+end]))
+		}
+	}
+
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// parseListArchive returns the first web address of the List-Archive header.
+func parseListArchive(value string) string {
+	for _, item := range strings.Split(value, ",") {
+		item = strings.Trim(strings.TrimSpace(item), "<>")
+		if strings.HasPrefix(item, "http://") || strings.HasPrefix(item, "https://") {
+			return item
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,131 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseMultipartMessage(t *testing.T) {
+	f, err := os.Open("testdata/maildir/new/1546336800.M1P1.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	message, err := ParseMessage(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.ID != "issue-42@weekly.example.com" {
+		t.Errorf(`Unexpected message ID, got %q`, message.ID)
+	}
+
+	if message.Subject != "Café edition" {
+		t.Errorf(`Unexpected subject, got %q`, message.Subject)
+	}
+
+	if message.FromName != "Weekly News" || message.FromAddress != "news@weekly.example.com" {
+		t.Errorf(`Unexpected sender, got %q <%s>`, message.FromName, message.FromAddress)
+	}
+
+	if message.Sender() != "weekly.example.com" {
+		t.Errorf(`The list ID should identify the sender, got %q`, message.Sender())
+	}
+
+	if message.ListArchive != "https://weekly.example.com/archive/42" {
+		t.Errorf(`Unexpected list archive, got %q`, message.ListArchive)
+	}
+
+	if !message.Date.Equal(time.Date(2019, time.January, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected date, got %v`, message.Date)
+	}
+
+	if !strings.Contains(message.HTML, `<h1>Café</h1><p>Hello <a href="https://weekly.example.com/a">world</a></p>`) {
+		t.Errorf(`The HTML part is not decoded properly, got %q`, message.HTML)
+	}
+
+	if message.Content() != message.HTML {
+		t.Error(`The HTML part should be preferred over the text part`)
+	}
+
+	if len(message.Recipients) != 2 || message.Recipients[0] != "0a1b2c+tech@newsletters.example.org" {
+		t.Errorf(`Unexpected recipients, got %v`, message.Recipients)
+	}
+
+	if len(message.Attachments) != 1 {
+		t.Fatalf(`Unexpected number of attachments, got %d`, len(message.Attachments))
+	}
+
+	attachment := message.Attachments[0]
+	if attachment.Filename != "report.pdf" || attachment.MimeType != "application/pdf" {
+		t.Errorf(`Unexpected attachment, got %q (%s)`, attachment.Filename, attachment.MimeType)
+	}
+
+	if !strings.HasPrefix(string(attachment.Content), "%PDF-1.4") {
+		t.Errorf(`The attachment is not decoded properly, got %q`, attachment.Content)
+	}
+}
+
+func TestParsePlainTextMessage(t *testing.T) {
+	data := "From: news@example.org\n" +
+		"Subject: Hello\n" +
+		"Content-Type: text/plain; charset=iso-8859-1\n" +
+		"Content-Transfer-Encoding: quoted-printable\n" +
+		"\n" +
+		"Caf=E9 <b>\n" +
+		"second line\n" +
+		"\n" +
+		"New paragraph\n"
+
+	message, err := ParseMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.Sender() != "news@example.org" {
+		t.Errorf(`The sender address should identify the sender, got %q`, message.Sender())
+	}
+
+	expected := `<p>Café &lt;b&gt;<br>second line</p><p>New paragraph</p>`
+	if message.Content() != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, message.Content(), expected)
+	}
+
+	if message.Date.IsZero() {
+		t.Error(`The date should be set when the header is missing`)
+	}
+}
+
+func TestParseInvalidMessage(t *testing.T) {
+	if _, err := ParseMessage(strings.NewReader("not an email")); err == nil {
+		t.Error(`An invalid message should return an error`)
+	}
+}
+
+func TestMessageSenderWithEnvelopeSender(t *testing.T) {
+	scenarios := []struct {
+		message  *Message
+		expected string
+	}{
+		{&Message{FromAddress: "news@example.com"}, "news@example.com"},
+		{&Message{FromAddress: "news@example.com", EnvelopeSender: "bounce-123@mail.example.com"}, "news@example.com"},
+		{&Message{FromAddress: "news@mail.example.com", EnvelopeSender: "bounce@example.com"}, "news@mail.example.com"},
+		{&Message{ListID: "weekly.example.com", FromAddress: "news@example.org", EnvelopeSender: "bounce@example.com"}, "weekly.example.com"},
+		{&Message{FromAddress: "news@example.com", EnvelopeSender: "someone@example.net"}, "news@example.com via example.net"},
+		{&Message{FromAddress: "news@example.com", EnvelopeSender: "someone@com"}, "news@example.com via com"},
+		{&Message{EnvelopeSender: "someone@example.net"}, "someone@example.net"},
+	}
+
+	for _, scenario := range scenarios {
+		if result := scenario.message.Sender(); result != scenario.expected {
+			t.Errorf(`Unexpected sender for %+v, got %q instead of %q`, scenario.message, result, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"net"
+	"strings"
+
+	"miniflux.app/config"
+	"miniflux.app/url"
+)
+
+// IsEnabled returns true if newsletters can be received by SMTP or from a Maildir.
+func IsEnabled() bool {
+	return config.Opts.NewsletterListenAddr() != "" || config.Opts.NewsletterMaildir() != ""
+}
+
+// Domain returns the domain of the newsletter addresses.
+func Domain() string {
+	if domain := config.Opts.NewsletterDomain(); domain != "" {
+		return strings.ToLower(domain)
+	}
+
+	host := url.Domain(config.Opts.BaseURL())
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	return strings.ToLower(host)
+}
+
+// Address returns the newsletter address for the given token and name.
+func Address(token, name string) string {
+	if name != "" {
+		token += "+" + name
+	}
+
+	return token + "@" + Domain()
+}
+
+// ParseRecipient extracts the user token and the optional name from a newsletter address.
+// Addresses of other domains are rejected unless the domain is empty.
+func ParseRecipient(address, domain string) (token, name string, ok bool) {
+	address = strings.ToLower(strings.Trim(strings.TrimSpace(address), "<>"))
+
+	at := strings.LastIndex(address, "@")
+	if at == -1 {
+		return "", "", false
+	}
+
+	if domain != "" && address[at+1:] != strings.ToLower(domain) {
+		return "", "", false
+	}
+
+	token = address[:at]
+	if plus := strings.Index(token, "+"); plus != -1 {
+		token, name = token[:plus], token[plus+1:]
+	}
+
+	if token == "" {
+		return "", "", false
+	}
+
+	return token, name, true
+}
@@ -0,0 +1,29 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import "testing"
+
+func TestParseRecipient(t *testing.T) {
+	scenarios := []struct {
+		address, domain, token, name string
+		ok                           bool
+	}{
+		{"0a1b2c@example.org", "example.org", "0a1b2c", "", true},
+		{"0a1b2c+Tech@Example.org", "example.org", "0a1b2c", "tech", true},
+		{"<0a1b2c+a+b@example.org>", "example.org", "0a1b2c", "a+b", true},
+		{"0a1b2c@example.org", "", "0a1b2c", "", true},
+		{"0a1b2c@other.org", "example.org", "", "", false},
+		{"+tech@example.org", "example.org", "", "", false},
+		{"example.org", "example.org", "", "", false},
+	}
+
+	for _, scenario := range scenarios {
+		token, name, ok := ParseRecipient(scenario.address, scenario.domain)
+		if ok != scenario.ok || token != scenario.token || name != scenario.name {
+			t.Errorf(`Unexpected result for %q: token=%q name=%q ok=%v`, scenario.address, token, name, ok)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"miniflux.app/logger"
+)
+
+const (
+	// MaxMessageSize is the size limit of the messages accepted by the SMTP server.
+	MaxMessageSize = 25 * 1024 * 1024
+
+	maxRecipients  = 100
+	commandTimeout = 5 * time.Minute
+)
+
+// Server is a minimal SMTP server that accepts newsletters for the users of this instance.
+// It does not relay messages, only recipients of the newsletter domain are accepted.
+type Server struct {
+	domain  string
+	deliver DeliverFunc
+}
+
+// NewServer returns a new SMTP server.
+func NewServer(domain string, deliver DeliverFunc) *Server {
+	return &Server{domain: domain, deliver: deliver}
+}
+
+// ListenAndServe listens on the given TCP address and handles incoming connections.
+func (s *Server) ListenAndServe(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return s.Serve(listener)
+}
+
+// Serve accepts incoming connections on the given listener.
+func (s *Server) Serve(listener net.Listener) error {
+	defer listener.Close()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.handleConnection(conn)
+	}
+}
+
+type smtpSession struct {
+	server     *Server
+	conn       net.Conn
+	text       *textproto.Conn
+	hasMail    bool
+	from       string
+	recipients []string
+}
+
+func (s *Server) handleConnection(conn net.Conn) {
+	session := &smtpSession{server: s, conn: conn, text: textproto.NewConn(conn)}
+	defer session.text.Close()
+
+	session.reply(220, "%s ESMTP Miniflux", s.domain)
+
+	for {
+		conn.SetDeadline(time.Now().Add(commandTimeout))
+
+		line, err := session.text.ReadLine()
+		if err != nil {
+			if err != io.EOF {
+				logger.Debug("[Newsletter:SMTP] %v", err)
+			}
+			return
+		}
+
+		verb, args := line, ""
+		if i := strings.Index(line, " "); i != -1 {
+			verb, args = line[:i], strings.TrimSpace(line[i+1:])
+		}
+
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			session.reset()
+			session.reply(250, "%s", s.domain)
+		case "EHLO":
+			session.reset()
+			session.reply(250, "%s\n8BITMIME\nSIZE %d", s.domain, MaxMessageSize)
+		case "MAIL":
+			session.handleMail(args)
+		case "RCPT":
+			session.handleRcpt(args)
+		case "DATA":
+			if !session.handleData() {
+				return
+			}
+		case "RSET":
+			session.reset()
+			session.reply(250, "OK")
+		case "NOOP":
+			session.reply(250, "OK")
+		case "VRFY":
+			session.reply(252, "Cannot verify user")
+		case "QUIT":
+			session.reply(221, "Bye")
+			return
+		default:
+			session.reply(502, "Command not implemented")
+		}
+	}
+}
+
+// reply writes a response, multiline messages are separated by "\n".
+func (s *smtpSession) reply(code int, format string, args ...interface{}) {
+	lines := strings.Split(fmt.Sprintf(format, args...), "\n")
+	for i, line := range lines {
+		separator := " "
+		if i < len(lines)-1 {
+			separator = "-"
+		}
+		s.text.PrintfLine("%d%s%s", code, separator, line)
+	}
+}
+
+func (s *smtpSession) reset() {
+	s.hasMail = false
+	s.from = ""
+	s.recipients = nil
+}
+
+func (s *smtpSession) handleMail(args string) {
+	if !strings.HasPrefix(strings.ToUpper(args), "FROM:") {
+		s.reply(501, "Syntax: MAIL FROM:<address>")
+		return
+	}
+
+	from := parsePath(args[5:])
+	if !strings.Contains(from, "@") {
+		s.reply(550, "Sender address rejected")
+		return
+	}
+
+	s.reset()
+	s.hasMail = true
+	s.from = strings.ToLower(from)
+	s.reply(250, "OK")
+}
+
+func (s *smtpSession) handleRcpt(args string) {
+	if !strings.HasPrefix(strings.ToUpper(args), "TO:") {
+		s.reply(501, "Syntax: RCPT TO:<address>")
+		return
+	}
+
+	if !s.hasMail {
+		s.reply(503, "Need MAIL command first")
+		return
+	}
+
+	if len(s.recipients) >= maxRecipients {
+		s.reply(452, "Too many recipients")
+		return
+	}
+
+	recipient := parsePath(args[3:])
+	if _, _, ok := ParseRecipient(recipient, s.server.domain); !ok {
+		s.reply(550, "Mailbox unavailable")
+		return
+	}
+
+	s.recipients = append(s.recipients, recipient)
+	s.reply(250, "OK")
+}
+
+// handleData reads the message and returns false if the connection must be closed.
+func (s *smtpSession) handleData() bool {
+	if len(s.recipients) == 0 {
+		s.reply(503, "Need RCPT command first")
+		return true
+	}
+
+	s.reply(354, "End data with <CR><LF>.<CR><LF>")
+
+	reader := s.text.DotReader()
+	data, err := ioutil.ReadAll(io.LimitReader(reader, MaxMessageSize+1))
+	if err != nil {
+		logger.Debug("[Newsletter:SMTP] %v", err)
+		return false
+	}
+
+	if len(data) > MaxMessageSize {
+		io.Copy(ioutil.Discard, reader)
+		s.reset()
+		s.reply(552, "Message exceeds fixed maximum message size")
+		return true
+	}
+
+	recipients, from := s.recipients, s.from
+	logger.Debug("[Newsletter:SMTP] Message from %q to %v", from, recipients)
+	s.reset()
+
+	message, err := ParseMessage(bytes.NewReader(data))
+	if err != nil {
+		logger.Error("[Newsletter:SMTP] %v", err)
+		s.reply(554, "Unable to parse the message")
+		return true
+	}
+
+	message.EnvelopeSender = from
+
+	if err := s.server.deliver(recipients, message); err != nil {
+		if err == ErrUnknownRecipient {
+			s.reply(550, "Mailbox unavailable")
+		} else {
+			logger.Error("[Newsletter:SMTP] %v", err)
+			s.reply(451, "Unable to store the message, try again later")
+		}
+		return true
+	}
+
+	s.reply(250, "OK")
+	return true
+}
+
+// parsePath extracts the address of a SMTP path: "<user@example.org> SIZE=1000".
+func parsePath(value string) string {
+	value = strings.TrimSpace(value)
+	if start := strings.Index(value, "<"); start != -1 {
+		if end := strings.Index(value[start:], ">"); end != -1 {
+			return value[start+1 : start+end]
+		}
+	}
+
+	if i := strings.Index(value, " "); i != -1 {
+		value = value[:i]
+	}
+
+	return value
+}
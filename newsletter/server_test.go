@@ -0,0 +1,148 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"io/ioutil"
+	"net"
+	"net/smtp"
+	"sync"
+	"testing"
+)
+
+type recorder struct {
+	sync.Mutex
+	recipients []string
+	messages   []*Message
+}
+
+func (r *recorder) deliver(recipients []string, message *Message) error {
+	r.Lock()
+	defer r.Unlock()
+
+	r.recipients = append(r.recipients, recipients...)
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func startTestServer(t *testing.T, deliver DeliverFunc) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go NewServer("newsletters.example.org", deliver).Serve(listener)
+	return listener.Addr().String()
+}
+
+func TestServerReceiveMessage(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/maildir/new/1546336800.M1P1.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &recorder{}
+	addr := startTestServer(t, r.deliver)
+
+	err = smtp.SendMail(addr, nil, "news@weekly.example.com", []string{"0a1b2c+tech@newsletters.example.org"}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if len(r.messages) != 1 {
+		t.Fatalf(`Unexpected number of messages, got %d`, len(r.messages))
+	}
+
+	if r.recipients[0] != "0a1b2c+tech@newsletters.example.org" {
+		t.Errorf(`The envelope recipient should be used, got %v`, r.recipients)
+	}
+
+	if r.messages[0].Subject != "Café edition" || len(r.messages[0].Attachments) != 1 {
+		t.Errorf(`The message is not parsed properly: %+v`, r.messages[0])
+	}
+
+	if r.messages[0].EnvelopeSender != "news@weekly.example.com" {
+		t.Errorf(`The envelope sender should be recorded, got %q`, r.messages[0].EnvelopeSender)
+	}
+}
+
+func TestServerForgedSender(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/maildir/new/1546336800.M1P1.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &recorder{}
+	addr := startTestServer(t, r.deliver)
+
+	err = smtp.SendMail(addr, nil, "Someone@Evil.example.net", []string{"0a1b2c@newsletters.example.org"}, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if sender := r.messages[0].Sender(); sender != "weekly.example.com via evil.example.net" {
+		t.Errorf(`The envelope domain should be part of the sender, got %q`, sender)
+	}
+}
+
+func TestServerRejectNullSender(t *testing.T) {
+	r := &recorder{}
+	addr := startTestServer(t, r.deliver)
+
+	err := smtp.SendMail(addr, nil, "", []string{"0a1b2c@newsletters.example.org"}, []byte("Subject: bounce\r\n\r\nHello"))
+	if err == nil {
+		t.Fatal(`Messages without envelope sender should be refused`)
+	}
+
+	if len(r.messages) != 0 {
+		t.Error(`No message should be delivered`)
+	}
+}
+
+func TestServerRejectOtherDomains(t *testing.T) {
+	r := &recorder{}
+	addr := startTestServer(t, r.deliver)
+
+	err := smtp.SendMail(addr, nil, "spammer@example.com", []string{"someone@example.net"}, []byte("Subject: relay\r\n\r\nHello"))
+	if err == nil {
+		t.Fatal(`Relaying to other domains should be refused`)
+	}
+
+	if len(r.messages) != 0 {
+		t.Error(`No message should be delivered`)
+	}
+}
+
+func TestServerUnknownRecipient(t *testing.T) {
+	addr := startTestServer(t, func(recipients []string, message *Message) error {
+		return ErrUnknownRecipient
+	})
+
+	err := smtp.SendMail(addr, nil, "news@example.com", []string{"unknown@newsletters.example.org"}, []byte("Subject: test\r\n\r\nHello"))
+	if err == nil {
+		t.Fatal(`Messages for unknown users should be refused`)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	scenarios := map[string]string{
+		"<user@example.org>":           "user@example.org",
+		" <user@example.org> SIZE=100": "user@example.org",
+		"user@example.org":             "user@example.org",
+		"<>":                           "",
+	}
+
+	for input, expected := range scenarios {
+		if result := parsePath(input); result != expected {
+			t.Errorf(`Unexpected path for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const temporaryFileSuffix = ".tmp"
+
+// Cache stores images on disk and removes the least recently used ones when the size limit is reached.
+type Cache struct {
+	directory string
+	maxSize   int64
+
+	mu    sync.Mutex
+	size  int64
+	items map[string]*list.Element
+	lru   *list.List
+}
+
+type cacheItem struct {
+	key  string
+	size int64
+}
+
+// NewCache returns a cache stored in the given directory, existing files are loaded in the cache.
+func NewCache(directory string, maxSize int64) (*Cache, error) {
+	if err := os.MkdirAll(directory, 0700); err != nil {
+		return nil, fmt.Errorf("proxy: unable to create cache directory: %v", err)
+	}
+
+	files, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: unable to read cache directory: %v", err)
+	}
+
+	// The modification time is updated each time an image is served, the oldest files are the least recently used.
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	c := &Cache{
+		directory: directory,
+		maxSize:   maxSize,
+		items:     make(map[string]*list.Element),
+		lru:       list.New(),
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		if strings.HasSuffix(file.Name(), temporaryFileSuffix) {
+			os.Remove(filepath.Join(directory, file.Name()))
+			continue
+		}
+
+		c.items[file.Name()] = c.lru.PushFront(&cacheItem{key: file.Name(), size: file.Size()})
+		c.size += file.Size()
+	}
+
+	c.removeFiles(c.evict())
+	return c, nil
+}
+
+// Size returns the total size of the cached files.
+func (c *Cache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// Contains returns true if the key is in the cache.
+func (c *Cache) Contains(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, found := c.items[key]
+	return found
+}
+
+// Get returns the cached image, nil if the image is not in the cache.
+// The lock protects only the bookkeeping, the file is read without holding it.
+func (c *Cache) Get(key string) *Image {
+	c.mu.Lock()
+	_, found := c.items[key]
+	c.mu.Unlock()
+
+	if !found {
+		return nil
+	}
+
+	filename := filepath.Join(c.directory, key)
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		c.discard(key)
+		return nil
+	}
+
+	separator := bytes.IndexByte(data, '\n')
+	if separator == -1 {
+		c.discard(key)
+		return nil
+	}
+
+	now := time.Now()
+	os.Chtimes(filename, now, now)
+
+	c.mu.Lock()
+	if element, found := c.items[key]; found {
+		c.lru.MoveToFront(element)
+	}
+	c.mu.Unlock()
+
+	return &Image{ContentType: string(data[:separator]), Content: data[separator+1:]}
+}
+
+// Put stores an image in the cache. The file starts with the content type followed by a new line.
+// It is written to a temporary file renamed once complete, so readers never see a partial image.
+func (c *Cache) Put(key string, image *Image) error {
+	size := int64(len(image.ContentType) + 1 + len(image.Content))
+	if size > c.maxSize {
+		return nil
+	}
+
+	data := make([]byte, 0, size)
+	data = append(data, image.ContentType...)
+	data = append(data, '\n')
+	data = append(data, image.Content...)
+
+	temporaryFile, err := ioutil.TempFile(c.directory, key+".*"+temporaryFileSuffix)
+	if err != nil {
+		return fmt.Errorf("proxy: unable to create cache file: %v", err)
+	}
+
+	_, err = temporaryFile.Write(data)
+	if closeErr := temporaryFile.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(temporaryFile.Name(), filepath.Join(c.directory, key))
+	}
+
+	if err != nil {
+		os.Remove(temporaryFile.Name())
+		return fmt.Errorf("proxy: unable to write cache file: %v", err)
+	}
+
+	c.mu.Lock()
+	if element, found := c.items[key]; found {
+		c.forget(element)
+	}
+
+	c.items[key] = c.lru.PushFront(&cacheItem{key: key, size: size})
+	c.size += size
+	evicted := c.evict()
+	c.mu.Unlock()
+
+	c.removeFiles(evicted)
+	return nil
+}
+
+// discard removes an unreadable file from the cache.
+func (c *Cache) discard(key string) {
+	c.mu.Lock()
+	element, found := c.items[key]
+	if found {
+		c.forget(element)
+	}
+	c.mu.Unlock()
+
+	if found {
+		c.removeFiles([]string{key})
+	}
+}
+
+// evict removes the least recently used items from the bookkeeping and returns their keys.
+func (c *Cache) evict() []string {
+	var keys []string
+	for c.size > c.maxSize {
+		element := c.lru.Back()
+		if element == nil {
+			break
+		}
+
+		keys = append(keys, c.forget(element))
+	}
+
+	return keys
+}
+
+func (c *Cache) forget(element *list.Element) string {
+	item := c.lru.Remove(element).(*cacheItem)
+	delete(c.items, item.key)
+	c.size -= item.size
+	return item.key
+}
+
+func (c *Cache) removeFiles(keys []string) {
+	for _, key := range keys {
+		os.Remove(filepath.Join(c.directory, key))
+	}
+}
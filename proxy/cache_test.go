@@ -0,0 +1,173 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func newTestCache(t *testing.T, maxSize int64) (*Cache, string) {
+	directory, err := ioutil.TempDir("", "miniflux-proxy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err := NewCache(directory, maxSize)
+	if err != nil {
+		os.RemoveAll(directory)
+		t.Fatal(err)
+	}
+
+	return cache, directory
+}
+
+func TestCachePutAndGet(t *testing.T) {
+	cache, directory := newTestCache(t, 1024)
+	defer os.RemoveAll(directory)
+
+	if image := cache.Get("missing"); image != nil {
+		t.Fatal(`Missing keys should return nil`)
+	}
+
+	if err := cache.Put("key", &Image{ContentType: "image/png", Content: []byte("png data")}); err != nil {
+		t.Fatal(err)
+	}
+
+	image := cache.Get("key")
+	if image == nil {
+		t.Fatal(`The image should be in the cache`)
+	}
+
+	if image.ContentType != "image/png" {
+		t.Errorf(`Unexpected content type, got %q instead of %q`, image.ContentType, "image/png")
+	}
+
+	if !bytes.Equal(image.Content, []byte("png data")) {
+		t.Errorf(`Unexpected content, got %q`, image.Content)
+	}
+
+	if cache.Size() != int64(len("image/png\npng data")) {
+		t.Errorf(`Unexpected cache size: %d`, cache.Size())
+	}
+}
+
+func TestCachePutReplacesImage(t *testing.T) {
+	cache, directory := newTestCache(t, 1024)
+	defer os.RemoveAll(directory)
+
+	cache.Put("key", &Image{ContentType: "image/png", Content: []byte("old")})
+	cache.Put("key", &Image{ContentType: "image/gif", Content: []byte("new data")})
+
+	image := cache.Get("key")
+	if image == nil || image.ContentType != "image/gif" || string(image.Content) != "new data" {
+		t.Fatalf(`The image should be replaced, got %+v`, image)
+	}
+
+	if cache.Size() != int64(len("image/gif\nnew data")) {
+		t.Errorf(`Unexpected cache size: %d`, cache.Size())
+	}
+
+	files, _ := ioutil.ReadDir(directory)
+	if len(files) != 1 {
+		t.Errorf(`Temporary files should be renamed, got %d files`, len(files))
+	}
+}
+
+func TestCacheConcurrentAccess(t *testing.T) {
+	cache, directory := newTestCache(t, 100)
+	defer os.RemoveAll(directory)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				key := string(rune('a' + (i+j)%5))
+				cache.Put(key, &Image{ContentType: "image/png", Content: bytes.Repeat([]byte("x"), 20)})
+				if image := cache.Get(key); image != nil && len(image.Content) != 20 {
+					t.Errorf(`Partial image read from the cache: %q`, image.Content)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if cache.Size() > 100 {
+		t.Errorf(`The cache size should stay under the limit, got %d`, cache.Size())
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsedImages(t *testing.T) {
+	cache, directory := newTestCache(t, 30)
+	defer os.RemoveAll(directory)
+
+	image := &Image{ContentType: "image/gif", Content: []byte("12345")}
+	cache.Put("a", image)
+	cache.Put("b", image)
+
+	// "a" becomes the most recently used image.
+	cache.Get("a")
+	cache.Put("c", image)
+
+	if !cache.Contains("a") || !cache.Contains("c") {
+		t.Error(`The most recently used images should be kept`)
+	}
+
+	if cache.Contains("b") {
+		t.Error(`The least recently used image should be evicted`)
+	}
+
+	if _, err := os.Stat(filepath.Join(directory, "b")); !os.IsNotExist(err) {
+		t.Error(`The evicted file should be removed from the disk`)
+	}
+
+	if cache.Size() > 30 {
+		t.Errorf(`The cache is larger than the limit: %d`, cache.Size())
+	}
+}
+
+func TestCacheIgnoresImagesLargerThanLimit(t *testing.T) {
+	cache, directory := newTestCache(t, 10)
+	defer os.RemoveAll(directory)
+
+	if err := cache.Put("key", &Image{ContentType: "image/png", Content: []byte("too large for the cache")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if cache.Contains("key") {
+		t.Error(`The image should not be cached`)
+	}
+}
+
+func TestCacheLoadsExistingFiles(t *testing.T) {
+	cache, directory := newTestCache(t, 1024)
+	defer os.RemoveAll(directory)
+
+	cache.Put("key", &Image{ContentType: "image/png", Content: []byte("png data")})
+	ioutil.WriteFile(filepath.Join(directory, "partial"+temporaryFileSuffix), []byte("x"), 0600)
+
+	reloaded, err := NewCache(directory, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if image := reloaded.Get("key"); image == nil || image.ContentType != "image/png" {
+		t.Error(`Existing files should be loaded in the cache`)
+	}
+
+	if reloaded.Size() != cache.Size() {
+		t.Errorf(`Unexpected cache size, got %d instead of %d`, reloaded.Size(), cache.Size())
+	}
+
+	if _, err := os.Stat(filepath.Join(directory, "partial"+temporaryFileSuffix)); !os.IsNotExist(err) {
+		t.Error(`Temporary files should be removed`)
+	}
+}
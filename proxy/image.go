@@ -0,0 +1,338 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	prefetchWorkers   = 4
+	prefetchQueueSize = 1000
+
+	// sniffLength is the number of bytes read to detect the content type.
+	sniffLength = 1024
+)
+
+var (
+	cacheOnce    sync.Once
+	defaultCache *Cache
+
+	prefetchOnce  sync.Once
+	prefetchQueue chan string
+)
+
+// Image represents a downloaded image.
+type Image struct {
+	ContentType string
+	Content     []byte
+}
+
+// Download represents an image read from the cache or streamed from the remote server.
+type Download struct {
+	ContentType string
+	io.ReadCloser
+}
+
+// Open returns the image from the cache, the image is streamed from the remote server on cache miss
+// and stored in the cache once it has been read entirely.
+func Open(imageURL string) (*Download, error) {
+	return open(imageCache(), imageURL)
+}
+
+func open(cache *Cache, imageURL string) (*Download, error) {
+	key := crypto.Hash(imageURL)
+
+	if cache != nil {
+		if image := cache.Get(key); image != nil {
+			return &Download{ContentType: image.ContentType, ReadCloser: ioutil.NopCloser(bytes.NewReader(image.Content))}, nil
+		}
+	}
+
+	response, err := stream(imageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// The beginning of the content is enough to detect the content type.
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(response.Body, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		response.Body.Close()
+		return nil, fmt.Errorf("proxy: unable to read image %q: %v", imageURL, err)
+	}
+	head = head[:n]
+
+	contentType, err := detectContentType(response.Header.Get("Content-Type"), head)
+	if err != nil {
+		response.Body.Close()
+		return nil, fmt.Errorf("proxy: %q is not an image: %v", imageURL, err)
+	}
+
+	reader := io.MultiReader(bytes.NewReader(head), response.Body)
+	if cache == nil {
+		return &Download{ContentType: contentType, ReadCloser: &readCloser{reader, response.Body}}, nil
+	}
+
+	return &Download{
+		ContentType: contentType,
+		ReadCloser: &cachingReader{
+			reader:      reader,
+			body:        response.Body,
+			cache:       cache,
+			key:         key,
+			contentType: contentType,
+			maxSize:     config.Opts.ProxyCacheMaxImageSize(),
+		},
+	}, nil
+}
+
+// Get returns the image from the cache, the image is downloaded on cache miss.
+func Get(imageURL string) (*Image, error) {
+	cache := imageCache()
+	key := crypto.Hash(imageURL)
+
+	if cache != nil {
+		if image := cache.Get(key); image != nil {
+			return image, nil
+		}
+	}
+
+	image, err := Fetch(imageURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if cache != nil {
+		if err := cache.Put(key, image); err != nil {
+			logger.Error("[Proxy] %v", err)
+		}
+	}
+
+	return image, nil
+}
+
+// Fetch downloads an image and makes sure the response is actually an image.
+// Images larger than the maximum size of the images stored in the cache are rejected.
+func Fetch(imageURL string) (*Image, error) {
+	response, err := stream(imageURL)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	maxSize := config.Opts.ProxyCacheMaxImageSize()
+	content, err := ioutil.ReadAll(io.LimitReader(response.Body, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("proxy: unable to read image %q: %v", imageURL, err)
+	}
+
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("proxy: image %q is larger than %d bytes", imageURL, maxSize)
+	}
+
+	contentType, err := detectContentType(response.Header.Get("Content-Type"), content)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: %q is not an image: %v", imageURL, err)
+	}
+
+	return &Image{ContentType: contentType, Content: content}, nil
+}
+
+// stream sends the request without reading the body, the caller must close the body of the response.
+func stream(imageURL string) (*http.Response, error) {
+	response, err := client.New(imageURL).Stream()
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return nil, fmt.Errorf("proxy: unable to download %q (status=%d)", imageURL, response.StatusCode)
+	}
+
+	return response, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// cachingReader stores the image in the cache once it has been read entirely.
+// Images larger than the maximum size are not buffered and never stored.
+type cachingReader struct {
+	reader      io.Reader
+	body        io.Closer
+	cache       *Cache
+	key         string
+	contentType string
+	maxSize     int64
+	content     []byte
+	skipped     bool
+}
+
+func (r *cachingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+
+	if !r.skipped {
+		if int64(len(r.content)+n) > r.maxSize {
+			r.skipped = true
+			r.content = nil
+		} else {
+			r.content = append(r.content, p[:n]...)
+		}
+	}
+
+	if err == io.EOF && !r.skipped {
+		r.skipped = true
+		if err := r.cache.Put(r.key, &Image{ContentType: r.contentType, Content: r.content}); err != nil {
+			logger.Error("[Proxy] %v", err)
+		}
+		r.content = nil
+	}
+
+	return n, err
+}
+
+func (r *cachingReader) Close() error {
+	return r.body.Close()
+}
+
+// Prefetch queues the download into the cache of the images of the content that are going to be proxified.
+// Images are downloaded in the background by a fixed number of workers,
+// they are skipped when the queue is full.
+func Prefetch(content string) {
+	cache := imageCache()
+	if cache == nil {
+		return
+	}
+
+	prefetchOnce.Do(func() {
+		prefetchQueue = make(chan string, prefetchQueueSize)
+		for i := 0; i < prefetchWorkers; i++ {
+			go prefetchWorker(prefetchQueue)
+		}
+	})
+
+	for _, imageURL := range prefetchImageURLs(content, config.Opts.ProxyImages()) {
+		if cache.Contains(crypto.Hash(imageURL)) {
+			continue
+		}
+
+		if !enqueue(prefetchQueue, imageURL) {
+			logger.Debug("[Proxy] Prefetch queue is full, skipping %q", imageURL)
+		}
+	}
+}
+
+func prefetchWorker(queue <-chan string) {
+	for imageURL := range queue {
+		if _, err := Get(imageURL); err != nil {
+			logger.Debug("[Proxy] Unable to prefetch image: %v", err)
+		}
+	}
+}
+
+// enqueue adds the image URL to the queue without blocking, it returns false if the queue is full.
+func enqueue(queue chan<- string, imageURL string) bool {
+	select {
+	case queue <- imageURL:
+		return true
+	default:
+		return false
+	}
+}
+
+// prefetchImageURLs returns the URLs of the images that are proxified in the content.
+func prefetchImageURLs(content, proxyImages string) []string {
+	if proxyImages == "none" {
+		return nil
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return nil
+	}
+
+	var imageURLs []string
+	doc.Find("img[src]").Each(func(i int, img *goquery.Selection) {
+		imageURL, _ := img.Attr("src")
+		if !strings.HasPrefix(imageURL, "http://") && !strings.HasPrefix(imageURL, "https://") {
+			return
+		}
+
+		if proxyImages != "all" && url.IsHTTPS(imageURL) {
+			return
+		}
+
+		imageURLs = append(imageURLs, imageURL)
+	})
+
+	return imageURLs
+}
+
+func imageCache() *Cache {
+	cacheOnce.Do(func() {
+		directory := config.Opts.ProxyCacheDirectory()
+		if directory == "" {
+			return
+		}
+
+		cache, err := NewCache(directory, int64(config.Opts.ProxyCacheMaxSize())*1024*1024)
+		if err != nil {
+			logger.Error("[Proxy] Image cache disabled: %v", err)
+			return
+		}
+
+		defaultCache = cache
+	})
+
+	return defaultCache
+}
+
+// detectContentType sniffs the content and only trusts the declared content type
+// for image formats that cannot be detected.
+func detectContentType(declaredContentType string, content []byte) (string, error) {
+	sniffedContentType := http.DetectContentType(content)
+	if strings.HasPrefix(sniffedContentType, "image/") {
+		return sniffedContentType, nil
+	}
+
+	declaredMediaType, _, _ := mime.ParseMediaType(declaredContentType)
+	declaredMediaType = strings.ToLower(declaredMediaType)
+
+	switch {
+	case declaredMediaType == "image/svg+xml":
+		// SVG images are XML documents.
+		head := content
+		if len(head) > sniffLength {
+			head = head[:sniffLength]
+		}
+
+		if bytes.Contains(bytes.ToLower(head), []byte("<svg")) {
+			return declaredMediaType, nil
+		}
+	case strings.HasPrefix(declaredMediaType, "image/") && sniffedContentType == "application/octet-stream":
+		return declaredMediaType, nil
+	}
+
+	return "", fmt.Errorf("unexpected content type %q", sniffedContentType)
+}
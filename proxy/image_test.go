@@ -0,0 +1,197 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+)
+
+var gifImage = []byte("GIF89a\x01\x00\x01\x00\x80\x00\x00\xff\xff\xff\x00\x00\x00!\xf9\x04\x00\x00\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02D\x01\x00;")
+
+func TestDetectContentType(t *testing.T) {
+	scenarios := []struct {
+		declared string
+		content  []byte
+		expected string
+	}{
+		{"image/png", gifImage, "image/gif"},
+		{"", gifImage, "image/gif"},
+		{"image/svg+xml; charset=utf-8", []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg"></svg>`), "image/svg+xml"},
+		{"image/avif", []byte("\x00\x00\x00\x1cftypavif"), "image/avif"},
+	}
+
+	for _, scenario := range scenarios {
+		contentType, err := detectContentType(scenario.declared, scenario.content)
+		if err != nil {
+			t.Errorf(`Unexpected error for %q: %v`, scenario.declared, err)
+		} else if contentType != scenario.expected {
+			t.Errorf(`Unexpected content type, got %q instead of %q`, contentType, scenario.expected)
+		}
+	}
+}
+
+func TestDetectContentTypeRejectsOtherDocuments(t *testing.T) {
+	scenarios := []struct {
+		declared string
+		content  []byte
+	}{
+		{"text/html", []byte("<html><body>Not found</body></html>")},
+		{"image/png", []byte("<html><body>Not found</body></html>")},
+		{"image/svg+xml", []byte("<html><script>alert(1)</script></html>")},
+		{"application/octet-stream", []byte("\x00\x01\x02")},
+	}
+
+	for _, scenario := range scenarios {
+		if _, err := detectContentType(scenario.declared, scenario.content); err == nil {
+			t.Errorf(`The content %q should be rejected`, scenario.content)
+		}
+	}
+}
+
+func TestFetch(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/image.gif":
+			w.Header().Set("Content-Type", "image/gif")
+			w.Write(gifImage)
+		case "/page.html":
+			w.Header().Set("Content-Type", "image/gif")
+			w.Write([]byte("<html><body>Not an image</body></html>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	image, err := Fetch(ts.URL + "/image.gif")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if image.ContentType != "image/gif" || len(image.Content) != len(gifImage) {
+		t.Errorf(`Unexpected image: %q (%d bytes)`, image.ContentType, len(image.Content))
+	}
+
+	if _, err := Fetch(ts.URL + "/page.html"); err == nil {
+		t.Error(`HTML documents should be rejected`)
+	}
+
+	if _, err := Fetch(ts.URL + "/missing.gif"); err == nil {
+		t.Error(`Missing images should return an error`)
+	}
+}
+
+func newImageServer(t *testing.T, largeImage []byte) *httptest.Server {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWLIST", "127.0.0.1")
+	os.Setenv("PROXY_CACHE_MAX_IMAGE_SIZE", "1")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/gif")
+		if r.URL.Path == "/large.gif" {
+			w.Write(largeImage)
+		} else {
+			w.Write(gifImage)
+		}
+	}))
+}
+
+func TestFetchRejectsLargeImages(t *testing.T) {
+	largeImage := append(append([]byte{}, gifImage...), make([]byte, 1024*1024)...)
+	ts := newImageServer(t, largeImage)
+	defer ts.Close()
+
+	if _, err := Fetch(ts.URL + "/large.gif"); err == nil {
+		t.Error(`Images larger than the maximum size should be rejected`)
+	}
+}
+
+func TestOpenStoresImagesInCache(t *testing.T) {
+	largeImage := append(append([]byte{}, gifImage...), make([]byte, 1024*1024)...)
+	ts := newImageServer(t, largeImage)
+	defer ts.Close()
+
+	cache, directory := newTestCache(t, 10*1024*1024)
+	defer os.RemoveAll(directory)
+
+	for _, scenario := range []struct {
+		path    string
+		content []byte
+		cached  bool
+	}{
+		{"/image.gif", gifImage, true},
+		{"/large.gif", largeImage, false},
+	} {
+		download, err := open(cache, ts.URL+scenario.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := ioutil.ReadAll(download)
+		download.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if download.ContentType != "image/gif" || !bytes.Equal(content, scenario.content) {
+			t.Errorf(`Unexpected image for %s: %q (%d bytes)`, scenario.path, download.ContentType, len(content))
+		}
+
+		if cached := cache.Contains(crypto.Hash(ts.URL + scenario.path)); cached != scenario.cached {
+			t.Errorf(`Unexpected cache state for %s, got %v instead of %v`, scenario.path, cached, scenario.cached)
+		}
+	}
+}
+
+func TestPrefetchImageURLs(t *testing.T) {
+	content := `<p><img src="http://example.org/a.png"><img src="https://example.org/b.png"><img src="data:image/png;base64,AAAA"></p>`
+
+	if imageURLs := prefetchImageURLs(content, "http-only"); len(imageURLs) != 1 || imageURLs[0] != "http://example.org/a.png" {
+		t.Errorf(`Only HTTP images should be prefetched, got %v`, imageURLs)
+	}
+
+	if imageURLs := prefetchImageURLs(content, "all"); len(imageURLs) != 2 {
+		t.Errorf(`All remote images should be prefetched, got %v`, imageURLs)
+	}
+
+	if imageURLs := prefetchImageURLs(content, "none"); len(imageURLs) != 0 {
+		t.Errorf(`No image should be prefetched, got %v`, imageURLs)
+	}
+}
+
+func TestEnqueueDoesNotBlock(t *testing.T) {
+	queue := make(chan string, 1)
+
+	if !enqueue(queue, "http://example.org/a.png") {
+		t.Error(`The image should be queued`)
+	}
+
+	if enqueue(queue, "http://example.org/b.png") {
+		t.Error(`The image should be skipped when the queue is full`)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"miniflux.app/config"
+)
+
+// storedPrivateKey is used when PROXY_PRIVATE_KEY is not defined.
+var storedPrivateKey []byte
+
+// UseStoredPrivateKey sets the key stored in the database, shared by all instances and kept across restarts.
+func UseStoredPrivateKey(key []byte) {
+	storedPrivateKey = key
+}
+
+func privateKey() []byte {
+	if key := config.Opts.ProxyPrivateKey(); len(key) > 0 {
+		return key
+	}
+
+	return storedPrivateKey
+}
+
+// Sign returns the base64 encoded HMAC signature of the image URL.
+// The signature is empty when no key is available, such URLs are never accepted.
+func Sign(imageURL string) string {
+	return base64.URLEncoding.EncodeToString(signature(imageURL))
+}
+
+// Verify returns true if the encoded signature matches the image URL.
+func Verify(imageURL, encodedSignature string) bool {
+	expectedSignature := signature(imageURL)
+	if expectedSignature == nil {
+		return false
+	}
+
+	decodedSignature, err := base64.URLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(decodedSignature, expectedSignature)
+}
+
+// signature returns nil without a key, an empty key would allow anyone to sign URLs.
+func signature(imageURL string) []byte {
+	key := privateKey()
+	if len(key) == 0 {
+		return nil
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(imageURL))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	imageURL := "http://website/folder/image.png"
+	signature := Sign(imageURL)
+
+	if !Verify(imageURL, signature) {
+		t.Error(`The signature should be valid`)
+	}
+
+	if Verify("http://website/folder/other.png", signature) {
+		t.Error(`The signature of another URL should be rejected`)
+	}
+
+	if Verify(imageURL, "") || Verify(imageURL, "not base64!") {
+		t.Error(`Invalid signatures should be rejected`)
+	}
+
+	os.Setenv("PROXY_PRIVATE_KEY", "another key")
+	config.Opts, _ = config.NewParser().ParseEnvironmentVariables()
+
+	if Verify(imageURL, signature) {
+		t.Error(`Signatures made with another key should be rejected`)
+	}
+}
+
+func TestSignWithStoredPrivateKey(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	defer UseStoredPrivateKey(nil)
+
+	imageURL := "http://website/folder/image.png"
+	UseStoredPrivateKey([]byte("stored key"))
+	signature := Sign(imageURL)
+
+	if !Verify(imageURL, signature) {
+		t.Error(`The signature should be valid with the stored key`)
+	}
+
+	UseStoredPrivateKey([]byte("another stored key"))
+	if Verify(imageURL, signature) {
+		t.Error(`The signature should depend on the stored key`)
+	}
+
+	os.Setenv("PROXY_PRIVATE_KEY", "stored key")
+	config.Opts, _ = config.NewParser().ParseEnvironmentVariables()
+	if !Verify(imageURL, signature) {
+		t.Error(`PROXY_PRIVATE_KEY should take precedence over the stored key`)
+	}
+}
+
+func TestSignWithoutPrivateKey(t *testing.T) {
+	os.Clearenv()
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	UseStoredPrivateKey(nil)
+
+	imageURL := "http://website/folder/image.png"
+	if signature := Sign(imageURL); signature != "" {
+		t.Errorf(`URLs should not be signed without a key, got %q`, signature)
+	}
+
+	mac := hmac.New(sha256.New, nil)
+	mac.Write([]byte(imageURL))
+	if Verify(imageURL, base64.URLEncoding.EncodeToString(mac.Sum(nil))) || Verify(imageURL, "") {
+		t.Error(`Signatures should be rejected without a key`)
+	}
+}
@@ -25,6 +25,7 @@ type atom03Feed struct {
 	Author  atomPerson    `xml:"author"`
 	Links   atomLinks     `xml:"link"`
 	Entries []atom03Entry `xml:"entry"`
+	Lang    string        `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
 }
 
 func (a *atom03Feed) Transform() *model.Feed {
@@ -32,6 +33,7 @@ func (a *atom03Feed) Transform() *model.Feed {
 	feed.FeedURL = a.Links.firstLinkWithRelation("self")
 	feed.SiteURL = a.Links.originalLink()
 	feed.Title = a.Title.String()
+	feed.Language = strings.TrimSpace(a.Lang)
 
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -30,6 +30,7 @@ type atom10Feed struct {
 	Author  atomPerson    `xml:"author"`
 	Links   atomLinks     `xml:"link"`
 	Entries []atom10Entry `xml:"entry"`
+	Lang    string        `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
 }
 
 func (a *atom10Feed) Transform() *model.Feed {
@@ -37,6 +38,7 @@ func (a *atom10Feed) Transform() *model.Feed {
 	feed.FeedURL = a.Links.firstLinkWithRelation("self")
 	feed.SiteURL = a.Links.originalLink()
 	feed.Title = a.Title.String()
+	feed.Language = strings.TrimSpace(a.Lang)
 
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -64,14 +66,15 @@ func (a *atom10Feed) Transform() *model.Feed {
 }
 
 type atom10Entry struct {
-	ID        string     `xml:"id"`
-	Title     atom10Text `xml:"title"`
-	Published string     `xml:"published"`
-	Updated   string     `xml:"updated"`
-	Links     atomLinks  `xml:"link"`
-	Summary   atom10Text `xml:"summary"`
-	Content   atom10Text `xml:"http://www.w3.org/2005/Atom content"`
-	Author    atomPerson `xml:"author"`
+	ID         string         `xml:"id"`
+	Title      atom10Text     `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Links      atomLinks      `xml:"link"`
+	Summary    atom10Text     `xml:"summary"`
+	Content    atom10Text     `xml:"http://www.w3.org/2005/Atom content"`
+	Author     atomPerson     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
 	media.Element
 }
 
@@ -85,6 +88,7 @@ func (a *atom10Entry) Transform() *model.Entry {
 	entry.Title = a.entryTitle()
 	entry.Enclosures = a.entryEnclosures()
 	entry.CommentsURL = a.entryCommentsURL()
+	entry.Tags = a.entryTags()
 	return entry
 }
 
@@ -209,6 +213,28 @@ func (a *atom10Entry) entryCommentsURL() string {
 	return ""
 }
 
+// See https://tools.ietf.org/html/rfc4287#section-4.2.2
+func (a *atom10Entry) entryTags() []string {
+	var tags []string
+	for _, category := range a.Categories {
+		tag := strings.TrimSpace(category.Term)
+		if tag == "" {
+			tag = strings.TrimSpace(category.Label)
+		}
+
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
 type atom10Text struct {
 	Type string `xml:"type,attr"`
 	Data string `xml:",chardata"`
@@ -100,6 +100,24 @@ func TestParseFeedWithoutTitle(t *testing.T) {
 	}
 }
 
+func TestParseFeedLanguage(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<feed xmlns="http://www.w3.org/2005/Atom" xml:lang="de-DE">
+			<title>Beispiel</title>
+			<link rel="alternate" type="text/html" href="https://example.org/"/>
+			<updated>2003-12-13T18:30:02Z</updated>
+		</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Language != "de-DE" {
+		t.Errorf("Incorrect feed language, got: %s", feed.Language)
+	}
+}
+
 func TestParseEntryWithoutTitle(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<feed xmlns="http://www.w3.org/2005/Atom">
@@ -937,3 +955,30 @@ func TestAbsoluteCommentsURL(t *testing.T) {
 		t.Errorf("Incorrect entry comments URL, got: %s", feed.Entries[0].CommentsURL)
 	}
 }
+
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+		<title>Example Feed</title>
+		<link href="http://example.org/"/>
+		<entry>
+			<title>Test</title>
+			<link href="http://example.org/test"/>
+			<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+			<updated>2003-12-13T18:30:02Z</updated>
+			<category term="go" label="Go programming"/>
+			<category label="Databases"/>
+			<category term=""/>
+		</entry>
+	</feed>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "go" || tags[1] != "Databases" {
+		t.Errorf(`Incorrect entry tags, got: %q`, tags)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package chapters // import "miniflux.app/reader/chapters"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// Specs: https://github.com/Podcastindex-org/podcast-namespace/blob/main/chapters/jsonChapters.md
+type jsonChapters struct {
+	Version  string        `json:"version"`
+	Chapters []jsonChapter `json:"chapters"`
+}
+
+type jsonChapter struct {
+	StartTime float64 `json:"startTime"`
+	Title     string  `json:"title"`
+	Image     string  `json:"img"`
+	URL       string  `json:"url"`
+	TOC       *bool   `json:"toc"`
+}
+
+// FetchMissingChapters downloads the chapters files of the enclosures that do not have chapters yet.
+// Chapters are downloaded in the background rather than when feeds are refreshed, the HTTP client timeout applies
+// to each download. A file that cannot be downloaded is recorded as empty and not downloaded again.
+func FetchMissingChapters(store *storage.Storage, batchSize int) {
+	enclosures, err := store.EnclosuresWithoutChapters(batchSize)
+	if err != nil {
+		logger.Error("[Chapters] %v", err)
+		return
+	}
+
+	for _, enclosure := range enclosures {
+		list, err := Fetch(enclosure.ChaptersURL)
+		if err != nil {
+			logger.Error("[Chapters] Unable to fetch chapters of enclosure #%d: %v", enclosure.ID, err)
+		}
+
+		if list == nil {
+			list = make(model.PodcastChapters, 0)
+		}
+
+		if err := store.UpdateEnclosureChapters(enclosure.ID, list); err != nil {
+			logger.Error("[Chapters] %v", err)
+		}
+	}
+}
+
+// Fetch downloads the chapters file of an episode.
+func Fetch(chaptersURL string) (model.PodcastChapters, error) {
+	response, err := client.New(chaptersURL).Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if response.HasServerFailure() || response.IsNotFound() {
+		return nil, fmt.Errorf("chapters: unable to download %q (status=%d)", chaptersURL, response.StatusCode)
+	}
+
+	return Parse(response.Body)
+}
+
+// Parse returns the chapters that should be visible to the user, sorted by start time.
+func Parse(data io.Reader) (model.PodcastChapters, error) {
+	var document jsonChapters
+	if err := json.NewDecoder(data).Decode(&document); err != nil {
+		return nil, fmt.Errorf("chapters: unable to parse JSON chapters: %v", err)
+	}
+
+	var chapters model.PodcastChapters
+	for _, chapter := range document.Chapters {
+		if chapter.TOC != nil && !*chapter.TOC {
+			continue
+		}
+
+		chapters = append(chapters, &model.PodcastChapter{
+			StartTime: chapter.StartTime,
+			Title:     strings.TrimSpace(chapter.Title),
+			URL:       strings.TrimSpace(chapter.URL),
+			Image:     strings.TrimSpace(chapter.Image),
+		})
+	}
+
+	sort.SliceStable(chapters, func(i, j int) bool {
+		return chapters[i].StartTime < chapters[j].StartTime
+	})
+
+	return chapters, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package chapters // import "miniflux.app/reader/chapters"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseChapters(t *testing.T) {
+	data := `{
+		"version": "1.2.0",
+		"chapters": [
+			{"startTime": 168, "title": "Hearing Aids", "img": "https://example.com/images/hearing_aids.jpg"},
+			{"startTime": 0, "title": "Intro"},
+			{"startTime": 90, "title": "Sponsor", "toc": false},
+			{"startTime": 259.5, "title": "Progress Report", "url": "https://example.com/progress", "toc": true}
+		]
+	}`
+
+	chapters, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chapters) != 3 {
+		t.Fatalf(`Hidden chapters should be ignored, got %d chapters`, len(chapters))
+	}
+
+	if chapters[0].Title != "Intro" || chapters[1].StartTime != 168 || chapters[2].StartTime != 259.5 {
+		t.Errorf(`Chapters should be sorted by start time: %+v %+v %+v`, chapters[0], chapters[1], chapters[2])
+	}
+
+	if chapters[1].Image != "https://example.com/images/hearing_aids.jpg" || chapters[2].URL != "https://example.com/progress" {
+		t.Errorf(`Incorrect chapter links: %+v %+v`, chapters[1], chapters[2])
+	}
+}
+
+func TestParseInvalidChapters(t *testing.T) {
+	if _, err := Parse(strings.NewReader(`<chapters/>`)); err == nil {
+		t.Error(`Invalid documents should return an error`)
+	}
+}
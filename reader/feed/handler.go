@@ -6,10 +6,12 @@ package feed // import "miniflux.app/reader/feed"
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
+	"miniflux.app/integration"
 	"miniflux.app/locale"
 	"miniflux.app/logger"
 	"miniflux.app/model"
@@ -17,6 +19,7 @@ import (
 	"miniflux.app/reader/icon"
 	"miniflux.app/reader/parser"
 	"miniflux.app/reader/processor"
+	"miniflux.app/reader/selector"
 	"miniflux.app/storage"
 	"miniflux.app/timer"
 )
@@ -75,6 +78,53 @@ func (h *Handler) CreateFeed(userID, categoryID int64, url string, crawler bool,
 	return subscription, nil
 }
 
+// CreateScrapedFeed fetch a web page, extract entries with CSS selectors and store a new feed.
+func (h *Handler) CreateScrapedFeed(userID, categoryID int64, url, userAgent string, rules *selector.Rules) (*model.Feed, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:CreateScrapedFeed] pageUrl=%s", url))
+
+	if !h.store.CategoryExists(userID, categoryID) {
+		return nil, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	subscription, parseErr := PreviewScrapedFeed(url, userAgent, rules)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	if h.store.FeedURLExists(userID, subscription.FeedURL) {
+		return nil, errors.NewLocalizedError(errDuplicate, subscription.FeedURL)
+	}
+
+	subscription.UserID = userID
+	subscription.WithCategoryID(categoryID)
+	subscription.WithBrowsingParameters(false, userAgent, "", "", "", "")
+	subscription.WithSelectors(rules.Item, rules.Title, rules.Link, rules.Date, rules.Content)
+	subscription.CheckedNow()
+
+	processor.ProcessFeedEntries(h.store, subscription)
+
+	if storeErr := h.store.CreateFeed(subscription); storeErr != nil {
+		return nil, storeErr
+	}
+
+	logger.Debug("[Handler:CreateScrapedFeed] Feed saved with ID: %d", subscription.ID)
+
+	checkFeedIcon(h.store, subscription.ID, subscription.SiteURL)
+	return subscription, nil
+}
+
+// PreviewScrapedFeed fetch a web page and returns the entries found with CSS selectors, without saving anything.
+func PreviewScrapedFeed(url, userAgent string, rules *selector.Rules) (*model.Feed, *errors.LocalizedError) {
+	request := client.New(url)
+	request.WithUserAgent(userAgent)
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	return selector.Parse(response.EffectiveURL, strings.NewReader(response.BodyAsString()), rules)
+}
+
 // RefreshFeed fetch and update a feed if necessary.
 func (h *Handler) RefreshFeed(userID, feedID int64) error {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[Handler:RefreshFeed] feedID=%d", feedID))
@@ -90,6 +140,11 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		return errors.NewLocalizedError(errNotFound, feedID)
 	}
 
+	// Newsletters are received by email, there is nothing to fetch.
+	if originalFeed.IsNewsletter() {
+		return nil
+	}
+
 	originalFeed.CheckedNow()
 
 	request := client.New(originalFeed.FeedURL)
@@ -103,10 +158,11 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		return requestErr
 	}
 
+	var newEntries model.Entries
 	if response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		logger.Debug("[Handler:RefreshFeed] Feed #%d has been modified", feedID)
 
-		updatedFeed, parseErr := parser.ParseFeed(response.BodyAsString())
+		updatedFeed, parseErr := parseFeed(originalFeed, response)
 		if parseErr != nil {
 			originalFeed.WithError(parseErr.Localize(printer))
 			h.store.UpdateFeedError(originalFeed)
@@ -114,10 +170,13 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		}
 
 		originalFeed.Entries = updatedFeed.Entries
+		originalFeed.Podcast = updatedFeed.Podcast
+		originalFeed.Language = updatedFeed.Language
 		processor.ProcessFeedEntries(h.store, originalFeed)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
-		if storeErr := h.store.UpdateEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler); storeErr != nil {
+		newEntries, storeErr = h.store.UpdateEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, !originalFeed.Crawler)
+		if storeErr != nil {
 			originalFeed.WithError(storeErr.Error())
 			h.store.UpdateFeedError(originalFeed)
 			return storeErr
@@ -139,6 +198,10 @@ func (h *Handler) RefreshFeed(userID, feedID int64) error {
 		return storeErr
 	}
 
+	if len(newEntries) > 0 {
+		pushEntries(h.store, originalFeed, newEntries)
+	}
+
 	return nil
 }
 
@@ -147,6 +210,28 @@ func NewFeedHandler(store *storage.Storage) *Handler {
 	return &Handler{store}
 }
 
+func parseFeed(feed *model.Feed, response *client.Response) (*model.Feed, *errors.LocalizedError) {
+	if feed.IsScraped() {
+		return selector.Parse(response.EffectiveURL, strings.NewReader(response.BodyAsString()), selector.FeedRules(feed))
+	}
+
+	return parser.ParseFeed(response.BodyAsString())
+}
+
+func pushEntries(store *storage.Storage, feed *model.Feed, entries model.Entries) {
+	settings, err := store.Integration(feed.UserID)
+	if err != nil {
+		logger.Error("[Handler:RefreshFeed] %v", err)
+		return
+	}
+
+	if err := integration.QueueNewEntries(store, feed, entries, settings); err != nil {
+		logger.Error("[Handler:RefreshFeed] %v", err)
+	}
+
+	go integration.PushEntries(feed, entries, settings)
+}
+
 func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL string) {
 	if !store.HasIcon(feedID) {
 		icon, err := icon.FindIcon(websiteURL)
@@ -17,12 +17,13 @@ import (
 )
 
 type jsonFeed struct {
-	Version string     `json:"version"`
-	Title   string     `json:"title"`
-	SiteURL string     `json:"home_page_url"`
-	FeedURL string     `json:"feed_url"`
-	Author  jsonAuthor `json:"author"`
-	Items   []jsonItem `json:"items"`
+	Version  string     `json:"version"`
+	Title    string     `json:"title"`
+	SiteURL  string     `json:"home_page_url"`
+	FeedURL  string     `json:"feed_url"`
+	Author   jsonAuthor `json:"author"`
+	Language string     `json:"language"`
+	Items    []jsonItem `json:"items"`
 }
 
 type jsonAuthor struct {
@@ -41,6 +42,7 @@ type jsonItem struct {
 	DateModified  string           `json:"date_modified"`
 	Author        jsonAuthor       `json:"author"`
 	Attachments   []jsonAttachment `json:"attachments"`
+	Tags          []string         `json:"tags"`
 }
 
 type jsonAttachment struct {
@@ -60,6 +62,7 @@ func (j *jsonFeed) Transform() *model.Feed {
 	feed.FeedURL = j.FeedURL
 	feed.SiteURL = j.SiteURL
 	feed.Title = strings.TrimSpace(j.Title)
+	feed.Language = strings.TrimSpace(j.Language)
 
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -150,6 +153,18 @@ func (j *jsonItem) GetEnclosures() model.EnclosureList {
 	return enclosures
 }
 
+func (j *jsonItem) GetTags() []string {
+	var tags []string
+	for _, tag := range j.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
 func (j *jsonItem) Transform() *model.Entry {
 	entry := new(model.Entry)
 	entry.URL = j.URL
@@ -159,6 +174,7 @@ func (j *jsonItem) Transform() *model.Entry {
 	entry.Content = j.GetContent()
 	entry.Title = strings.TrimSpace(j.GetTitle())
 	entry.Enclosures = j.GetEnclosures()
+	entry.Tags = j.GetTags()
 	return entry
 }
 
@@ -416,3 +416,30 @@ func TestParseInvalidJSON(t *testing.T) {
 		t.Error("Parse should returns an error")
 	}
 }
+
+func TestParseItemWithTags(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/initial-post",
+				"content_text": "Hello, world!",
+				"tags": ["go", " ", "databases "]
+			}
+		]
+	}`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "go" || tags[1] != "databases" {
+		t.Errorf(`Incorrect entry tags, got: %q`, tags)
+	}
+}
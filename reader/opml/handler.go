@@ -5,7 +5,6 @@
 package opml // import "miniflux.app/reader/opml"
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
@@ -28,69 +27,68 @@ func (h *Handler) Export(userID int64) (string, error) {
 
 	var subscriptions SubcriptionList
 	for _, feed := range feeds {
-		subscriptions = append(subscriptions, &Subcription{
-			Title:        feed.Title,
-			FeedURL:      feed.FeedURL,
-			SiteURL:      feed.SiteURL,
-			CategoryName: feed.Category.Title,
-		})
+		subscriptions = append(subscriptions, NewSubscription(feed))
 	}
 
 	return Serialize(subscriptions), nil
 }
 
 // Import parses and create feeds from an OPML import.
-func (h *Handler) Import(userID int64, data io.Reader) error {
+func (h *Handler) Import(userID int64, data io.Reader) (*ImportReport, error) {
 	subscriptions, err := Parse(data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	report := &ImportReport{Feeds: make([]*ImportResult, 0)}
 	for _, subscription := range subscriptions {
-		if !h.store.FeedURLExists(userID, subscription.FeedURL) {
-			var category *model.Category
-			var err error
-
-			if subscription.CategoryName == "" {
-				category, err = h.store.FirstCategory(userID)
-				if err != nil {
-					logger.Error("[OPML:Import] %v", err)
-					return errors.New("unable to find first category")
-				}
-			} else {
-				category, err = h.store.CategoryByTitle(userID, subscription.CategoryName)
-				if err != nil {
-					logger.Error("[OPML:Import] %v", err)
-					return errors.New("unable to search category by title")
-				}
-
-				if category == nil {
-					category = &model.Category{
-						UserID: userID,
-						Title:  subscription.CategoryName,
-					}
-
-					err := h.store.CreateCategory(category)
-					if err != nil {
-						logger.Error("[OPML:Import] %v", err)
-						return fmt.Errorf(`unable to create this category: %q`, subscription.CategoryName)
-					}
-				}
-			}
-
-			feed := &model.Feed{
-				UserID:   userID,
-				Title:    subscription.Title,
-				FeedURL:  subscription.FeedURL,
-				SiteURL:  subscription.SiteURL,
-				Category: category,
-			}
-
-			h.store.CreateFeed(feed)
+		if h.store.FeedURLExists(userID, subscription.FeedURL) {
+			report.add(subscription, ImportStatusDuplicate, "")
+			continue
+		}
+
+		category, err := h.findOrCreateCategory(userID, subscription.CategoryName)
+		if err != nil {
+			logger.Error("[OPML:Import] %v", err)
+			report.add(subscription, ImportStatusFailed, fmt.Sprintf(`unable to create this category: %q`, subscription.CategoryName))
+			continue
+		}
+
+		feed := subscription.Feed(userID, category)
+		if err := h.store.CreateFeed(feed); err != nil {
+			logger.Error("[OPML:Import] %v", err)
+			report.add(subscription, ImportStatusFailed, "unable to create this feed")
+			continue
+		}
+
+		report.add(subscription, ImportStatusCreated, "").FeedID = feed.ID
+
+		if !feed.Disabled && !feed.IsNewsletter() {
+			report.jobs = append(report.jobs, model.Job{UserID: userID, FeedID: feed.ID})
+		}
+	}
+
+	return report, nil
+}
+
+func (h *Handler) findOrCreateCategory(userID int64, title string) (*model.Category, error) {
+	if title == "" {
+		return h.store.FirstCategory(userID)
+	}
+
+	category, err := h.store.CategoryByTitle(userID, title)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		category = &model.Category{UserID: userID, Title: title}
+		if err := h.store.CreateCategory(category); err != nil {
+			return nil, err
 		}
 	}
 
-	return nil
+	return category, nil
 }
 
 // NewHandler creates a new handler for OPML files.
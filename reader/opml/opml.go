@@ -6,12 +6,24 @@ package opml // import "miniflux.app/reader/opml"
 
 import (
 	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"miniflux.app/model"
+	"miniflux.app/search"
 )
 
+// Namespace is used for the attributes specific to Miniflux.
+const Namespace = "https://miniflux.app/opml"
+
+// categorySeparator joins the titles of nested outlines to build category names.
+const categorySeparator = " / "
+
 type opml struct {
-	XMLName  xml.Name  `xml:"opml"`
-	Version  string    `xml:"version,attr"`
-	Outlines []outline `xml:"body>outline"`
+	XMLName   xml.Name  `xml:"opml"`
+	Version   string    `xml:"version,attr"`
+	Namespace string    `xml:"xmlns:miniflux,attr,omitempty"`
+	Outlines  []outline `xml:"body>outline"`
 }
 
 type outline struct {
@@ -20,6 +32,50 @@ type outline struct {
 	FeedURL  string    `xml:"xmlUrl,attr,omitempty"`
 	SiteURL  string    `xml:"htmlUrl,attr,omitempty"`
 	Outlines []outline `xml:"outline,omitempty"`
+
+	ScraperRules    string `xml:"https://miniflux.app/opml scraperRules,attr"`
+	RewriteRules    string `xml:"https://miniflux.app/opml rewriteRules,attr"`
+	Crawler         string `xml:"https://miniflux.app/opml crawler,attr"`
+	UserAgent       string `xml:"https://miniflux.app/opml userAgent,attr"`
+	Username        string `xml:"https://miniflux.app/opml username,attr"`
+	Password        string `xml:"https://miniflux.app/opml password,attr"`
+	Disabled        string `xml:"https://miniflux.app/opml disabled,attr"`
+	Notify          string `xml:"https://miniflux.app/opml notify,attr"`
+	ItemSelector    string `xml:"https://miniflux.app/opml itemSelector,attr"`
+	TitleSelector   string `xml:"https://miniflux.app/opml titleSelector,attr"`
+	LinkSelector    string `xml:"https://miniflux.app/opml linkSelector,attr"`
+	DateSelector    string `xml:"https://miniflux.app/opml dateSelector,attr"`
+	ContentSelector string `xml:"https://miniflux.app/opml contentSelector,attr"`
+	SearchLanguage  string `xml:"https://miniflux.app/opml searchLanguage,attr"`
+	ArchiveReadDays string `xml:"https://miniflux.app/opml archiveReadDays,attr"`
+	MaxEntries      string `xml:"https://miniflux.app/opml maxEntries,attr"`
+	MarkAsReadDays  string `xml:"https://miniflux.app/opml markAsReadDays,attr"`
+}
+
+func newOutline(subscription *Subcription) outline {
+	return outline{
+		Title:           subscription.Title,
+		Text:            subscription.Title,
+		FeedURL:         subscription.FeedURL,
+		SiteURL:         subscription.SiteURL,
+		ScraperRules:    subscription.ScraperRules,
+		RewriteRules:    subscription.RewriteRules,
+		Crawler:         formatBool(subscription.Crawler),
+		UserAgent:       subscription.UserAgent,
+		Username:        subscription.Username,
+		Password:        subscription.Password,
+		Disabled:        formatBool(subscription.Disabled),
+		Notify:          formatBool(subscription.Notify),
+		ItemSelector:    subscription.ItemSelector,
+		TitleSelector:   subscription.TitleSelector,
+		LinkSelector:    subscription.LinkSelector,
+		DateSelector:    subscription.DateSelector,
+		ContentSelector: subscription.ContentSelector,
+		SearchLanguage:  subscription.SearchLanguage,
+		ArchiveReadDays: formatInt(subscription.ArchiveReadDays),
+		MaxEntries:      formatInt(subscription.MaxEntries),
+		MarkAsReadDays:  formatInt(subscription.MarkAsReadDays),
+	}
 }
 
 func (o *outline) GetTitle() string {
@@ -50,31 +106,151 @@ func (o *outline) GetSiteURL() string {
 	return o.FeedURL
 }
 
+// GetCategoryName returns the name of the category when the outline is a folder.
+func (o *outline) GetCategoryName() string {
+	// outline.Text is only available in OPML v2.
+	return strings.TrimSpace(o.Text)
+}
+
 func (o *outline) Append(subscriptions SubcriptionList, category string) SubcriptionList {
 	if o.FeedURL != "" {
-		subscriptions = append(subscriptions, &Subcription{
-			Title:        o.GetTitle(),
-			FeedURL:      o.FeedURL,
-			SiteURL:      o.GetSiteURL(),
-			CategoryName: category,
-		})
+		subscription := &Subcription{
+			Title:           o.GetTitle(),
+			FeedURL:         o.FeedURL,
+			SiteURL:         o.GetSiteURL(),
+			CategoryName:    category,
+			ScraperRules:    o.ScraperRules,
+			RewriteRules:    o.RewriteRules,
+			Crawler:         parseBool(o.Crawler),
+			UserAgent:       o.UserAgent,
+			Username:        o.Username,
+			Password:        o.Password,
+			Disabled:        parseBool(o.Disabled),
+			Notify:          parseBool(o.Notify),
+			ItemSelector:    o.ItemSelector,
+			TitleSelector:   o.TitleSelector,
+			LinkSelector:    o.LinkSelector,
+			DateSelector:    o.DateSelector,
+			ContentSelector: o.ContentSelector,
+			SearchLanguage:  parseSearchLanguage(o.SearchLanguage),
+		}
+
+		// Invalid retention settings are ignored, the feed inherits the settings of its category.
+		archiveReadDays, maxEntries, markAsReadDays := parseInt(o.ArchiveReadDays), parseInt(o.MaxEntries), parseInt(o.MarkAsReadDays)
+		if model.ValidateRetentionPolicy(archiveReadDays, maxEntries, markAsReadDays) == nil {
+			subscription.ArchiveReadDays = archiveReadDays
+			subscription.MaxEntries = maxEntries
+			subscription.MarkAsReadDays = markAsReadDays
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if len(o.Outlines) > 0 {
+		// Nested folders are flattened because categories cannot be nested.
+		if name := o.GetCategoryName(); o.FeedURL == "" && name != "" {
+			if category == "" {
+				category = name
+			} else {
+				category = category + categorySeparator + name
+			}
+		}
+
+		for _, element := range o.Outlines {
+			subscriptions = element.Append(subscriptions, category)
+		}
 	}
 
 	return subscriptions
 }
 
+// MarshalXML writes the attributes specific to Miniflux with the namespace prefix declared on the root element.
+func (o outline) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "outline"}
+	start.Attr = nil
+
+	addAttr := func(name, value string) {
+		if value != "" {
+			start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+		}
+	}
+
+	addAttr("title", o.Title)
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "text"}, Value: o.Text})
+	addAttr("xmlUrl", o.FeedURL)
+	addAttr("htmlUrl", o.SiteURL)
+	addAttr("miniflux:scraperRules", o.ScraperRules)
+	addAttr("miniflux:rewriteRules", o.RewriteRules)
+	addAttr("miniflux:crawler", o.Crawler)
+	addAttr("miniflux:userAgent", o.UserAgent)
+	addAttr("miniflux:username", o.Username)
+	addAttr("miniflux:password", o.Password)
+	addAttr("miniflux:disabled", o.Disabled)
+	addAttr("miniflux:notify", o.Notify)
+	addAttr("miniflux:itemSelector", o.ItemSelector)
+	addAttr("miniflux:titleSelector", o.TitleSelector)
+	addAttr("miniflux:linkSelector", o.LinkSelector)
+	addAttr("miniflux:dateSelector", o.DateSelector)
+	addAttr("miniflux:contentSelector", o.ContentSelector)
+	addAttr("miniflux:searchLanguage", o.SearchLanguage)
+	addAttr("miniflux:archiveReadDays", o.ArchiveReadDays)
+	addAttr("miniflux:maxEntries", o.MaxEntries)
+	addAttr("miniflux:markAsReadDays", o.MarkAsReadDays)
+
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	for _, child := range o.Outlines {
+		if err := e.Encode(child); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
 func (o *opml) Transform() SubcriptionList {
 	var subscriptions SubcriptionList
 	for _, outline := range o.Outlines {
-		if len(outline.Outlines) > 0 {
-			for _, element := range outline.Outlines {
-				// outline.Text is only available in OPML v2.
-				subscriptions = element.Append(subscriptions, outline.Text)
-			}
-		} else {
-			subscriptions = outline.Append(subscriptions, "")
-		}
+		subscriptions = outline.Append(subscriptions, "")
 	}
 
 	return subscriptions
 }
+
+func formatBool(value bool) string {
+	if value {
+		return "true"
+	}
+
+	return ""
+}
+
+func parseBool(value string) bool {
+	result, _ := strconv.ParseBool(strings.TrimSpace(value))
+	return result
+}
+
+func formatInt(value int) string {
+	if value != 0 {
+		return strconv.Itoa(value)
+	}
+
+	return ""
+}
+
+func parseInt(value string) int {
+	result, _ := strconv.Atoi(strings.TrimSpace(value))
+	return result
+}
+
+// parseSearchLanguage ignores the text search configurations not supported by this instance.
+func parseSearchLanguage(value string) string {
+	value = strings.TrimSpace(value)
+	if !search.IsValidConfiguration(value) {
+		return ""
+	}
+
+	return value
+}
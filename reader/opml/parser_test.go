@@ -234,3 +234,75 @@ func TestParseInvalidXML(t *testing.T) {
 		t.Error("Parse should generate an error")
 	}
 }
+
+func TestParseOpmlWithNestedCategories(t *testing.T) {
+	data := `<?xml version="1.0"?>
+	<opml version="2.0">
+		<body>
+			<outline text="Tech">
+				<outline text="Feed 1" xmlUrl="http://example.org/feed1/" htmlUrl="http://example.org/1"></outline>
+				<outline text="Go">
+					<outline text="Feed 2" xmlUrl="http://example.org/feed2/" htmlUrl="http://example.org/2"></outline>
+				</outline>
+			</outline>
+		</body>
+	</opml>
+	`
+
+	var expected SubcriptionList
+	expected = append(expected, &Subcription{Title: "Feed 1", FeedURL: "http://example.org/feed1/", SiteURL: "http://example.org/1", CategoryName: "Tech"})
+	expected = append(expected, &Subcription{Title: "Feed 2", FeedURL: "http://example.org/feed2/", SiteURL: "http://example.org/2", CategoryName: "Tech / Go"})
+
+	subscriptions, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(subscriptions), 2)
+	}
+
+	for i := 0; i < len(subscriptions); i++ {
+		if !subscriptions[i].Equals(expected[i]) {
+			t.Errorf(`Subscription are different: "%v" vs "%v"`, subscriptions[i], expected[i])
+		}
+	}
+}
+
+func TestParseOpmlWithMinifluxAttributes(t *testing.T) {
+	data := `<?xml version="1.0"?>
+	<opml version="2.0" xmlns:mf="https://miniflux.app/opml">
+		<body>
+			<outline text="Feed 1" xmlUrl="http://example.org/feed1/" mf:crawler="true" mf:disabled="1" mf:notify="invalid" mf:userAgent="Agent" mf:searchLanguage="klingon" mf:maxEntries="100" mf:markAsReadDays="-2"></outline>
+			<outline text="Feed 2" xmlUrl="http://example.org/feed2/" mf:archiveReadDays="30" mf:maxEntries="-1" mf:markAsReadDays="x"></outline>
+		</body>
+	</opml>
+	`
+
+	subscriptions, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 2 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(subscriptions), 2)
+	}
+
+	subscription := subscriptions[0]
+	if !subscription.Crawler || !subscription.Disabled || subscription.Notify || subscription.UserAgent != "Agent" {
+		t.Errorf(`Unexpected settings: %+v`, subscription)
+	}
+
+	if subscription.SearchLanguage != "" {
+		t.Errorf(`Unsupported search languages should be ignored, got %q`, subscription.SearchLanguage)
+	}
+
+	if subscription.MaxEntries != 0 || subscription.MarkAsReadDays != 0 {
+		t.Errorf(`Invalid retention settings should be ignored, got %+v`, subscription)
+	}
+
+	subscription = subscriptions[1]
+	if subscription.ArchiveReadDays != 30 || subscription.MaxEntries != -1 || subscription.MarkAsReadDays != 0 {
+		t.Errorf(`Unexpected retention settings: %+v`, subscription)
+	}
+}
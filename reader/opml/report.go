@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package opml // import "miniflux.app/reader/opml"
+
+import "miniflux.app/model"
+
+// Import statuses.
+const (
+	ImportStatusCreated   = "created"
+	ImportStatusDuplicate = "duplicate"
+	ImportStatusFailed    = "failed"
+)
+
+// ImportResult represents the outcome of the import of one subscription.
+type ImportResult struct {
+	Title        string `json:"title"`
+	FeedURL      string `json:"feed_url"`
+	CategoryName string `json:"category"`
+	Status       string `json:"status"`
+	Reason       string `json:"reason,omitempty"`
+	FeedID       int64  `json:"feed_id,omitempty"`
+}
+
+// ImportReport contains the outcome of an OPML import.
+type ImportReport struct {
+	Created int             `json:"created"`
+	Skipped int             `json:"skipped"`
+	Failed  int             `json:"failed"`
+	Feeds   []*ImportResult `json:"feeds"`
+
+	jobs model.JobList
+}
+
+// Jobs returns the refresh jobs of the imported feeds.
+func (r *ImportReport) Jobs() model.JobList {
+	return r.jobs
+}
+
+func (r *ImportReport) add(subscription *Subcription, status, reason string) *ImportResult {
+	result := &ImportResult{
+		Title:        subscription.Title,
+		FeedURL:      subscription.FeedURL,
+		CategoryName: subscription.CategoryName,
+		Status:       status,
+		Reason:       reason,
+	}
+
+	switch status {
+	case ImportStatusCreated:
+		r.Created++
+	case ImportStatusDuplicate:
+		r.Skipped++
+	case ImportStatusFailed:
+		r.Failed++
+	}
+
+	r.Feeds = append(r.Feeds, result)
+	return result
+}
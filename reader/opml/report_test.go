@@ -0,0 +1,22 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package opml // import "miniflux.app/reader/opml"
+
+import "testing"
+
+func TestImportReport(t *testing.T) {
+	report := &ImportReport{}
+	report.add(&Subcription{Title: "Feed 1"}, ImportStatusCreated, "").FeedID = 42
+	report.add(&Subcription{Title: "Feed 2"}, ImportStatusDuplicate, "")
+	report.add(&Subcription{Title: "Feed 3"}, ImportStatusFailed, "unable to create this feed")
+
+	if report.Created != 1 || report.Skipped != 1 || report.Failed != 1 {
+		t.Errorf(`Unexpected counters: %+v`, report)
+	}
+
+	if len(report.Feeds) != 3 || report.Feeds[0].FeedID != 42 || report.Feeds[2].Reason != "unable to create this feed" {
+		t.Errorf(`Unexpected results: %+v`, report.Feeds)
+	}
+}
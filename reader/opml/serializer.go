@@ -43,6 +43,7 @@ func groupSubscriptionsByFeed(subscriptions SubcriptionList) map[string]Subcript
 func normalizeFeeds(subscriptions SubcriptionList) *opml {
 	feeds := new(opml)
 	feeds.Version = "2.0"
+	feeds.Namespace = Namespace
 
 	groupedSubs := groupSubscriptionsByFeed(subscriptions)
 	var categories []string
@@ -54,12 +55,7 @@ func normalizeFeeds(subscriptions SubcriptionList) *opml {
 	for _, categoryName := range categories {
 		category := outline{Text: categoryName}
 		for _, subscription := range groupedSubs[categoryName] {
-			category.Outlines = append(category.Outlines, outline{
-				Title:   subscription.Title,
-				Text:    subscription.Title,
-				FeedURL: subscription.FeedURL,
-				SiteURL: subscription.SiteURL,
-			})
+			category.Outlines = append(category.Outlines, newOutline(subscription))
 		}
 
 		feeds.Outlines = append(feeds.Outlines, category)
@@ -7,6 +7,7 @@ package opml // import "miniflux.app/reader/opml"
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -64,3 +65,47 @@ func TestNormalizedCategoriesOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestSerializeFeedSettings(t *testing.T) {
+	subscription := &Subcription{
+		Title:           "Feed 1",
+		FeedURL:         "http://example.org/feed/1",
+		SiteURL:         "http://example.org/1",
+		CategoryName:    "Category 1",
+		ScraperRules:    "article",
+		RewriteRules:    "add_image_title",
+		Crawler:         true,
+		UserAgent:       "Custom Agent",
+		Username:        "user",
+		Password:        "p&ss\"word",
+		Disabled:        true,
+		Notify:          true,
+		ItemSelector:    "li.post",
+		TitleSelector:   "h2",
+		LinkSelector:    "a.permalink",
+		DateSelector:    "time",
+		ContentSelector: ".summary",
+		SearchLanguage:  "french",
+		ArchiveReadDays: 30,
+		MaxEntries:      -1,
+		MarkAsReadDays:  7,
+	}
+
+	output := Serialize(SubcriptionList{subscription})
+	if !strings.Contains(output, `xmlns:miniflux="https://miniflux.app/opml"`) {
+		t.Errorf(`The namespace should be declared: %s`, output)
+	}
+
+	feeds, err := Parse(bytes.NewBufferString(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 1 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(feeds), 1)
+	}
+
+	if !feeds[0].Equals(subscription) {
+		t.Errorf(`Subscription are different: "%v" vs "%v"`, feeds[0], subscription)
+	}
+}
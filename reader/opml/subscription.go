@@ -4,18 +4,89 @@
 
 package opml // import "miniflux.app/reader/opml"
 
+import "miniflux.app/model"
+
 // Subcription represents a feed that will be imported or exported.
 type Subcription struct {
-	Title        string
-	SiteURL      string
-	FeedURL      string
-	CategoryName string
+	Title           string
+	SiteURL         string
+	FeedURL         string
+	CategoryName    string
+	ScraperRules    string
+	RewriteRules    string
+	Crawler         bool
+	UserAgent       string
+	Username        string
+	Password        string
+	Disabled        bool
+	Notify          bool
+	ItemSelector    string
+	TitleSelector   string
+	LinkSelector    string
+	DateSelector    string
+	ContentSelector string
+	SearchLanguage  string
+	ArchiveReadDays int
+	MaxEntries      int
+	MarkAsReadDays  int
 }
 
 // Equals compare two subscriptions.
 func (s Subcription) Equals(subscription *Subcription) bool {
-	return s.Title == subscription.Title && s.SiteURL == subscription.SiteURL &&
-		s.FeedURL == subscription.FeedURL && s.CategoryName == subscription.CategoryName
+	return s == *subscription
+}
+
+// Feed returns a new feed with the settings of the subscription.
+func (s *Subcription) Feed(userID int64, category *model.Category) *model.Feed {
+	feed := &model.Feed{
+		UserID:          userID,
+		Title:           s.Title,
+		FeedURL:         s.FeedURL,
+		SiteURL:         s.SiteURL,
+		ScraperRules:    s.ScraperRules,
+		RewriteRules:    s.RewriteRules,
+		Crawler:         s.Crawler,
+		UserAgent:       s.UserAgent,
+		Username:        s.Username,
+		Password:        s.Password,
+		Disabled:        s.Disabled,
+		Notify:          s.Notify,
+		SearchLanguage:  s.SearchLanguage,
+		ArchiveReadDays: s.ArchiveReadDays,
+		MaxEntries:      s.MaxEntries,
+		MarkAsReadDays:  s.MarkAsReadDays,
+		Category:        category,
+	}
+
+	feed.WithSelectors(s.ItemSelector, s.TitleSelector, s.LinkSelector, s.DateSelector, s.ContentSelector)
+	return feed
+}
+
+// NewSubscription returns a subscription with all the settings of the feed.
+func NewSubscription(feed *model.Feed) *Subcription {
+	return &Subcription{
+		Title:           feed.Title,
+		FeedURL:         feed.FeedURL,
+		SiteURL:         feed.SiteURL,
+		CategoryName:    feed.Category.Title,
+		ScraperRules:    feed.ScraperRules,
+		RewriteRules:    feed.RewriteRules,
+		Crawler:         feed.Crawler,
+		UserAgent:       feed.UserAgent,
+		Username:        feed.Username,
+		Password:        feed.Password,
+		Disabled:        feed.Disabled,
+		Notify:          feed.Notify,
+		ItemSelector:    feed.ItemSelector,
+		TitleSelector:   feed.TitleSelector,
+		LinkSelector:    feed.LinkSelector,
+		DateSelector:    feed.DateSelector,
+		ContentSelector: feed.ContentSelector,
+		SearchLanguage:  feed.SearchLanguage,
+		ArchiveReadDays: feed.ArchiveReadDays,
+		MaxEntries:      feed.MaxEntries,
+		MarkAsReadDays:  feed.MarkAsReadDays,
+	}
 }
 
 // SubcriptionList is a list of subscriptions.
@@ -0,0 +1,162 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package opml // import "miniflux.app/reader/opml"
+
+import (
+	"fmt"
+
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// Sync fetches a remote OPML file and applies the differences to the feeds of the user.
+//
+// Feeds listed in the file are created when missing and moved to the category of the file.
+// When RemoveMissing is enabled, feeds previously created by this subscription and no longer
+// listed in the file are removed. The returned jobs refresh the feeds created.
+func (h *Handler) Sync(sub *model.OPMLSubscription) (model.JobList, error) {
+	jobs, changes, err := h.sync(sub)
+	if err != nil {
+		sub.ErrorMsg = err.Error()
+	} else {
+		sub.ErrorMsg = ""
+	}
+
+	if storeErr := h.store.UpdateOPMLSubscriptionStatus(sub); storeErr != nil {
+		return nil, storeErr
+	}
+
+	if storeErr := h.store.CreateOPMLSubscriptionChanges(changes); storeErr != nil {
+		return nil, storeErr
+	}
+
+	return jobs, err
+}
+
+func (h *Handler) sync(sub *model.OPMLSubscription) (model.JobList, model.OPMLSubscriptionChanges, error) {
+	var jobs model.JobList
+	changes := make(model.OPMLSubscriptionChanges, 0)
+
+	clt := client.New(sub.URL)
+	response, err := clt.Get()
+	if err != nil {
+		return nil, changes, err
+	}
+
+	if response.HasServerFailure() {
+		return nil, changes, fmt.Errorf("unable to fetch the OPML file, status code: %d", response.StatusCode)
+	}
+
+	subscriptions, parseErr := Parse(response.Body)
+	if parseErr != nil {
+		return nil, changes, parseErr
+	}
+
+	feeds, err := h.store.Feeds(sub.UserID)
+	if err != nil {
+		return nil, changes, err
+	}
+
+	feedsByURL := make(map[string]*model.Feed, len(feeds))
+	for _, feed := range feeds {
+		feedsByURL[feed.FeedURL] = feed
+	}
+
+	record := func(action string, subscription *Subcription, details string) {
+		changes = append(changes, &model.OPMLSubscriptionChange{
+			SubscriptionID: sub.ID,
+			Action:         action,
+			FeedURL:        subscription.FeedURL,
+			Title:          subscription.Title,
+			Category:       subscription.CategoryName,
+			Details:        details,
+		})
+	}
+
+	listed := make(map[string]bool, len(subscriptions))
+	for _, subscription := range subscriptions {
+		listed[subscription.FeedURL] = true
+
+		feed, exists := feedsByURL[subscription.FeedURL]
+		if exists {
+			if subscription.CategoryName == "" || feed.Category == nil || feed.Category.Title == subscription.CategoryName {
+				continue
+			}
+
+			category, err := h.findOrCreateCategory(sub.UserID, subscription.CategoryName)
+			if err != nil {
+				logger.Error("[OPML:Sync] %v", err)
+				record(model.OPMLSubscriptionChangeFailed, subscription, "unable to create the category")
+				continue
+			}
+
+			if err := h.store.UpdateFeedCategory(sub.UserID, feed.ID, category.ID); err != nil {
+				logger.Error("[OPML:Sync] %v", err)
+				record(model.OPMLSubscriptionChangeFailed, subscription, "unable to move the feed")
+				continue
+			}
+
+			record(model.OPMLSubscriptionChangeMoved, subscription, feed.Category.Title)
+			feed.Category = category
+			continue
+		}
+
+		category, err := h.findOrCreateCategory(sub.UserID, subscription.CategoryName)
+		if err != nil {
+			logger.Error("[OPML:Sync] %v", err)
+			record(model.OPMLSubscriptionChangeFailed, subscription, "unable to create the category")
+			continue
+		}
+
+		feed = subscription.Feed(sub.UserID, category)
+		if err := h.store.CreateFeed(feed); err != nil {
+			logger.Error("[OPML:Sync] %v", err)
+			record(model.OPMLSubscriptionChangeFailed, subscription, "unable to create the feed")
+			continue
+		}
+
+		if err := h.store.SetFeedOPMLSubscription(sub.UserID, feed.ID, sub.ID); err != nil {
+			logger.Error("[OPML:Sync] %v", err)
+		}
+
+		record(model.OPMLSubscriptionChangeAdded, subscription, "")
+		feedsByURL[feed.FeedURL] = feed
+
+		if !feed.Disabled && !feed.IsNewsletter() {
+			jobs = append(jobs, model.Job{UserID: sub.UserID, FeedID: feed.ID})
+		}
+	}
+
+	if !sub.RemoveMissing {
+		return jobs, changes, nil
+	}
+
+	managedFeeds, err := h.store.OPMLSubscriptionFeeds(sub.ID)
+	if err != nil {
+		return jobs, changes, err
+	}
+
+	for feedURL, feedID := range managedFeeds {
+		if listed[feedURL] {
+			continue
+		}
+
+		subscription := &Subcription{FeedURL: feedURL}
+		if feed, exists := feedsByURL[feedURL]; exists {
+			subscription = NewSubscription(feed)
+		}
+
+		if err := h.store.RemoveFeed(sub.UserID, feedID); err != nil {
+			logger.Error("[OPML:Sync] %v", err)
+			record(model.OPMLSubscriptionChangeFailed, subscription, "unable to remove the feed")
+			continue
+		}
+
+		record(model.OPMLSubscriptionChangeRemoved, subscription, "")
+	}
+
+	return jobs, changes, nil
+}
@@ -5,8 +5,10 @@
 package processor
 
 import (
+	"miniflux.app/config"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/proxy"
 	"miniflux.app/reader/rewrite"
 	"miniflux.app/reader/sanitizer"
 	"miniflux.app/reader/scraper"
@@ -32,6 +34,10 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
 
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered.
 		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+
+		if config.Opts.ProxyPrefetchImages() && !store.EntryURLExists(feed.ID, entry.URL) {
+			proxy.Prefetch(entry.Content)
+		}
 	}
 }
 
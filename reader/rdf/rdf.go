@@ -29,6 +29,7 @@ func (r *rdfFeed) Transform() *model.Feed {
 	feed := new(model.Feed)
 	feed.Title = sanitizer.StripTags(r.Title)
 	feed.SiteURL = r.Link
+	feed.Language = strings.TrimSpace(r.DublinCoreLanguage)
 
 	for _, item := range r.Items {
 		entry := item.Transform()
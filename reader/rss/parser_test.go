@@ -71,6 +71,10 @@ func TestParseRss2Sample(t *testing.T) {
 		t.Errorf("Incorrect site URL, got: %s", feed.SiteURL)
 	}
 
+	if feed.Language != "en-us" {
+		t.Errorf("Incorrect language, got: %s", feed.Language)
+	}
+
 	if len(feed.Entries) != 4 {
 		t.Errorf("Incorrect number of entries, got: %d", len(feed.Entries))
 	}
@@ -1209,3 +1213,136 @@ func TestEntryDescriptionFromGooglePlayDescription(t *testing.T) {
 		t.Errorf(`Unexpected podcast content, got %q instead of %q`, result, expected)
 	}
 }
+
+func TestParsePodcastIndexElements(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<rss version="2.0" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+		<channel>
+			<title>Podcast Example</title>
+			<link>http://www.example.com/index.html</link>
+			<podcast:guid>917393e3-1b1e-5cef-ace4-edaa54e1f810</podcast:guid>
+			<podcast:locked owner="owner@example.com">yes</podcast:locked>
+			<podcast:funding url="https://example.com/donate">Support the show!</podcast:funding>
+			<podcast:funding url="https://example.com/member"></podcast:funding>
+			<item>
+				<title>Podcast Episode</title>
+				<guid>http://example.com/episode.mp3</guid>
+				<podcast:season name="Race for the Whitehouse">2</podcast:season>
+				<podcast:episode display="Ch.3">3.5</podcast:episode>
+				<podcast:chapters url="https://example.com/episode1/chapters.json" type="application/json+chapters" />
+				<podcast:transcript url="https://example.com/episode1/transcript.srt" type="application/x-subrip" language="es" rel="captions" />
+				<podcast:transcript url="https://example.com/episode1/transcript.html" type="text/html" />
+				<podcast:person role="Guest" href="https://www.wikipedia/alicebrown" img="http://example.com/images/alicebrown.jpg">Alice Brown</podcast:person>
+				<podcast:person>Becky Smith</podcast:person>
+				<enclosure url="http://example.com/episode.mp3" length="1024" type="audio/mpeg"/>
+			</item>
+			<item>
+				<title>Episode without Podcasting 2.0 elements</title>
+				<guid>http://example.com/other.mp3</guid>
+			</item>
+		</channel>
+	</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	podcast := feed.Podcast
+	if podcast == nil {
+		t.Fatal(`The feed should have podcast elements`)
+	}
+
+	if podcast.GUID != "917393e3-1b1e-5cef-ace4-edaa54e1f810" || !podcast.Locked || podcast.LockedOwner != "owner@example.com" {
+		t.Errorf(`Incorrect podcast elements: %+v`, podcast)
+	}
+
+	if len(podcast.Funding) != 2 || podcast.Funding[0].Title != "Support the show!" || podcast.Funding[1].Title != "https://example.com/member" {
+		t.Errorf(`Incorrect funding links: %+v`, podcast.Funding)
+	}
+
+	episode := feed.Entries[0].Podcast
+	if episode == nil {
+		t.Fatal(`The entry should have podcast elements`)
+	}
+
+	if episode.Season != 2 || episode.SeasonName != "Race for the Whitehouse" || episode.Episode != "3.5" || episode.EpisodeDisplay != "Ch.3" {
+		t.Errorf(`Incorrect season or episode: %+v`, episode)
+	}
+
+	if len(feed.Entries[0].Enclosures) != 1 {
+		t.Fatalf(`Incorrect number of enclosures, got: %d`, len(feed.Entries[0].Enclosures))
+	}
+
+	enclosure := feed.Entries[0].Enclosures[0]
+	if enclosure.ChaptersURL != "https://example.com/episode1/chapters.json" {
+		t.Errorf(`Incorrect chapters URL, got: %q`, enclosure.ChaptersURL)
+	}
+
+	if len(enclosure.Transcripts) != 2 {
+		t.Fatalf(`Incorrect number of transcripts, got: %d`, len(enclosure.Transcripts))
+	}
+
+	if transcript := enclosure.Transcripts[0]; transcript.MimeType != "application/x-subrip" || transcript.Language != "es" || transcript.Rel != "captions" {
+		t.Errorf(`Incorrect transcript: %+v`, transcript)
+	}
+
+	if len(episode.Persons) != 2 {
+		t.Fatalf(`Incorrect number of persons, got: %d`, len(episode.Persons))
+	}
+
+	if person := episode.Persons[0]; person.Name != "Alice Brown" || person.Role != "guest" || person.Image != "http://example.com/images/alicebrown.jpg" {
+		t.Errorf(`Incorrect person: %+v`, person)
+	}
+
+	if feed.Entries[1].Podcast != nil {
+		t.Errorf(`The entry should not have podcast elements: %+v`, feed.Entries[1].Podcast)
+	}
+}
+
+func TestParseFeedWithoutPodcastIndexElements(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<rss version="2.0">
+		<channel>
+			<title>Example</title>
+			<link>http://www.example.com/index.html</link>
+			<guid>not-a-podcast-guid</guid>
+		</channel>
+	</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Podcast != nil {
+		t.Errorf(`The feed should not have podcast elements: %+v`, feed.Podcast)
+	}
+}
+
+func TestParseEntryWithCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+			<link>https://example.org/</link>
+			<item>
+				<title>Item 1</title>
+				<link>https://example.org/item1</link>
+				<category>Go</category>
+				<category domain="https://example.org/tags"> Databases </category>
+				<category></category>
+				<media:category>Not a tag</media:category>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags := feed.Entries[0].Tags
+	if len(tags) != 2 || tags[0] != "Go" || tags[1] != "Databases" {
+		t.Errorf(`Incorrect entry tags, got: %q`, tags)
+	}
+}
@@ -4,7 +4,12 @@
 
 package rss // import "miniflux.app/reader/rss"
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+
+	"miniflux.app/model"
+)
 
 // PodcastFeedElement represents iTunes and GooglePlay feed XML elements.
 // Specs:
@@ -68,3 +73,144 @@ func (e *PodcastEntryElement) PodcastDescription() string {
 	}
 	return strings.TrimSpace(description)
 }
+
+// PodcastIndexFeedElement represents Podcasting 2.0 feed XML elements.
+// Specs: https://github.com/Podcastindex-org/podcast-namespace/blob/main/docs/1.0.md
+type PodcastIndexFeedElement struct {
+	PodcastGUID    string           `xml:"https://podcastindex.org/namespace/1.0 channel>guid"`
+	PodcastLocked  podcastLocked    `xml:"https://podcastindex.org/namespace/1.0 channel>locked"`
+	PodcastFunding []podcastFunding `xml:"https://podcastindex.org/namespace/1.0 channel>funding"`
+}
+
+// PodcastIndexEntryElement represents Podcasting 2.0 entry XML elements.
+type PodcastIndexEntryElement struct {
+	PodcastSeason        podcastSeason       `xml:"https://podcastindex.org/namespace/1.0 season"`
+	PodcastEpisodeNumber podcastEpisode      `xml:"https://podcastindex.org/namespace/1.0 episode"`
+	PodcastChapters      podcastChapters     `xml:"https://podcastindex.org/namespace/1.0 chapters"`
+	PodcastTranscripts   []podcastTranscript `xml:"https://podcastindex.org/namespace/1.0 transcript"`
+	PodcastPersons       []podcastPerson     `xml:"https://podcastindex.org/namespace/1.0 person"`
+}
+
+type podcastLocked struct {
+	Owner string `xml:"owner,attr"`
+	Value string `xml:",chardata"`
+}
+
+type podcastFunding struct {
+	URL   string `xml:"url,attr"`
+	Title string `xml:",chardata"`
+}
+
+type podcastSeason struct {
+	Name   string `xml:"name,attr"`
+	Number string `xml:",chardata"`
+}
+
+type podcastEpisode struct {
+	Display string `xml:"display,attr"`
+	Number  string `xml:",chardata"`
+}
+
+type podcastChapters struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type podcastTranscript struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Language string `xml:"language,attr"`
+	Rel      string `xml:"rel,attr"`
+}
+
+type podcastPerson struct {
+	Role  string `xml:"role,attr"`
+	Group string `xml:"group,attr"`
+	Image string `xml:"img,attr"`
+	URL   string `xml:"href,attr"`
+	Name  string `xml:",chardata"`
+}
+
+// Podcast returns the Podcasting 2.0 elements of the feed, nil if there is none.
+func (e *PodcastIndexFeedElement) Podcast() *model.Podcast {
+	podcast := &model.Podcast{
+		GUID:        strings.TrimSpace(e.PodcastGUID),
+		Locked:      strings.EqualFold(strings.TrimSpace(e.PodcastLocked.Value), "yes"),
+		LockedOwner: strings.TrimSpace(e.PodcastLocked.Owner),
+	}
+
+	for _, funding := range e.PodcastFunding {
+		if fundingURL := strings.TrimSpace(funding.URL); fundingURL != "" {
+			title := strings.TrimSpace(funding.Title)
+			if title == "" {
+				title = fundingURL
+			}
+
+			podcast.Funding = append(podcast.Funding, &model.PodcastFunding{URL: fundingURL, Title: title})
+		}
+	}
+
+	if podcast.GUID == "" && podcast.LockedOwner == "" && !podcast.Locked && len(podcast.Funding) == 0 {
+		return nil
+	}
+
+	return podcast
+}
+
+// PodcastEpisode returns the Podcasting 2.0 elements of the entry, nil if there is none.
+func (e *PodcastIndexEntryElement) PodcastEpisode() *model.PodcastEpisode {
+	episode := &model.PodcastEpisode{
+		SeasonName:     strings.TrimSpace(e.PodcastSeason.Name),
+		Episode:        strings.TrimSpace(e.PodcastEpisodeNumber.Number),
+		EpisodeDisplay: strings.TrimSpace(e.PodcastEpisodeNumber.Display),
+	}
+
+	episode.Season, _ = strconv.Atoi(strings.TrimSpace(e.PodcastSeason.Number))
+
+	for _, person := range e.PodcastPersons {
+		if name := strings.TrimSpace(person.Name); name != "" {
+			episode.Persons = append(episode.Persons, &model.PodcastPerson{
+				Name:  name,
+				Role:  strings.ToLower(strings.TrimSpace(person.Role)),
+				Group: strings.ToLower(strings.TrimSpace(person.Group)),
+				Image: strings.TrimSpace(person.Image),
+				URL:   strings.TrimSpace(person.URL),
+			})
+		}
+	}
+
+	if episode.Season == 0 && episode.SeasonName == "" && episode.Episode == "" && len(episode.Persons) == 0 {
+		return nil
+	}
+
+	return episode
+}
+
+// AddPodcastMedia adds the chapters and the transcripts of the episode to its audio and video enclosures.
+func (e *PodcastIndexEntryElement) AddPodcastMedia(enclosures model.EnclosureList) {
+	var chaptersURL string
+
+	// Only JSON chapters are supported.
+	if strings.Contains(strings.ToLower(e.PodcastChapters.Type), "json") {
+		chaptersURL = strings.TrimSpace(e.PodcastChapters.URL)
+	}
+
+	var transcripts model.PodcastTranscripts
+	for _, transcript := range e.PodcastTranscripts {
+		if transcriptURL := strings.TrimSpace(transcript.URL); transcriptURL != "" {
+			transcripts = append(transcripts, &model.PodcastTranscript{
+				URL:      transcriptURL,
+				MimeType: strings.TrimSpace(transcript.Type),
+				Language: strings.TrimSpace(transcript.Language),
+				Rel:      strings.TrimSpace(transcript.Rel),
+			})
+		}
+	}
+
+	for _, enclosure := range enclosures {
+		if strings.HasPrefix(enclosure.MimeType, "audio/") || strings.HasPrefix(enclosure.MimeType, "video/") {
+			enclosure.ChaptersURL = chaptersURL
+			enclosure.Transcripts = transcripts
+		}
+	}
+}
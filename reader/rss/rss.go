@@ -33,6 +33,7 @@ type rssFeed struct {
 	Webmaster      string    `xml:"channel>webMaster"`
 	Items          []rssItem `xml:"channel>item"`
 	PodcastFeedElement
+	PodcastIndexFeedElement
 }
 
 func (r *rssFeed) Transform() *model.Feed {
@@ -40,6 +41,8 @@ func (r *rssFeed) Transform() *model.Feed {
 	feed.SiteURL = r.siteURL()
 	feed.FeedURL = r.feedURL()
 	feed.Title = strings.TrimSpace(r.Title)
+	feed.Language = strings.TrimSpace(r.Language)
+	feed.Podcast = r.Podcast()
 
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -136,6 +139,11 @@ func (enclosure *rssEnclosure) Size() int64 {
 	return size
 }
 
+type rssCategory struct {
+	XMLName xml.Name
+	Data    string `xml:",chardata"`
+}
+
 type rssItem struct {
 	GUID           string           `xml:"guid"`
 	Title          string           `xml:"title"`
@@ -145,9 +153,11 @@ type rssItem struct {
 	Authors        []rssAuthor      `xml:"author"`
 	CommentLinks   []rssCommentLink `xml:"comments"`
 	EnclosureLinks []rssEnclosure   `xml:"enclosure"`
+	Categories     []rssCategory    `xml:"category"`
 	DublinCoreElement
 	FeedBurnerElement
 	PodcastEntryElement
+	PodcastIndexEntryElement
 	media.Element
 }
 
@@ -161,6 +171,9 @@ func (r *rssItem) Transform() *model.Entry {
 	entry.Content = r.entryContent()
 	entry.Title = r.entryTitle()
 	entry.Enclosures = r.entryEnclosures()
+	entry.Tags = r.entryTags()
+	entry.Podcast = r.PodcastEpisode()
+	r.AddPodcastMedia(entry.Enclosures)
 	return entry
 }
 
@@ -333,6 +346,20 @@ func (r *rssItem) entryCommentsURL() string {
 	return ""
 }
 
+func (r *rssItem) entryTags() []string {
+	var tags []string
+	for _, category := range r.Categories {
+		if category.XMLName.Space == "" {
+			tag := strings.TrimSpace(sanitizer.StripTags(category.Data))
+			if tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	return tags
+}
+
 func isValidLinkRelation(rel string) bool {
 	switch rel {
 	case "", "alternate", "enclosure", "related", "self", "via":
@@ -0,0 +1,155 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package selector // import "miniflux.app/reader/selector"
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/errors"
+	"miniflux.app/model"
+	"miniflux.app/reader/date"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Rules contains the CSS selectors used to find entries in a web page.
+// Title, link, date and content selectors are relative to each item.
+type Rules struct {
+	Item    string
+	Title   string
+	Link    string
+	Date    string
+	Content string
+}
+
+// FeedRules returns the rules defined for the given feed.
+func FeedRules(feed *model.Feed) *Rules {
+	return &Rules{
+		Item:    feed.ItemSelector,
+		Title:   feed.TitleSelector,
+		Link:    feed.LinkSelector,
+		Date:    feed.DateSelector,
+		Content: feed.ContentSelector,
+	}
+}
+
+// Parse returns a normalized feed struct from a web page.
+// Entries without link are ignored because the link is used to identify them.
+func Parse(pageURL string, data io.Reader, rules *Rules) (*model.Feed, *errors.LocalizedError) {
+	if strings.TrimSpace(rules.Item) == "" {
+		return nil, errors.NewLocalizedError("The item selector is mandatory")
+	}
+
+	document, err := goquery.NewDocumentFromReader(data)
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse this web page: %q", err)
+	}
+
+	feed := &model.Feed{
+		FeedURL: pageURL,
+		SiteURL: pageURL,
+		Title:   strings.TrimSpace(document.Find("title").First().Text()),
+	}
+
+	if feed.Title == "" {
+		feed.Title = pageURL
+	}
+
+	links := make(map[string]bool)
+	document.Find(rules.Item).Each(func(i int, item *goquery.Selection) {
+		entry := parseItem(pageURL, item, rules)
+		if entry == nil || links[entry.URL] {
+			return
+		}
+
+		links[entry.URL] = true
+		feed.Entries = append(feed.Entries, entry)
+	})
+
+	if len(feed.Entries) == 0 {
+		return nil, errors.NewLocalizedError("No item found with the selector %q", rules.Item)
+	}
+
+	return feed, nil
+}
+
+func parseItem(pageURL string, item *goquery.Selection, rules *Rules) *model.Entry {
+	link := findLink(item, rules.Link)
+	if link.Length() == 0 {
+		return nil
+	}
+
+	entryURL, err := url.AbsoluteURL(pageURL, strings.TrimSpace(link.AttrOr("href", "")))
+	if err != nil || entryURL == "" {
+		return nil
+	}
+
+	entry := &model.Entry{
+		URL:  entryURL,
+		Hash: crypto.Hash(entryURL),
+		Date: findDate(item, rules.Date),
+	}
+
+	if rules.Title != "" {
+		entry.Title = normalizeSpaces(item.Find(rules.Title).First().Text())
+	} else {
+		entry.Title = normalizeSpaces(link.Text())
+	}
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	if rules.Content != "" {
+		item.Find(rules.Content).Each(func(i int, s *goquery.Selection) {
+			content, _ := goquery.OuterHtml(s)
+			entry.Content += content
+		})
+	} else {
+		entry.Content, _ = item.Html()
+	}
+
+	entry.Content = strings.TrimSpace(entry.Content)
+	return entry
+}
+
+func findLink(item *goquery.Selection, selector string) *goquery.Selection {
+	if selector == "" {
+		if item.Is("a[href]") {
+			return item
+		}
+
+		selector = "a[href]"
+	}
+
+	return item.Find(selector).First()
+}
+
+func findDate(item *goquery.Selection, selector string) time.Time {
+	if selector != "" {
+		element := item.Find(selector).First()
+
+		value := element.AttrOr("datetime", "")
+		if value == "" {
+			value = element.Text()
+		}
+
+		if value = strings.TrimSpace(value); value != "" {
+			if result, err := date.Parse(value); err == nil {
+				return result
+			}
+		}
+	}
+
+	return time.Now()
+}
+
+func normalizeSpaces(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,121 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package selector // import "miniflux.app/reader/selector"
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+func parseTestPage(t *testing.T, rules *Rules) *model.Feed {
+	f, err := os.Open("testdata/blog.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	feed, parseErr := Parse("https://example.org/blog", f, rules)
+	if parseErr != nil {
+		t.Fatal(parseErr)
+	}
+
+	return feed
+}
+
+func TestParseWithAllSelectors(t *testing.T) {
+	feed := parseTestPage(t, &Rules{Item: "article.post", Title: "h2", Link: "h2 a", Date: "time", Content: "p.summary"})
+
+	if feed.Title != "Example Blog" {
+		t.Errorf(`Incorrect feed title, got: %q`, feed.Title)
+	}
+
+	if feed.FeedURL != "https://example.org/blog" || feed.SiteURL != "https://example.org/blog" {
+		t.Errorf(`Incorrect feed URLs, got: %q and %q`, feed.FeedURL, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Items without link and duplicate links should be ignored, got %d entries`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.URL != "https://example.org/posts/second-post" {
+		t.Errorf(`Relative links should be absolute, got: %q`, entry.URL)
+	}
+
+	if entry.Hash != crypto.Hash(entry.URL) {
+		t.Errorf(`The hash should be derived from the link, got: %q`, entry.Hash)
+	}
+
+	if entry.Title != "Second post" {
+		t.Errorf(`Incorrect entry title, got: %q`, entry.Title)
+	}
+
+	if !entry.Date.Equal(time.Date(2019, time.January, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`The datetime attribute should be used, got: %v`, entry.Date)
+	}
+
+	if entry.Content != `<p class="summary">The <strong>second</strong> summary.</p>` {
+		t.Errorf(`Incorrect entry content, got: %q`, entry.Content)
+	}
+
+	if !feed.Entries[1].Date.Equal(time.Date(2019, time.January, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`The element text should be used as date, got: %v`, feed.Entries[1].Date)
+	}
+}
+
+func TestParseWithItemSelectorOnly(t *testing.T) {
+	feed := parseTestPage(t, &Rules{Item: "article.post"})
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[1]
+	if entry.Title != "First post" || entry.URL != "https://example.org/posts/first-post" {
+		t.Errorf(`The first link of the item should be used, got: %q (%s)`, entry.Title, entry.URL)
+	}
+
+	if !strings.Contains(entry.Content, "The first summary.") {
+		t.Errorf(`The item should be used as content, got: %q`, entry.Content)
+	}
+
+	if entry.Date.IsZero() {
+		t.Error(`The entry date should be defined`)
+	}
+}
+
+func TestParseWithLinkAsItem(t *testing.T) {
+	feed := parseTestPage(t, &Rules{Item: "main h2 a"})
+
+	if len(feed.Entries) != 2 || feed.Entries[0].Title != "Second post" {
+		t.Errorf(`Links should be used as items, got: %v`, feed.Entries)
+	}
+}
+
+func TestParseWithoutMatchingItem(t *testing.T) {
+	_, err := Parse("https://example.org/", strings.NewReader(`<ul><li>Nothing</li></ul>`), &Rules{Item: "article"})
+	if err == nil {
+		t.Error(`An error should be returned when no item is found`)
+	}
+}
+
+func TestParseWithInvalidSelector(t *testing.T) {
+	_, err := Parse("https://example.org/", strings.NewReader(`<article><a href="/">Link</a></article>`), &Rules{Item: "article["})
+	if err == nil {
+		t.Error(`An error should be returned when the selector is invalid`)
+	}
+}
+
+func TestParseWithoutItemSelector(t *testing.T) {
+	_, err := Parse("https://example.org/", strings.NewReader(`<article><a href="/">Link</a></article>`), &Rules{})
+	if err == nil {
+		t.Error(`An error should be returned without item selector`)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package search parses the query language of the search box and selects the text search configuration of each language.
+
+*/
+package search // import "miniflux.app/search"
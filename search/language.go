@@ -0,0 +1,108 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package search // import "miniflux.app/search"
+
+import (
+	"sort"
+	"strings"
+)
+
+// DefaultConfiguration is the text search configuration used when the language is unknown,
+// words are indexed without stemming.
+const DefaultConfiguration = "simple"
+
+// Text search configurations available in all supported PostgreSQL versions, by ISO 639-1 language code.
+var configurations = map[string]string{
+	"da": "danish",
+	"de": "german",
+	"en": "english",
+	"es": "spanish",
+	"fi": "finnish",
+	"fr": "french",
+	"hu": "hungarian",
+	"it": "italian",
+	"nb": "norwegian",
+	"nl": "dutch",
+	"nn": "norwegian",
+	"no": "norwegian",
+	"pt": "portuguese",
+	"ro": "romanian",
+	"ru": "russian",
+	"sv": "swedish",
+	"tr": "turkish",
+}
+
+// Configurations returns the sorted list of text search configurations.
+func Configurations() []string {
+	seen := map[string]bool{DefaultConfiguration: true}
+	list := []string{DefaultConfiguration}
+	for _, configuration := range configurations {
+		if !seen[configuration] {
+			seen[configuration] = true
+			list = append(list, configuration)
+		}
+	}
+
+	sort.Strings(list)
+	return list
+}
+
+// IsValidConfiguration returns true if the text search configuration is supported.
+func IsValidConfiguration(configuration string) bool {
+	if configuration == DefaultConfiguration {
+		return true
+	}
+
+	for _, name := range configurations {
+		if name == configuration {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConfigurationForLanguage returns the text search configuration of a language tag
+// like "de", "fr-CA" or "en_US", or an empty string if the language is not supported.
+func ConfigurationForLanguage(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if index := strings.IndexAny(language, "-_"); index != -1 {
+		language = language[:index]
+	}
+
+	return configurations[language]
+}
+
+// UserConfiguration returns the text search configuration used for the queries of a user:
+// the user setting, otherwise the language of the user interface.
+func UserConfiguration(setting, userLanguage string) string {
+	if IsValidConfiguration(setting) {
+		return setting
+	}
+
+	if configuration := ConfigurationForLanguage(userLanguage); configuration != "" {
+		return configuration
+	}
+
+	return DefaultConfiguration
+}
+
+// FeedConfiguration returns the text search configuration used to index the entries of a feed:
+// the feed setting, otherwise the language declared by the feed, otherwise the user configuration.
+// Entries of a feed in an unsupported language are indexed without stemming.
+func FeedConfiguration(setting, feedLanguage, userConfiguration string) string {
+	if IsValidConfiguration(setting) {
+		return setting
+	}
+
+	if strings.TrimSpace(feedLanguage) != "" {
+		if configuration := ConfigurationForLanguage(feedLanguage); configuration != "" {
+			return configuration
+		}
+		return DefaultConfiguration
+	}
+
+	return userConfiguration
+}
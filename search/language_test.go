@@ -0,0 +1,80 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package search // import "miniflux.app/search"
+
+import "testing"
+
+func TestConfigurationForLanguage(t *testing.T) {
+	scenarios := map[string]string{
+		"de":     "german",
+		"fr-CA":  "french",
+		"en_US":  "english",
+		" NL ":   "dutch",
+		"nb-NO":  "norwegian",
+		"ja":     "",
+		"":       "",
+		"german": "",
+	}
+
+	for language, expected := range scenarios {
+		if result := ConfigurationForLanguage(language); result != expected {
+			t.Errorf(`Unexpected configuration for %q: got %q instead of %q`, language, result, expected)
+		}
+	}
+}
+
+func TestConfigurations(t *testing.T) {
+	list := Configurations()
+	if len(list) != 16 {
+		t.Fatalf(`Unexpected number of configurations: %v`, list)
+	}
+
+	if list[0] != "danish" || list[len(list)-1] != "turkish" {
+		t.Errorf(`Configurations are not sorted: %v`, list)
+	}
+
+	for _, configuration := range list {
+		if !IsValidConfiguration(configuration) {
+			t.Errorf(`Configuration %q should be valid`, configuration)
+		}
+	}
+
+	if IsValidConfiguration("klingon") || IsValidConfiguration("") {
+		t.Error(`Unknown configurations should not be valid`)
+	}
+}
+
+func TestUserConfiguration(t *testing.T) {
+	if result := UserConfiguration("french", "de_DE"); result != "french" {
+		t.Errorf(`The user setting should be used, got %q`, result)
+	}
+
+	if result := UserConfiguration("", "de_DE"); result != "german" {
+		t.Errorf(`The language of the user interface should be used, got %q`, result)
+	}
+
+	if result := UserConfiguration("", "zh_CN"); result != DefaultConfiguration {
+		t.Errorf(`The default configuration should be used, got %q`, result)
+	}
+}
+
+func TestFeedConfiguration(t *testing.T) {
+	scenarios := []struct {
+		setting, language, user, expected string
+	}{
+		{"spanish", "de", "english", "spanish"},
+		{"", "de-AT", "english", "german"},
+		{"", "ja", "english", DefaultConfiguration},
+		{"", "", "french", "french"},
+		{"invalid", "", "french", "french"},
+	}
+
+	for _, scenario := range scenarios {
+		result := FeedConfiguration(scenario.setting, scenario.language, scenario.user)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected configuration for %+v: got %q`, scenario, result)
+		}
+	}
+}
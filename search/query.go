@@ -0,0 +1,239 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package search // import "miniflux.app/search"
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	"miniflux.app/errors"
+)
+
+// Term represents a word or a quoted phrase of the full-text search.
+type Term struct {
+	Value   string
+	Phrase  bool
+	Negated bool
+}
+
+// Query represents a parsed search query.
+//
+// Text is a conjunction of disjunctions: "a b OR c" is parsed as a AND (b OR c).
+// Filters are always combined with AND.
+type Query struct {
+	Text       [][]*Term
+	Feeds      []string
+	Categories []string
+	Authors    []string
+	Status     string
+	Starred    bool
+	Before     *time.Time
+	After      *time.Time
+}
+
+// IsEmpty returns true if the query does not filter anything.
+func (q *Query) IsEmpty() bool {
+	return len(q.Text) == 0 && len(q.Feeds) == 0 && len(q.Categories) == 0 && len(q.Authors) == 0 &&
+		q.Status == "" && !q.Starred && q.Before == nil && q.After == nil
+}
+
+const (
+	operatorOR = "OR"
+	dateFormat = "2006-01-02"
+)
+
+type token struct {
+	key     string
+	value   string
+	quoted  bool
+	negated bool
+}
+
+// Parse parses a search query, dates are interpreted in the given timezone.
+//
+// The syntax supports the filters feed:, category:, author:, is:unread,
+// is:read, is:starred, before: and after: (YYYY-MM-DD), quoted phrases,
+// exclusion with a leading dash and the OR operator.
+func Parse(input, timezone string) (*Query, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	query := &Query{}
+	var group []*Term
+	expectTerm := false
+
+	for i, tok := range tokens {
+		if tok.key == "" && !tok.quoted && !tok.negated && tok.value == operatorOR {
+			if len(group) == 0 || i == len(tokens)-1 || expectTerm {
+				return nil, errors.NewLocalizedError("Invalid search query: OR must be placed between two search terms")
+			}
+
+			expectTerm = true
+			continue
+		}
+
+		if tok.key != "" {
+			if expectTerm {
+				return nil, errors.NewLocalizedError("Invalid search query: OR must be placed between two search terms")
+			}
+
+			if err := query.addFilter(tok, location); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		term := &Term{Value: tok.value, Phrase: tok.quoted, Negated: tok.negated}
+		if expectTerm {
+			group = append(group, term)
+			expectTerm = false
+			continue
+		}
+
+		if len(group) > 0 {
+			query.Text = append(query.Text, group)
+		}
+
+		group = []*Term{term}
+	}
+
+	if len(group) > 0 {
+		query.Text = append(query.Text, group)
+	}
+
+	return query, nil
+}
+
+func (q *Query) addFilter(tok *token, location *time.Location) error {
+	if tok.negated {
+		return errors.NewLocalizedError("Invalid search query: the filter %s: cannot be excluded", tok.key)
+	}
+
+	if tok.value == "" {
+		return errors.NewLocalizedError("Invalid search query: the filter %s: requires a value", tok.key)
+	}
+
+	switch tok.key {
+	case "feed":
+		q.Feeds = append(q.Feeds, tok.value)
+	case "category":
+		q.Categories = append(q.Categories, tok.value)
+	case "author":
+		q.Authors = append(q.Authors, tok.value)
+	case "is":
+		switch strings.ToLower(tok.value) {
+		case "unread":
+			q.Status = "unread"
+		case "read":
+			q.Status = "read"
+		case "starred":
+			q.Starred = true
+		default:
+			return errors.NewLocalizedError("Invalid search query: unknown state %q, use is:unread, is:read or is:starred", tok.value)
+		}
+	case "before", "after":
+		date, err := time.ParseInLocation(dateFormat, tok.value, location)
+		if err != nil {
+			return errors.NewLocalizedError("Invalid search query: the date %q must use the format YYYY-MM-DD", tok.value)
+		}
+
+		if tok.key == "before" {
+			q.Before = &date
+		} else {
+			q.After = &date
+		}
+	}
+
+	return nil
+}
+
+var filterKeys = map[string]bool{
+	"feed":     true,
+	"category": true,
+	"author":   true,
+	"is":       true,
+	"before":   true,
+	"after":    true,
+}
+
+func tokenize(input string) ([]*token, error) {
+	var tokens []*token
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		tok := &token{}
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			tok.negated = true
+			i++
+		}
+
+		// A filter is a known key followed by a colon, other words containing a colon are searched as is.
+		if colon := indexRune(runes[i:], ':'); colon > 0 {
+			key := strings.ToLower(string(runes[i : i+colon]))
+			if filterKeys[key] {
+				tok.key = key
+				i += colon + 1
+			}
+		}
+
+		if i < len(runes) && runes[i] == '"' {
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+
+			if end == len(runes) {
+				return nil, errors.NewLocalizedError("Invalid search query: a quotation mark is not closed")
+			}
+
+			tok.value = strings.TrimSpace(string(runes[i+1 : end]))
+			tok.quoted = true
+			i = end + 1
+		} else {
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			tok.value = string(runes[start:i])
+		}
+
+		if tok.key == "" && tok.value == "" {
+			continue
+		}
+
+		tokens = append(tokens, tok)
+	}
+
+	return tokens, nil
+}
+
+// indexRune returns the position of r in the current word, or -1 if the word does not contain it.
+func indexRune(runes []rune, r rune) int {
+	for i, c := range runes {
+		if c == r {
+			return i
+		}
+
+		if unicode.IsSpace(c) {
+			return -1
+		}
+	}
+
+	return -1
+}
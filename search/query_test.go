@@ -0,0 +1,129 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package search // import "miniflux.app/search"
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"miniflux.app/errors"
+)
+
+func TestParsePlainWords(t *testing.T) {
+	query, err := Parse("golang  postgres", "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]*Term{{{Value: "golang"}}, {{Value: "postgres"}}}
+	if !reflect.DeepEqual(query.Text, expected) {
+		t.Errorf(`Unexpected terms: %+v`, query.Text)
+	}
+}
+
+func TestParsePhraseExclusionAndOR(t *testing.T) {
+	query, err := Parse(`"rust language" -java go OR golang`, "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := [][]*Term{
+		{{Value: "rust language", Phrase: true}},
+		{{Value: "java", Negated: true}},
+		{{Value: "go"}, {Value: "golang"}},
+	}
+
+	if !reflect.DeepEqual(query.Text, expected) {
+		t.Errorf(`Unexpected terms: %+v`, query.Text)
+	}
+}
+
+func TestParseFilters(t *testing.T) {
+	query, err := Parse(`feed:"Ars Technica" category:Tech author:alice is:unread is:starred after:2019-01-01 before:2019-02-01 linux`, "Europe/Paris")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(query.Feeds, []string{"Ars Technica"}) {
+		t.Errorf(`Unexpected feeds: %v`, query.Feeds)
+	}
+
+	if !reflect.DeepEqual(query.Categories, []string{"Tech"}) || !reflect.DeepEqual(query.Authors, []string{"alice"}) {
+		t.Errorf(`Unexpected filters: %+v`, query)
+	}
+
+	if query.Status != "unread" || !query.Starred {
+		t.Errorf(`Unexpected state: %q, starred=%v`, query.Status, query.Starred)
+	}
+
+	location, _ := time.LoadLocation("Europe/Paris")
+	if query.After == nil || !query.After.Equal(time.Date(2019, 1, 1, 0, 0, 0, 0, location)) {
+		t.Errorf(`Unexpected after date: %v`, query.After)
+	}
+
+	if query.Before == nil || !query.Before.Equal(time.Date(2019, 2, 1, 0, 0, 0, 0, location)) {
+		t.Errorf(`Unexpected before date: %v`, query.Before)
+	}
+
+	if len(query.Text) != 1 || query.Text[0][0].Value != "linux" {
+		t.Errorf(`Unexpected terms: %+v`, query.Text)
+	}
+}
+
+func TestParseUnknownPrefixIsSearched(t *testing.T) {
+	query, err := Parse("https://example.org/ title:foo", "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(query.Text) != 2 || query.Text[0][0].Value != "https://example.org/" || query.Text[1][0].Value != "title:foo" {
+		t.Errorf(`Unexpected terms: %+v`, query.Text)
+	}
+}
+
+func TestParseEmptyQuery(t *testing.T) {
+	query, err := Parse("   ", "UTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !query.IsEmpty() {
+		t.Error(`The query should be empty`)
+	}
+}
+
+func TestParseInvalidQueries(t *testing.T) {
+	scenarios := map[string]string{
+		`"unterminated`:    "Invalid search query: a quotation mark is not closed",
+		`OR golang`:        "Invalid search query: OR must be placed between two search terms",
+		`golang OR`:        "Invalid search query: OR must be placed between two search terms",
+		`go OR OR rust`:    "Invalid search query: OR must be placed between two search terms",
+		`go OR feed:x`:     "Invalid search query: OR must be placed between two search terms",
+		`is:archived`:      `Invalid search query: unknown state "archived", use is:unread, is:read or is:starred`,
+		`before:yesterday`: `Invalid search query: the date "yesterday" must use the format YYYY-MM-DD`,
+		`after:2019-13-01`: `Invalid search query: the date "2019-13-01" must use the format YYYY-MM-DD`,
+		`feed:`:            "Invalid search query: the filter feed: requires a value",
+		`-feed:"Example"`:  "Invalid search query: the filter feed: cannot be excluded",
+	}
+
+	for input, expected := range scenarios {
+		_, err := Parse(input, "UTC")
+		if err == nil {
+			t.Errorf(`The query %q should be rejected`, input)
+			continue
+		}
+
+		localizedError, ok := err.(*errors.LocalizedError)
+		if !ok {
+			t.Errorf(`Unexpected error type for %q: %T`, input, err)
+			continue
+		}
+
+		if message := localizedError.Error(); message != expected {
+			t.Errorf(`Unexpected error for %q: %q instead of %q`, input, message, expected)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/reader/feed"
 	"miniflux.app/storage"
+	"miniflux.app/syndication"
 	"miniflux.app/ui"
 	"miniflux.app/worker"
 
@@ -166,7 +167,8 @@ func setupHandler(store *storage.Storage, feedHandler *feed.Handler, pool *worke
 	router.Use(middleware)
 
 	fever.Serve(router, store)
-	api.Serve(router, store, feedHandler)
+	api.Serve(router, store, pool, feedHandler)
+	syndication.Serve(router, store)
 	ui.Serve(router, store, pool, feedHandler)
 
 	router.HandleFunc("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
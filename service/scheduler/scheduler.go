@@ -8,13 +8,18 @@ import (
 	"time"
 
 	"miniflux.app/config"
+	"miniflux.app/digest"
 	"miniflux.app/logger"
+	"miniflux.app/mailer"
+	"miniflux.app/newsletter"
+	"miniflux.app/reader/chapters"
+	"miniflux.app/reader/opml"
 	"miniflux.app/storage"
 	"miniflux.app/worker"
 )
 
 // Serve starts the internal scheduler.
-func Serve(store *storage.Storage, pool *worker.Pool) {
+func Serve(store *storage.Storage, pool *worker.Pool, deliveryPool *worker.DeliveryPool) {
 	logger.Info(`Starting scheduler...`)
 
 	go feedScheduler(
@@ -24,11 +29,37 @@ func Serve(store *storage.Storage, pool *worker.Pool) {
 		config.Opts.BatchSize(),
 	)
 
+	go deliveryScheduler(
+		store,
+		deliveryPool,
+		config.Opts.IntegrationDeliveryFrequency(),
+		config.Opts.BatchSize(),
+	)
+
+	go opmlSubscriptionScheduler(
+		store,
+		pool,
+		config.Opts.OPMLSyncFrequency(),
+	)
+
+	if mailer.IsConfigured() {
+		go digestScheduler(store)
+	}
+
+	if maildir := config.Opts.NewsletterMaildir(); maildir != "" {
+		go newsletterScheduler(store, maildir)
+	}
+
+	go snoozeScheduler(store)
+
+	go chaptersScheduler(store, config.Opts.BatchSize())
+
 	go cleanupScheduler(
 		store,
 		config.Opts.CleanupFrequencyHours(),
 		config.Opts.CleanupArchiveReadDays(),
 		config.Opts.CleanupRemoveSessionsDays(),
+		config.Opts.CleanupRemoveDeliveriesDays(),
 	)
 }
 
@@ -45,15 +76,107 @@ func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSi
 	}
 }
 
-func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int, sessionsDays int) {
+func deliveryScheduler(store *storage.Storage, deliveryPool *worker.DeliveryPool, frequency, batchSize int) {
+	c := time.Tick(time.Duration(frequency) * time.Second)
+	for range c {
+		deliveries, err := store.NewIntegrationDeliveryBatch(batchSize)
+		if err != nil {
+			logger.Error("[Scheduler:Delivery] %v", err)
+		} else if len(deliveries) > 0 {
+			logger.Debug("[Scheduler:Delivery] Pushing %d deliveries", len(deliveries))
+			deliveryPool.Push(deliveries)
+		}
+	}
+}
+
+func opmlSubscriptionScheduler(store *storage.Storage, pool *worker.Pool, frequency int) {
+	handler := opml.NewHandler(store)
+	c := time.Tick(time.Duration(frequency) * time.Minute)
+	for range c {
+		subscriptions, err := store.AllOPMLSubscriptions()
+		if err != nil {
+			logger.Error("[Scheduler:OPML] %v", err)
+			continue
+		}
+
+		for _, subscription := range subscriptions {
+			jobs, err := handler.Sync(subscription)
+			if err != nil {
+				logger.Error("[Scheduler:OPML] Subscription #%d: %v", subscription.ID, err)
+			}
+
+			if len(jobs) > 0 {
+				logger.Debug("[Scheduler:OPML] Pushing %d jobs", len(jobs))
+				pool.Push(jobs)
+			}
+		}
+	}
+}
+
+func digestScheduler(store *storage.Storage) {
+	c := time.Tick(time.Minute)
+	for now := range c {
+		digest.SendDueDigests(store, now)
+	}
+}
+
+func newsletterScheduler(store *storage.Storage, maildir string) {
+	domain := newsletter.Domain()
+	deliver := newsletter.NewDeliverFunc(store, domain)
+
+	c := time.Tick(time.Minute)
+	for range c {
+		if err := newsletter.ProcessMaildir(maildir, domain, deliver); err != nil {
+			logger.Error("[Scheduler:Newsletter] %v", err)
+		}
+	}
+}
+
+func snoozeScheduler(store *storage.Storage) {
+	c := time.Tick(time.Minute)
+	for range c {
+		nbEntries, err := store.WakeSnoozedEntries()
+		if err != nil {
+			logger.Error("[Scheduler:Snooze] %v", err)
+		} else if nbEntries > 0 {
+			logger.Debug("[Scheduler:Snooze] Woke up %d entries", nbEntries)
+		}
+	}
+}
+
+func chaptersScheduler(store *storage.Storage, batchSize int) {
+	c := time.Tick(time.Minute)
+	for range c {
+		chapters.FetchMissingChapters(store, batchSize)
+	}
+}
+
+func cleanupScheduler(store *storage.Storage, frequency int, archiveDays int, sessionsDays int, deliveriesDays int) {
 	c := time.Tick(time.Duration(frequency) * time.Hour)
 	for range c {
 		nbSessions := store.CleanOldSessions(sessionsDays)
 		nbUserSessions := store.CleanOldUserSessions(sessionsDays)
 		logger.Info("[Scheduler:Cleanup] Cleaned %d sessions and %d user sessions", nbSessions, nbUserSessions)
 
-		if err := store.ArchiveEntries(archiveDays); err != nil {
+		nbDeliveries := store.CleanOldIntegrationDeliveries(deliveriesDays)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d integration deliveries", nbDeliveries)
+
+		nbArchived, err := store.ArchiveEntries(archiveDays)
+		if err != nil {
 			logger.Error("[Scheduler:Cleanup] %v", err)
 		}
+
+		nbRemoved, err := store.RemoveExtraEntries()
+		if err != nil {
+			logger.Error("[Scheduler:Cleanup] %v", err)
+		}
+
+		nbMarkedAsRead, err := store.MarkOldEntriesAsRead()
+		if err != nil {
+			logger.Error("[Scheduler:Cleanup] %v", err)
+		}
+
+		logger.Info("[Scheduler:Cleanup] Archived %d read entries, removed %d extra entries and marked %d old entries as read",
+			nbArchived, nbRemoved, nbMarkedAsRead)
 	}
 }
@@ -32,8 +32,15 @@ func (s *Storage) CategoryExists(userID, categoryID int64) bool {
 func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title)
+	query := `SELECT id, user_id, title, archive_read_days, max_entries, mark_as_read_days FROM categories WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, categoryID).Scan(
+		&category.ID,
+		&category.UserID,
+		&category.Title,
+		&category.ArchiveReadDays,
+		&category.MaxEntries,
+		&category.MarkAsReadDays,
+	)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -81,7 +88,7 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 
 // Categories returns all categories that belongs to the given user.
 func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	query := `SELECT id, user_id, title FROM categories WHERE user_id=$1 ORDER BY title ASC`
+	query := `SELECT id, user_id, title, archive_read_days, max_entries, mark_as_read_days FROM categories WHERE user_id=$1 ORDER BY title ASC`
 	rows, err := s.db.Query(query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch categories: %v`, err)
@@ -91,7 +98,15 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title); err != nil {
+		err := rows.Scan(
+			&category.ID,
+			&category.UserID,
+			&category.Title,
+			&category.ArchiveReadDays,
+			&category.MaxEntries,
+			&category.MarkAsReadDays,
+		)
+		if err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -138,9 +153,9 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 func (s *Storage) CreateCategory(category *model.Category) error {
 	query := `
 		INSERT INTO categories
-			(user_id, title)
+			(user_id, title, archive_read_days, max_entries, mark_as_read_days)
 		VALUES
-			($1, $2)
+			($1, $2, $3, $4, $5)
 		RETURNING
 			id
 	`
@@ -148,6 +163,9 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 		query,
 		category.UserID,
 		category.Title,
+		category.ArchiveReadDays,
+		category.MaxEntries,
+		category.MarkAsReadDays,
 	).Scan(&category.ID)
 
 	if err != nil {
@@ -159,10 +177,23 @@ func (s *Storage) CreateCategory(category *model.Category) error {
 
 // UpdateCategory updates an existing category.
 func (s *Storage) UpdateCategory(category *model.Category) error {
-	query := `UPDATE categories SET title=$1 WHERE id=$2 AND user_id=$3`
+	query := `
+		UPDATE
+			categories
+		SET
+			title=$1,
+			archive_read_days=$2,
+			max_entries=$3,
+			mark_as_read_days=$4
+		WHERE
+			id=$5 AND user_id=$6
+	`
 	_, err := s.db.Exec(
 		query,
 		category.Title,
+		category.ArchiveReadDays,
+		category.MaxEntries,
+		category.MarkAsReadDays,
 		category.ID,
 		category.UserID,
 	)
@@ -0,0 +1,167 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// Digest returns the email digest settings of the given user.
+func (s *Storage) Digest(userID int64) (*model.Digest, error) {
+	query := `
+		SELECT
+			d.user_id,
+			d.enabled,
+			d.email,
+			d.frequency,
+			d.hour,
+			d.weekday,
+			d.category_ids,
+			d.mark_as_read,
+			d.last_entry_id,
+			d.last_sent_at,
+			u.timezone,
+			u.language
+		FROM
+			digests d
+		JOIN users u ON u.id=d.user_id
+		WHERE
+			d.user_id=$1
+	`
+	digest, err := scanDigest(s.db.QueryRow(query, userID))
+	switch {
+	case err == sql.ErrNoRows:
+		return &model.Digest{UserID: userID, Frequency: model.DigestFrequencyDaily, Hour: 8, Weekday: 1}, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch digest settings: %v`, err)
+	default:
+		return digest, nil
+	}
+}
+
+// Digests returns the email digest settings of all users having enabled digests.
+func (s *Storage) Digests() (model.Digests, error) {
+	query := `
+		SELECT
+			d.user_id,
+			d.enabled,
+			d.email,
+			d.frequency,
+			d.hour,
+			d.weekday,
+			d.category_ids,
+			d.mark_as_read,
+			d.last_entry_id,
+			d.last_sent_at,
+			u.timezone,
+			u.language
+		FROM
+			digests d
+		JOIN users u ON u.id=d.user_id
+		WHERE
+			d.enabled='t'
+	`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch digests: %v`, err)
+	}
+	defer rows.Close()
+
+	var digests model.Digests
+	for rows.Next() {
+		digest, err := scanDigest(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch digest row: %v`, err)
+		}
+
+		digests = append(digests, digest)
+	}
+
+	return digests, nil
+}
+
+// UpdateDigest saves the email digest settings of a user.
+// A new or re-enabled digest starts after the most recent entry, the existing unread entries are not sent.
+func (s *Storage) UpdateDigest(digest *model.Digest) error {
+	query := `
+		INSERT INTO digests
+			(user_id, enabled, email, frequency, hour, weekday, category_ids, mark_as_read, last_entry_id, last_sent_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6, coalesce($7, '{}'::bigint[]), $8, (SELECT coalesce(max(id), 0) FROM entries WHERE user_id=$1), now())
+		ON CONFLICT (user_id) DO UPDATE SET
+			last_entry_id=(CASE WHEN digests.enabled THEN digests.last_entry_id ELSE EXCLUDED.last_entry_id END),
+			enabled=EXCLUDED.enabled,
+			email=EXCLUDED.email,
+			frequency=EXCLUDED.frequency,
+			hour=EXCLUDED.hour,
+			weekday=EXCLUDED.weekday,
+			category_ids=EXCLUDED.category_ids,
+			mark_as_read=EXCLUDED.mark_as_read
+	`
+	_, err := s.db.Exec(
+		query,
+		digest.UserID,
+		digest.Enabled,
+		digest.Email,
+		digest.Frequency,
+		digest.Hour,
+		digest.Weekday,
+		pq.Array(digest.CategoryIDs),
+		digest.MarkAsRead,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to update digest settings: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateDigestDelivery records the last entry sent in the digest of a user.
+func (s *Storage) UpdateDigestDelivery(userID, lastEntryID int64) error {
+	query := `UPDATE digests SET last_sent_at=now(), last_entry_id=greatest(last_entry_id, $1) WHERE user_id=$2`
+	if _, err := s.db.Exec(query, lastEntryID, userID); err != nil {
+		return fmt.Errorf(`store: unable to update digest delivery: %v`, err)
+	}
+
+	return nil
+}
+
+type digestScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDigest(row digestScanner) (*model.Digest, error) {
+	var digest model.Digest
+	var categoryIDs pq.Int64Array
+
+	err := row.Scan(
+		&digest.UserID,
+		&digest.Enabled,
+		&digest.Email,
+		&digest.Frequency,
+		&digest.Hour,
+		&digest.Weekday,
+		&categoryIDs,
+		&digest.MarkAsRead,
+		&digest.LastEntryID,
+		&digest.LastSentAt,
+		&digest.Timezone,
+		&digest.Language,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	digest.CategoryIDs = categoryIDs
+
+	return &digest, nil
+}
@@ -5,6 +5,7 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"database/sql"
 	"fmt"
 
 	"miniflux.app/model"
@@ -19,7 +20,12 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			entry_id,
 			url,
 			size,
-			mime_type
+			mime_type,
+			media_progression,
+			played,
+			chapters_url,
+			chapters,
+			transcripts
 		FROM
 			enclosures
 		WHERE
@@ -43,6 +49,11 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			&enclosure.URL,
 			&enclosure.Size,
 			&enclosure.MimeType,
+			&enclosure.MediaProgression,
+			&enclosure.Played,
+			&enclosure.ChaptersURL,
+			&enclosure.Chapters,
+			&enclosure.Transcripts,
 		)
 
 		if err != nil {
@@ -55,6 +66,63 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 	return enclosures, nil
 }
 
+// EnclosureByID returns an attachment by the ID.
+func (s *Storage) EnclosureByID(userID, enclosureID int64) (*model.Enclosure, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			url,
+			size,
+			mime_type,
+			media_progression,
+			played,
+			chapters_url,
+			chapters,
+			transcripts
+		FROM
+			enclosures
+		WHERE
+			id = $1 AND user_id = $2
+	`
+
+	var enclosure model.Enclosure
+	err := s.db.QueryRow(query, enclosureID, userID).Scan(
+		&enclosure.ID,
+		&enclosure.UserID,
+		&enclosure.EntryID,
+		&enclosure.URL,
+		&enclosure.Size,
+		&enclosure.MimeType,
+		&enclosure.MediaProgression,
+		&enclosure.Played,
+		&enclosure.ChaptersURL,
+		&enclosure.Chapters,
+		&enclosure.Transcripts,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch enclosure #%d: %v`, enclosureID, err)
+	}
+
+	return &enclosure, nil
+}
+
+// UpdateEnclosureProgression saves the playback position and the played state of an attachment.
+func (s *Storage) UpdateEnclosureProgression(enclosure *model.Enclosure) error {
+	query := `UPDATE enclosures SET media_progression=$1, played=$2 WHERE id=$3 AND user_id=$4`
+	_, err := s.db.Exec(query, enclosure.MediaProgression, enclosure.Played, enclosure.ID, enclosure.UserID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update progression of enclosure #%d: %v`, enclosure.ID, err)
+	}
+
+	return nil
+}
+
 // CreateEnclosure creates a new attachment.
 func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
 	if enclosure.URL == "" {
@@ -63,9 +131,9 @@ func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
 
 	query := `
 		INSERT INTO enclosures
-			(url, size, mime_type, entry_id, user_id)
+			(url, size, mime_type, entry_id, user_id, chapters_url, chapters, transcripts)
 		VALUES
-			($1, $2, $3, $4, $5)
+			($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING
 			id
 	`
@@ -76,6 +144,9 @@ func (s *Storage) CreateEnclosure(enclosure *model.Enclosure) error {
 		enclosure.MimeType,
 		enclosure.EntryID,
 		enclosure.UserID,
+		enclosure.ChaptersURL,
+		enclosure.Chapters,
+		enclosure.Transcripts,
 	).Scan(&enclosure.ID)
 
 	if err != nil {
@@ -93,15 +164,85 @@ func (s *Storage) IsEnclosureExists(enclosure *model.Enclosure) bool {
 	return result >= 1
 }
 
-// UpdateEnclosures add missing attachments while updating a feed.
+// UpdateEnclosures add missing attachments and updates the podcast elements of existing ones while updating a feed.
 func (s *Storage) UpdateEnclosures(enclosures model.EnclosureList) error {
 	for _, enclosure := range enclosures {
-		if !s.IsEnclosureExists(enclosure) {
-			err := s.CreateEnclosure(enclosure)
-			if err != nil {
-				return err
-			}
+		var err error
+		if s.IsEnclosureExists(enclosure) {
+			err = s.updateEnclosurePodcastElements(enclosure)
+		} else {
+			err = s.CreateEnclosure(enclosure)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateEnclosurePodcastElements updates the chapters URL and the transcripts of an attachment,
+// the downloaded chapters are kept unless the chapters URL has changed.
+func (s *Storage) updateEnclosurePodcastElements(enclosure *model.Enclosure) error {
+	query := `
+		UPDATE
+			enclosures
+		SET
+			chapters=(CASE WHEN chapters_url=$1 THEN chapters ELSE NULL END),
+			chapters_url=$1,
+			transcripts=$2
+		WHERE
+			user_id=$3 AND entry_id=$4 AND url=$5
+	`
+	_, err := s.db.Exec(query, enclosure.ChaptersURL, enclosure.Transcripts, enclosure.UserID, enclosure.EntryID, enclosure.URL)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update enclosure %q: %v`, enclosure.URL, err)
+	}
+
+	return nil
+}
+
+// EnclosuresWithoutChapters returns the most recent attachments having a chapters file that is not downloaded yet.
+func (s *Storage) EnclosuresWithoutChapters(limit int) (model.EnclosureList, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			url,
+			chapters_url
+		FROM
+			enclosures
+		WHERE
+			chapters_url <> '' AND chapters IS NULL
+		ORDER BY id DESC
+		LIMIT $1
+	`
+	rows, err := s.db.Query(query, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch enclosures without chapters: %v`, err)
+	}
+	defer rows.Close()
+
+	enclosures := make(model.EnclosureList, 0)
+	for rows.Next() {
+		var enclosure model.Enclosure
+		if err := rows.Scan(&enclosure.ID, &enclosure.UserID, &enclosure.EntryID, &enclosure.URL, &enclosure.ChaptersURL); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch enclosure row: %v`, err)
 		}
+
+		enclosures = append(enclosures, &enclosure)
+	}
+
+	return enclosures, nil
+}
+
+// UpdateEnclosureChapters saves the downloaded chapters of an attachment.
+func (s *Storage) UpdateEnclosureChapters(enclosureID int64, chapters model.PodcastChapters) error {
+	query := `UPDATE enclosures SET chapters=$1 WHERE id=$2`
+	if _, err := s.db.Exec(query, chapters, enclosureID); err != nil {
+		return fmt.Errorf(`store: unable to update chapters of enclosure #%d: %v`, enclosureID, err)
 	}
 
 	return nil
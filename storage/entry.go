@@ -5,10 +5,12 @@
 package storage // import "miniflux.app/storage"
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"time"
 
+	"miniflux.app/crypto"
 	"miniflux.app/logger"
 	"miniflux.app/model"
 
@@ -34,9 +36,14 @@ func (s *Storage) NewEntryQueryBuilder(userID int64) *EntryQueryBuilder {
 	return NewEntryQueryBuilder(s, userID)
 }
 
+// NewAnonymousQueryBuilder returns a new EntryQueryBuilder suitable for anonymous users.
+func (s *Storage) NewAnonymousQueryBuilder() *EntryQueryBuilder {
+	return NewAnonymousQueryBuilder(s)
+}
+
 // UpdateEntryContent updates entry content.
 func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
-	tx, err := s.db.Begin()
+	tx, err := s.begin()
 	if err != nil {
 		return err
 	}
@@ -59,7 +66,7 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 		UPDATE
 			entries
 		SET
-			document_vectors = setweight(to_tsvector(substring(coalesce(title, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce(content, '') for 1000000)), 'B')
+			document_vectors = ` + documentVectorsExpression("title", "content", "feed_id", "entries.id") + `
 		WHERE
 			id=$1 AND user_id=$2
 	`
@@ -76,9 +83,9 @@ func (s *Storage) UpdateEntryContent(entry *model.Entry) error {
 func (s *Storage) createEntry(entry *model.Entry) error {
 	query := `
 		INSERT INTO entries
-			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, changed_at, document_vectors)
+			(title, hash, url, comments_url, published_at, content, author, user_id, feed_id, podcast, tags, changed_at, document_vectors)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B'))
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, coalesce($11, '{}'::text[]), now(), ` + documentVectorsExpression("$1", "$6", "$9", "") + `)
 		RETURNING
 			id, status
 	`
@@ -93,6 +100,8 @@ func (s *Storage) createEntry(entry *model.Entry) error {
 		entry.Author,
 		entry.UserID,
 		entry.FeedID,
+		entry.Podcast,
+		pq.Array(entry.Tags),
 	).Scan(&entry.ID, &entry.Status)
 
 	if err != nil {
@@ -124,7 +133,9 @@ func (s *Storage) updateEntry(entry *model.Entry) error {
 			comments_url=$3,
 			content=$4,
 			author=$5,
-			document_vectors = setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($4, '') for 1000000)), 'B')
+			podcast=$9,
+			tags=coalesce($10, '{}'::text[]),
+			document_vectors = ` + documentVectorsExpression("$1", "$4", "$7", "entries.id") + `
 		WHERE
 			user_id=$6 AND feed_id=$7 AND hash=$8
 		RETURNING
@@ -140,6 +151,8 @@ func (s *Storage) updateEntry(entry *model.Entry) error {
 		entry.UserID,
 		entry.FeedID,
 		entry.Hash,
+		entry.Podcast,
+		pq.Array(entry.Tags),
 	).Scan(&entry.ID)
 
 	if err != nil {
@@ -162,7 +175,104 @@ func (s *Storage) entryExists(entry *model.Entry) bool {
 	return result == 1
 }
 
+// RestoreEntry creates an entry from a backup, or updates the status of the entry if it already exists.
+func (s *Storage) RestoreEntry(entry *model.Entry) (created bool, err error) {
+	status, starred := entry.Status, entry.Starred
+	if !s.entryExists(entry) {
+		if err := s.createEntry(entry); err != nil {
+			return false, err
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			if enclosure.ID == 0 || (enclosure.MediaProgression == 0 && !enclosure.Played) {
+				continue
+			}
+
+			if err := s.UpdateEnclosureProgression(enclosure); err != nil {
+				return true, err
+			}
+		}
+
+		created = true
+	}
+
+	query := `UPDATE entries SET status=$1, starred=$2 WHERE user_id=$3 AND feed_id=$4 AND hash=$5 RETURNING id`
+	if err := s.db.QueryRow(query, status, starred, entry.UserID, entry.FeedID, entry.Hash).Scan(&entry.ID); err != nil {
+		return created, fmt.Errorf(`store: unable to restore entry %q: %v`, entry.Hash, err)
+	}
+
+	entry.Status, entry.Starred = status, starred
+	return created, nil
+}
+
+// ImportEntry creates an entry imported from another feed reader, or updates the status of the entry
+// having the same checksum or the same URL in the feed.
+// Entries created by an import are flagged as imported: they are kept by the cleanup jobs and
+// replaced by the entry of the same URL found by the next refresh of the feed.
+func (s *Storage) ImportEntry(entry *model.Entry) (created bool, err error) {
+	var entryID int64
+	query := `
+		SELECT
+			id
+		FROM entries
+		WHERE
+			user_id=$1 AND feed_id=$2 AND (hash=$3 OR ($4 <> '' AND url=$4))
+		ORDER BY
+			hash=$3 DESC
+		LIMIT 1
+	`
+	err = s.db.QueryRow(query, entry.UserID, entry.FeedID, entry.Hash, entry.URL).Scan(&entryID)
+	switch {
+	case err == sql.ErrNoRows:
+		status, starred := entry.Status, entry.Starred
+		if err := s.createEntry(entry); err != nil {
+			return false, err
+		}
+
+		entry.Status, entry.Starred = status, starred
+		entryID, created = entry.ID, true
+	case err != nil:
+		return false, fmt.Errorf(`store: unable to find imported entry %q: %v`, entry.URL, err)
+	default:
+		entry.ID = entryID
+	}
+
+	query = `UPDATE entries SET status=$1, starred=$2, imported=imported OR $3 WHERE id=$4`
+	if _, err := s.db.Exec(query, entry.Status, entry.Starred, created, entryID); err != nil {
+		return created, fmt.Errorf(`store: unable to import entry %q: %v`, entry.URL, err)
+	}
+
+	return created, nil
+}
+
+// adoptImportedEntry gives the checksum of a new entry found while refreshing a feed to the imported entry
+// of the same URL, to avoid duplicating the articles imported from another feed reader.
+// Imported entries are identified by the checksum of their URL.
+func (s *Storage) adoptImportedEntry(entry *model.Entry) bool {
+	if entry.URL == "" {
+		return false
+	}
+
+	query := `
+		UPDATE
+			entries
+		SET
+			hash=$1
+		WHERE
+			id=(SELECT id FROM entries WHERE user_id=$2 AND feed_id=$3 AND imported AND hash=$4 LIMIT 1)
+	`
+	result, err := s.db.Exec(query, entry.Hash, entry.UserID, entry.FeedID, crypto.Hash(entry.URL))
+	if err != nil {
+		logger.Error(`store: unable to update imported entry %q: %v`, entry.URL, err)
+		return false
+	}
+
+	count, _ := result.RowsAffected()
+	return count == 1
+}
+
 // cleanupEntries deletes from the database entries marked as "removed" and not visible anymore in the feed.
+// Entries with a note or highlights are kept.
 func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 	query := `
 		DELETE FROM
@@ -170,7 +280,7 @@ func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 		WHERE
 			feed_id=$1
 		AND
-			id IN (SELECT id FROM entries WHERE feed_id=$2 AND status=$3 AND NOT (hash=ANY($4)))
+			id IN (SELECT id FROM entries WHERE feed_id=$2 AND status=$3 AND NOT (hash=ANY($4)) AND ` + annotatedEntryCondition + `)
 	`
 	if _, err := s.db.Exec(query, feedID, feedID, model.EntryStatusRemoved, pq.Array(entryHashes)); err != nil {
 		return fmt.Errorf(`store: unable to cleanup entries: %v`, err)
@@ -179,23 +289,26 @@ func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 	return nil
 }
 
-// UpdateEntries updates a list of entries while refreshing a feed.
-func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (err error) {
+// UpdateEntries updates a list of entries while refreshing a feed and returns the newly created entries.
+func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, updateExistingEntries bool) (newEntries model.Entries, err error) {
 	var entryHashes []string
 	for _, entry := range entries {
 		entry.UserID = userID
 		entry.FeedID = feedID
 
-		if s.entryExists(entry) {
+		if s.entryExists(entry) || s.adoptImportedEntry(entry) {
 			if updateExistingEntries {
 				err = s.updateEntry(entry)
 			}
 		} else {
 			err = s.createEntry(entry)
+			if err == nil {
+				newEntries = append(newEntries, entry)
+			}
 		}
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		entryHashes = append(entryHashes, entry.Hash)
@@ -205,34 +318,60 @@ func (s *Storage) UpdateEntries(userID, feedID int64, entries model.Entries, upd
 		logger.Error(`store: feed #%d: %v`, feedID, err)
 	}
 
-	return nil
+	return newEntries, nil
 }
 
-// ArchiveEntries changes the status of read items to "removed" after specified days.
-func (s *Storage) ArchiveEntries(days int) error {
-	if days < 0 {
-		return nil
-	}
-
+// ArchiveEntries changes the status of read items to "removed" after the number of days defined by
+// the retention policy of their feed or category, otherwise after the specified days.
+// Starred, shared, snoozed, annotated and imported entries are never archived.
+func (s *Storage) ArchiveEntries(days int) (int64, error) {
+	archiveReadDays := retentionSetting("archive_read_days", "$2::int")
 	query := `
 		UPDATE
 			entries
 		SET
-			status='removed'
+			status=$1
 		WHERE
-			id=ANY(SELECT id FROM entries WHERE status='read' AND starred is false AND published_at < now () - '%d days'::interval LIMIT 5000)
+			id=ANY(
+				SELECT
+					entries.id
+				FROM entries
+				JOIN feeds f ON f.id=entries.feed_id
+				JOIN categories c ON c.id=f.category_id
+				WHERE
+					entries.status=$3
+				AND
+					` + archiveReadDays + ` >= 0
+				AND
+					entries.published_at < now() - (` + archiveReadDays + ` * interval '1 day')
+				AND
+					` + importedEntryCondition("archive_read_days") + `
+				AND
+					` + removableEntryCondition + `
+				LIMIT $4
+			)
 	`
-	if _, err := s.db.Exec(fmt.Sprintf(query, days)); err != nil {
-		return fmt.Errorf(`store: unable to archive read entries: %v`, err)
+	count, err := s.updateEntriesInBatches(query, model.EntryStatusRemoved, days, model.EntryStatusRead, cleanupBatchSize)
+	if err != nil {
+		return count, fmt.Errorf(`store: unable to archive read entries: %v`, err)
 	}
 
-	return nil
+	return count, nil
 }
 
-// SetEntriesStatus update the status of the given list of entries.
+// SetEntriesStatus update the status of the given list of entries, the snooze of entries marked as read is cancelled.
 func (s *Storage) SetEntriesStatus(userID int64, entryIDs []int64, status string) error {
-	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND id=ANY($3)`
-	result, err := s.db.Exec(query, status, userID, pq.Array(entryIDs))
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			snoozed_until=(CASE WHEN $4 THEN snoozed_until ELSE NULL END),
+			changed_at=now()
+		WHERE
+			user_id=$2 AND id=ANY($3)
+	`
+	result, err := s.db.Exec(query, status, userID, pq.Array(entryIDs), status == model.EntryStatusUnread)
 	if err != nil {
 		return fmt.Errorf(`store: unable to update entries statuses %v: %v`, entryIDs, err)
 	}
@@ -280,9 +419,9 @@ func (s *Storage) FlushHistory(userID int64) error {
 	return nil
 }
 
-// MarkAllAsRead updates all user entries to the read status.
+// MarkAllAsRead updates all user entries to the read status, snoozed entries are left unread.
 func (s *Storage) MarkAllAsRead(userID int64) error {
-	query := `UPDATE entries SET status=$1, changed_at=now() WHERE user_id=$2 AND status=$3`
+	query := `UPDATE entries SET status=$1, snoozed_until=NULL, changed_at=now() WHERE user_id=$2 AND status=$3 AND (snoozed_until IS NULL OR snoozed_until <= now())`
 	result, err := s.db.Exec(query, model.EntryStatusRead, userID, model.EntryStatusUnread)
 	if err != nil {
 		return fmt.Errorf(`store: unable to mark all entries as read: %v`, err)
@@ -301,9 +440,10 @@ func (s *Storage) MarkFeedAsRead(userID, feedID int64, before time.Time) error {
 			entries
 		SET
 			status=$1,
+			snoozed_until=NULL,
 			changed_at=now()
 		WHERE
-			user_id=$2 AND feed_id=$3 AND status=$4 AND published_at < $5
+			user_id=$2 AND feed_id=$3 AND status=$4 AND published_at < $5 AND (snoozed_until IS NULL OR snoozed_until <= now())
 	`
 	result, err := s.db.Exec(query, model.EntryStatusRead, userID, feedID, model.EntryStatusUnread, before)
 	if err != nil {
@@ -323,6 +463,7 @@ func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time)
 			entries
 		SET
 			status=$1,
+			snoozed_until=NULL,
 			changed_at=now()
 		WHERE
 			user_id=$2
@@ -330,6 +471,8 @@ func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time)
 			status=$3
 		AND
 			published_at < $4
+		AND
+			(snoozed_until IS NULL OR snoozed_until <= now())
 		AND
 			feed_id IN (SELECT id FROM feeds WHERE user_id=$2 AND category_id=$5)
 	`
@@ -344,6 +487,36 @@ func (s *Storage) MarkCategoryAsRead(userID, categoryID int64, before time.Time)
 	return nil
 }
 
+// EntryShareCode returns the share code of the given entry and generates one if the entry is not shared yet.
+func (s *Storage) EntryShareCode(userID int64, entryID int64) (shareCode string, err error) {
+	query := `SELECT share_code FROM entries WHERE user_id=$1 AND id=$2`
+	err = s.db.QueryRow(query, userID, entryID).Scan(&shareCode)
+	if err != nil {
+		return "", fmt.Errorf(`store: unable to get share code for entry #%d: %v`, entryID, err)
+	}
+
+	if shareCode == "" {
+		shareCode = crypto.GenerateRandomString(24)
+
+		query = `UPDATE entries SET share_code=$1 WHERE user_id=$2 AND id=$3`
+		if _, err = s.db.Exec(query, shareCode, userID, entryID); err != nil {
+			return "", fmt.Errorf(`store: unable to set share code for entry #%d: %v`, entryID, err)
+		}
+	}
+
+	return shareCode, nil
+}
+
+// UnshareEntry removes the share code of the given entry, the public link stops working.
+func (s *Storage) UnshareEntry(userID int64, entryID int64) error {
+	query := `UPDATE entries SET share_code='' WHERE user_id=$1 AND id=$2`
+	if _, err := s.db.Exec(query, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to remove share code for entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
+
 // EntryURLExists returns true if an entry with this URL already exists.
 func (s *Storage) EntryURLExists(feedID int64, entryURL string) bool {
 	var result bool
@@ -351,3 +524,11 @@ func (s *Storage) EntryURLExists(feedID int64, entryURL string) bool {
 	s.db.QueryRow(query, feedID, entryURL).Scan(&result)
 	return result
 }
+
+// EntryExists returns true if the entry belongs to the user.
+func (s *Storage) EntryExists(userID, entryID int64) bool {
+	var result bool
+	query := `SELECT true FROM entries WHERE user_id=$1 AND id=$2`
+	s.db.QueryRow(query, userID, entryID).Scan(&result)
+	return result
+}
@@ -0,0 +1,278 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// EntryNote returns the note of an entry, or nil when the entry has no note.
+func (s *Storage) EntryNote(userID, entryID int64) (*model.EntryNote, error) {
+	query := `
+		SELECT
+			entry_id,
+			user_id,
+			content,
+			created_at,
+			changed_at
+		FROM
+			entry_notes
+		WHERE
+			user_id=$1 AND entry_id=$2
+	`
+
+	var note model.EntryNote
+	err := s.db.QueryRow(query, userID, entryID).Scan(
+		&note.EntryID,
+		&note.UserID,
+		&note.Content,
+		&note.CreatedAt,
+		&note.ChangedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch the note of entry #%d: %v`, entryID, err)
+	}
+
+	return &note, nil
+}
+
+// UserEntryNotes returns the notes of all the entries of a user, indexed by entry ID.
+func (s *Storage) UserEntryNotes(userID int64) (map[int64]*model.EntryNote, error) {
+	query := `
+		SELECT
+			entry_id,
+			user_id,
+			content,
+			created_at,
+			changed_at
+		FROM
+			entry_notes
+		WHERE
+			user_id=$1
+	`
+
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch the notes of user #%d: %v`, userID, err)
+	}
+	defer rows.Close()
+
+	notes := make(map[int64]*model.EntryNote)
+	for rows.Next() {
+		var note model.EntryNote
+		if err := rows.Scan(&note.EntryID, &note.UserID, &note.Content, &note.CreatedAt, &note.ChangedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch note row: %v`, err)
+		}
+
+		notes[note.EntryID] = &note
+	}
+
+	return notes, nil
+}
+
+// SaveEntryNote creates or updates the note of an entry, an empty note is removed.
+func (s *Storage) SaveEntryNote(note *model.EntryNote) error {
+	if note.Content == "" {
+		return s.RemoveEntryNote(note.UserID, note.EntryID)
+	}
+
+	query := `
+		INSERT INTO entry_notes
+			(entry_id, user_id, content)
+		SELECT
+			id, user_id, $3
+		FROM
+			entries
+		WHERE
+			id=$1 AND user_id=$2
+		ON CONFLICT (entry_id) DO UPDATE SET
+			content=EXCLUDED.content,
+			changed_at=now()
+		RETURNING
+			created_at, changed_at
+	`
+	err := s.db.QueryRow(query, note.EntryID, note.UserID, note.Content).Scan(&note.CreatedAt, &note.ChangedAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return fmt.Errorf(`store: entry #%d not found`, note.EntryID)
+	case err != nil:
+		return fmt.Errorf(`store: unable to save the note of entry #%d: %v`, note.EntryID, err)
+	}
+
+	return s.indexEntryAnnotations(note.EntryID)
+}
+
+// RemoveEntryNote deletes the note of an entry.
+func (s *Storage) RemoveEntryNote(userID, entryID int64) error {
+	result, err := s.db.Exec(`DELETE FROM entry_notes WHERE user_id=$1 AND entry_id=$2`, userID, entryID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove the note of entry #%d: %v`, entryID, err)
+	}
+
+	if count, _ := result.RowsAffected(); count == 0 {
+		return nil
+	}
+
+	return s.indexEntryAnnotations(entryID)
+}
+
+// EntryHighlights returns the highlights of an entry.
+func (s *Storage) EntryHighlights(userID, entryID int64) (model.EntryHighlights, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			text,
+			created_at
+		FROM
+			entry_highlights
+		WHERE
+			user_id=$1 AND entry_id=$2
+		ORDER BY id ASC
+	`
+
+	rows, err := s.db.Query(query, userID, entryID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch the highlights of entry #%d: %v`, entryID, err)
+	}
+	defer rows.Close()
+
+	highlights := make(model.EntryHighlights, 0)
+	for rows.Next() {
+		var highlight model.EntryHighlight
+		err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Text,
+			&highlight.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+
+		highlights = append(highlights, &highlight)
+	}
+
+	return highlights, nil
+}
+
+// UserEntryHighlights returns the highlights of all the entries of a user, indexed by entry ID.
+func (s *Storage) UserEntryHighlights(userID int64) (map[int64]model.EntryHighlights, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			text,
+			created_at
+		FROM
+			entry_highlights
+		WHERE
+			user_id=$1
+		ORDER BY id ASC
+	`
+
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch the highlights of user #%d: %v`, userID, err)
+	}
+	defer rows.Close()
+
+	highlights := make(map[int64]model.EntryHighlights)
+	for rows.Next() {
+		var highlight model.EntryHighlight
+		err := rows.Scan(
+			&highlight.ID,
+			&highlight.UserID,
+			&highlight.EntryID,
+			&highlight.Text,
+			&highlight.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch highlight row: %v`, err)
+		}
+
+		highlights[highlight.EntryID] = append(highlights[highlight.EntryID], &highlight)
+	}
+
+	return highlights, nil
+}
+
+// CreateEntryHighlight adds a highlight to an entry.
+func (s *Storage) CreateEntryHighlight(highlight *model.EntryHighlight) error {
+	query := `
+		INSERT INTO entry_highlights
+			(user_id, entry_id, text)
+		SELECT
+			user_id, id, $3
+		FROM
+			entries
+		WHERE
+			id=$1 AND user_id=$2
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(query, highlight.EntryID, highlight.UserID, highlight.Text).Scan(&highlight.ID, &highlight.CreatedAt)
+	switch {
+	case err == sql.ErrNoRows:
+		return fmt.Errorf(`store: entry #%d not found`, highlight.EntryID)
+	case err != nil:
+		return fmt.Errorf(`store: unable to create highlight: %v`, err)
+	}
+
+	return s.indexEntryAnnotations(highlight.EntryID)
+}
+
+// RemoveEntryHighlight deletes a highlight.
+func (s *Storage) RemoveEntryHighlight(userID, entryID, highlightID int64) error {
+	result, err := s.db.Exec(
+		`DELETE FROM entry_highlights WHERE id=$1 AND user_id=$2 AND entry_id=$3`,
+		highlightID,
+		userID,
+		entryID,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this highlight: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove this highlight: %v`, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no highlight has been removed`)
+	}
+
+	return s.indexEntryAnnotations(entryID)
+}
+
+// indexEntryAnnotations updates the full-text index of an entry after a change of its note or highlights.
+func (s *Storage) indexEntryAnnotations(entryID int64) error {
+	query := `UPDATE entries SET document_vectors = ` + documentVectorsExpression("title", "content", "feed_id", "entries.id") + ` WHERE id=$1`
+	if _, err := s.db.Exec(query, entryID); err != nil {
+		return fmt.Errorf(`store: unable to index the annotations of entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
+
+// annotatedEntryCondition excludes the entries having a note or highlights, they are kept by the cleanup jobs.
+const annotatedEntryCondition = `
+	NOT EXISTS (SELECT 1 FROM entry_notes WHERE entry_notes.entry_id=entries.id)
+	AND NOT EXISTS (SELECT 1 FROM entry_highlights WHERE entry_highlights.entry_id=entries.id)
+`
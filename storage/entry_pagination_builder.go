@@ -11,26 +11,37 @@ import (
 	"time"
 
 	"miniflux.app/model"
+	"miniflux.app/search"
 	"miniflux.app/timer"
 )
 
 // EntryPaginationBuilder is a builder for entry prev/next queries.
 type EntryPaginationBuilder struct {
 	store      *Storage
+	userID     int64
 	conditions []string
 	args       []interface{}
 	entryID    int64
 	direction  string
+	order      string
 }
 
-// WithSearchQuery adds full-text search query to the condition.
-func (e *EntryPaginationBuilder) WithSearchQuery(query string) {
-	if query != "" {
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", len(e.args)+1))
-		e.args = append(e.args, query)
+// WithSearchQuery adds the conditions of a search query.
+func (e *EntryPaginationBuilder) WithSearchQuery(query *search.Query) {
+	if query != nil {
+		conditions, args, _ := searchConditions(query, e.args, e.store.textSearchConfigurations(e.userID))
+		e.conditions = append(e.conditions, conditions...)
+		e.args = args
 	}
 }
 
+// WithSavedSearch adds the conditions of a saved search.
+func (e *EntryPaginationBuilder) WithSavedSearch(savedSearch *model.SavedSearch, query *search.Query) {
+	e.WithSearchQuery(query)
+	e.WithStatus(savedSearch.Status)
+	e.WithCategoryID(savedSearch.CategoryID)
+}
+
 // WithStarred adds starred to the condition.
 func (e *EntryPaginationBuilder) WithStarred() {
 	e.conditions = append(e.conditions, "e.starred is true")
@@ -57,12 +68,17 @@ func (e *EntryPaginationBuilder) WithStatus(status string) {
 	if status != "" {
 		e.conditions = append(e.conditions, fmt.Sprintf("e.status = $%d", len(e.args)+1))
 		e.args = append(e.args, status)
+
+		if status == model.EntryStatusUnread {
+			e.conditions = append(e.conditions, notSnoozedCondition)
+			e.order = unreadSortingOrder
+		}
 	}
 }
 
 // Entries returns previous and next entries.
 func (e *EntryPaginationBuilder) Entries() (*model.Entry, *model.Entry, error) {
-	tx, err := e.store.db.Begin()
+	tx, err := e.store.begin()
 	if err != nil {
 		return nil, nil, fmt.Errorf("begin transaction for entry pagination: %v", err)
 	}
@@ -94,26 +110,26 @@ func (e *EntryPaginationBuilder) Entries() (*model.Entry, *model.Entry, error) {
 	return prevEntry, nextEntry, nil
 }
 
-func (e *EntryPaginationBuilder) getPrevNextID(tx *sql.Tx) (prevID int64, nextID int64, err error) {
+func (e *EntryPaginationBuilder) getPrevNextID(tx transaction) (prevID int64, nextID int64, err error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[EntryPaginationBuilder] %v, %v", e.conditions, e.args))
 
 	cte := `
 		WITH entry_pagination AS (
 			SELECT
 				e.id,
-				lag(e.id) over (order by e.published_at asc, e.id desc) as prev_id,
-				lead(e.id) over (order by e.published_at asc, e.id desc) as next_id
+				lag(e.id) over (order by %[1]s asc, e.id desc) as prev_id,
+				lead(e.id) over (order by %[1]s asc, e.id desc) as next_id
 			FROM entries AS e
 			LEFT JOIN feeds AS f ON f.id=e.feed_id
-			WHERE %s
-			ORDER BY e.published_at asc, e.id desc
+			WHERE %[2]s
+			ORDER BY %[1]s asc, e.id desc
 		)
-		SELECT prev_id, next_id FROM entry_pagination AS ep WHERE %s;
+		SELECT prev_id, next_id FROM entry_pagination AS ep WHERE %[3]s;
 	`
 
 	subCondition := strings.Join(e.conditions, " AND ")
 	finalCondition := fmt.Sprintf("ep.id = $%d", len(e.args)+1)
-	query := fmt.Sprintf(cte, subCondition, finalCondition)
+	query := fmt.Sprintf(cte, e.order, subCondition, finalCondition)
 	e.args = append(e.args, e.entryID)
 
 	var pID, nID sql.NullInt64
@@ -136,7 +152,7 @@ func (e *EntryPaginationBuilder) getPrevNextID(tx *sql.Tx) (prevID int64, nextID
 	return prevID, nextID, nil
 }
 
-func (e *EntryPaginationBuilder) getEntry(tx *sql.Tx, entryID int64) (*model.Entry, error) {
+func (e *EntryPaginationBuilder) getEntry(tx transaction, entryID int64) (*model.Entry, error) {
 	var entry model.Entry
 
 	err := tx.QueryRow(`SELECT id, title FROM entries WHERE id = $1`, entryID).Scan(
@@ -158,9 +174,11 @@ func (e *EntryPaginationBuilder) getEntry(tx *sql.Tx, entryID int64) (*model.Ent
 func NewEntryPaginationBuilder(store *Storage, userID, entryID int64, direction string) *EntryPaginationBuilder {
 	return &EntryPaginationBuilder{
 		store:      store,
+		userID:     userID,
 		args:       []interface{}{userID, "removed"},
 		conditions: []string{"e.user_id = $1", "e.status <> $2"},
 		entryID:    entryID,
 		direction:  direction,
+		order:      "e.published_at",
 	}
 }
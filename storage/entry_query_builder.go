@@ -12,32 +12,54 @@ import (
 	"github.com/lib/pq"
 
 	"miniflux.app/model"
+	"miniflux.app/search"
 	"miniflux.app/timezone"
 )
 
 // EntryQueryBuilder builds a SQL query to fetch entries.
 type EntryQueryBuilder struct {
 	store      *Storage
+	userID     int64
 	args       []interface{}
 	conditions []string
 	order      string
 	direction  string
 	limit      int
 	offset     int
+	unread     bool
 }
 
-// WithSearchQuery adds full-text search query to the condition.
-func (e *EntryQueryBuilder) WithSearchQuery(query string) *EntryQueryBuilder {
-	if query != "" {
-		e.conditions = append(e.conditions, fmt.Sprintf("e.document_vectors @@ plainto_tsquery($%d)", len(e.args)+1))
-		e.args = append(e.args, query)
+// WithSearchQuery adds the conditions of a search query, results are ordered by relevance when the query contains search terms.
+func (e *EntryQueryBuilder) WithSearchQuery(query *search.Query) *EntryQueryBuilder {
+	if query == nil || query.IsEmpty() {
+		return e
 	}
+
+	conditions, args, tsquery := searchConditions(query, e.args, e.store.textSearchConfigurations(e.userID))
+	e.conditions = append(e.conditions, conditions...)
+	e.args = args
+
 	// ordered by relevance, can be overrode
-	e.WithOrder(fmt.Sprintf("ts_rank(document_vectors, plainto_tsquery('%s'))", query))
+	if tsquery != "" {
+		e.WithOrder(fmt.Sprintf("ts_rank(e.document_vectors, %s)", tsquery))
+	} else {
+		e.WithOrder("e.published_at")
+	}
 	e.WithDirection("DESC")
 	return e
 }
 
+// WithSavedSearch adds the conditions of a saved search, the sorting order is not changed.
+func (e *EntryQueryBuilder) WithSavedSearch(savedSearch *model.SavedSearch, query *search.Query) *EntryQueryBuilder {
+	conditions, args, _ := searchConditions(query, e.args, e.store.textSearchConfigurations(e.userID))
+	e.conditions = append(e.conditions, conditions...)
+	e.args = args
+
+	e.WithStatus(savedSearch.Status)
+	e.WithCategoryID(savedSearch.CategoryID)
+	return e
+}
+
 // WithStarred adds starred filter.
 func (e *EntryQueryBuilder) WithStarred() *EntryQueryBuilder {
 	e.conditions = append(e.conditions, "e.starred is true")
@@ -110,15 +132,55 @@ func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder
 	return e
 }
 
+// WithCategoryIDs adds a condition to fetch only the entries of the given categories.
+func (e *EntryQueryBuilder) WithCategoryIDs(categoryIDs []int64) *EntryQueryBuilder {
+	if len(categoryIDs) > 0 {
+		e.conditions = append(e.conditions, fmt.Sprintf("f.category_id = ANY($%d)", len(e.args)+1))
+		e.args = append(e.args, pq.Array(categoryIDs))
+	}
+	return e
+}
+
+// WithTag adds a condition to fetch only the entries with the given tag.
+func (e *EntryQueryBuilder) WithTag(tag string) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("$%d = ANY(e.tags)", len(e.args)+1))
+	e.args = append(e.args, tag)
+	return e
+}
+
+// WithShareCode adds a condition to fetch only the entry shared with the given code.
+func (e *EntryQueryBuilder) WithShareCode(shareCode string) *EntryQueryBuilder {
+	e.conditions = append(e.conditions, fmt.Sprintf("e.share_code = $%d", len(e.args)+1))
+	e.args = append(e.args, shareCode)
+	return e
+}
+
+// WithShareCodeNotEmpty adds a condition to fetch only shared entries.
+func (e *EntryQueryBuilder) WithShareCodeNotEmpty() *EntryQueryBuilder {
+	e.conditions = append(e.conditions, "e.share_code <> ''")
+	return e
+}
+
 // WithStatus set the entry status.
 func (e *EntryQueryBuilder) WithStatus(status string) *EntryQueryBuilder {
 	if status != "" {
 		e.conditions = append(e.conditions, fmt.Sprintf("e.status = $%d", len(e.args)+1))
 		e.args = append(e.args, status)
+
+		if status == model.EntryStatusUnread {
+			e.conditions = append(e.conditions, notSnoozedCondition)
+			e.unread = true
+		}
 	}
 	return e
 }
 
+// WithSnoozed adds a condition to fetch only the snoozed entries.
+func (e *EntryQueryBuilder) WithSnoozed() *EntryQueryBuilder {
+	e.conditions = append(e.conditions, "e.snoozed_until > now()")
+	return e
+}
+
 // WithoutStatus set the entry status that should not be returned.
 func (e *EntryQueryBuilder) WithoutStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -182,6 +244,16 @@ func (e *EntryQueryBuilder) GetEntry() (*model.Entry, error) {
 		return nil, err
 	}
 
+	entries[0].Note, err = e.store.EntryNote(entries[0].UserID, entries[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries[0].Highlights, err = e.store.EntryHighlights(entries[0].UserID, entries[0].ID)
+	if err != nil {
+		return nil, err
+	}
+
 	return entries[0], nil
 }
 
@@ -190,8 +262,9 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 	query := `
 		SELECT
 		e.id, e.user_id, e.feed_id, e.hash, e.published_at at time zone u.timezone, e.title,
-		e.url, e.comments_url, e.author, e.content, e.status, e.starred,
-		f.title as feed_title, f.feed_url, f.site_url, f.checked_at,
+		e.url, e.comments_url, e.author, e.content, e.status, e.starred, e.share_code, e.podcast,
+		e.tags, e.snoozed_until at time zone u.timezone,
+		f.title as feed_title, f.feed_url, f.site_url, f.checked_at, f.podcast,
 		f.category_id, c.title as category_title, f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
 		fi.icon_id,
 		u.timezone
@@ -236,10 +309,15 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Content,
 			&entry.Status,
 			&entry.Starred,
+			&entry.ShareCode,
+			&entry.Podcast,
+			(*pq.StringArray)(&entry.Tags),
+			&entry.SnoozedUntil,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
 			&entry.Feed.SiteURL,
 			&entry.Feed.CheckedAt,
+			&entry.Feed.Podcast,
 			&entry.Feed.Category.ID,
 			&entry.Feed.Category.Title,
 			&entry.Feed.ScraperRules,
@@ -263,6 +341,10 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		// Make sure that timestamp fields contains timezone information (API)
 		entry.Date = timezone.Convert(tz, entry.Date)
 		entry.Feed.CheckedAt = timezone.Convert(tz, entry.Feed.CheckedAt)
+		if entry.SnoozedUntil != nil {
+			snoozedUntil := timezone.Convert(tz, *entry.SnoozedUntil)
+			entry.SnoozedUntil = &snoozedUntil
+		}
 
 		entry.Feed.ID = entry.FeedID
 		entry.Feed.UserID = entry.UserID
@@ -302,6 +384,31 @@ func (e *EntryQueryBuilder) GetEntryIDs() ([]int64, error) {
 	return entryIDs, nil
 }
 
+// GetFeedIDs returns the distinct feed IDs of the entries matching the conditions.
+func (e *EntryQueryBuilder) GetFeedIDs() ([]int64, error) {
+	query := `SELECT DISTINCT e.feed_id FROM entries e LEFT JOIN feeds f ON f.id=e.feed_id WHERE %s`
+	query = fmt.Sprintf(query, e.buildCondition())
+
+	rows, err := e.store.db.Query(query, e.args...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get feed IDs: %v", err)
+	}
+	defer rows.Close()
+
+	var feedIDs []int64
+	for rows.Next() {
+		var feedID int64
+
+		if err := rows.Scan(&feedID); err != nil {
+			return nil, fmt.Errorf("unable to fetch feed ID row: %v", err)
+		}
+
+		feedIDs = append(feedIDs, feedID)
+	}
+
+	return feedIDs, nil
+}
+
 func (e *EntryQueryBuilder) buildCondition() string {
 	return strings.Join(e.conditions, " AND ")
 }
@@ -309,8 +416,13 @@ func (e *EntryQueryBuilder) buildCondition() string {
 func (e *EntryQueryBuilder) buildSorting() string {
 	var parts []string
 
-	if e.order != "" {
-		parts = append(parts, fmt.Sprintf(`ORDER BY %s`, e.order))
+	order := e.order
+	if e.unread && (order == model.DefaultSortingOrder || order == "e."+model.DefaultSortingOrder) {
+		order = unreadSortingOrder
+	}
+
+	if order != "" {
+		parts = append(parts, fmt.Sprintf(`ORDER BY %s`, order))
 	}
 
 	if e.direction != "" {
@@ -332,7 +444,16 @@ func (e *EntryQueryBuilder) buildSorting() string {
 func NewEntryQueryBuilder(store *Storage, userID int64) *EntryQueryBuilder {
 	return &EntryQueryBuilder{
 		store:      store,
+		userID:     userID,
 		args:       []interface{}{userID},
 		conditions: []string{"e.user_id = $1"},
 	}
 }
+
+// NewAnonymousQueryBuilder returns a new EntryQueryBuilder that is not restricted to a user.
+// It must be used only with conditions that identify public entries, like a share code.
+func NewAnonymousQueryBuilder(store *Storage) *EntryQueryBuilder {
+	return &EntryQueryBuilder{
+		store: store,
+	}
+}
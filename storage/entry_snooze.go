@@ -0,0 +1,72 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/model"
+)
+
+// notSnoozedCondition excludes the snoozed entries from the unread entries.
+const notSnoozedCondition = "(e.snoozed_until IS NULL OR e.snoozed_until <= now())"
+
+// unreadSortingOrder replaces the publication date to sort the unread entries,
+// the snoozed entries are sorted at the time they woke up.
+const unreadSortingOrder = "coalesce(e.woken_at, e.published_at)"
+
+// SnoozeEntries hides the given entries from the unread entries until the given time.
+func (s *Storage) SnoozeEntries(userID int64, entryIDs []int64, until time.Time) error {
+	query := `UPDATE entries SET snoozed_until=$1, changed_at=now() WHERE user_id=$2 AND id=ANY($3) AND status<>$4`
+	if _, err := s.db.Exec(query, until, userID, pq.Array(entryIDs), model.EntryStatusRemoved); err != nil {
+		return fmt.Errorf(`store: unable to snooze entries %v: %v`, entryIDs, err)
+	}
+
+	return nil
+}
+
+// UnsnoozeEntries cancels the snooze of the given entries.
+func (s *Storage) UnsnoozeEntries(userID int64, entryIDs []int64) error {
+	query := `UPDATE entries SET snoozed_until=NULL, changed_at=now() WHERE user_id=$1 AND id=ANY($2)`
+	if _, err := s.db.Exec(query, userID, pq.Array(entryIDs)); err != nil {
+		return fmt.Errorf(`store: unable to cancel the snooze of entries %v: %v`, entryIDs, err)
+	}
+
+	return nil
+}
+
+// CountSnoozedEntries returns the number of snoozed entries of a user.
+func (s *Storage) CountSnoozedEntries(userID int64) int {
+	var count int
+	query := `SELECT count(*) FROM entries WHERE user_id=$1 AND snoozed_until > now()`
+	s.db.QueryRow(query, userID).Scan(&count)
+	return count
+}
+
+// WakeSnoozedEntries marks the entries whose snooze has expired as unread, the wake time is recorded
+// to sort them with the new entries in the unread entries.
+func (s *Storage) WakeSnoozedEntries() (int64, error) {
+	query := `
+		UPDATE
+			entries
+		SET
+			status=(CASE WHEN status=$1 THEN status ELSE $2 END),
+			woken_at=(CASE WHEN status=$1 THEN woken_at ELSE now() END),
+			snoozed_until=NULL,
+			changed_at=now()
+		WHERE
+			snoozed_until <= now()
+	`
+	result, err := s.db.Exec(query, model.EntryStatusRemoved, model.EntryStatusUnread)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to wake snoozed entries: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	return count, nil
+}
@@ -71,9 +71,21 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 			f.rewrite_rules,
 			f.crawler,
 			f.user_agent,
+			f.item_selector,
+			f.title_selector,
+			f.link_selector,
+			f.date_selector,
+			f.content_selector,
+			f.podcast,
 			f.username,
 			f.password,
 			f.disabled,
+			f.notify,
+			f.language,
+			f.search_language,
+			f.archive_read_days,
+			f.max_entries,
+			f.mark_as_read_days,
 			f.category_id,
 			c.title as category_title,
 			fi.icon_id,
@@ -113,9 +125,21 @@ func (s *Storage) Feeds(userID int64) (model.Feeds, error) {
 			&feed.RewriteRules,
 			&feed.Crawler,
 			&feed.UserAgent,
+			&feed.ItemSelector,
+			&feed.TitleSelector,
+			&feed.LinkSelector,
+			&feed.DateSelector,
+			&feed.ContentSelector,
+			&feed.Podcast,
 			&feed.Username,
 			&feed.Password,
 			&feed.Disabled,
+			&feed.Notify,
+			&feed.Language,
+			&feed.SearchLanguage,
+			&feed.ArchiveReadDays,
+			&feed.MaxEntries,
+			&feed.MarkAsReadDays,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&iconID,
@@ -151,11 +175,13 @@ func (s *Storage) FeedsWithCounters(userID int64) (model.Feeds, error) {
 			f.checked_at at time zone u.timezone,
 			f.parsing_error_count, f.parsing_error_msg,
 			f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
-			f.username, f.password, f.disabled,
+			f.item_selector, f.title_selector, f.link_selector, f.date_selector, f.content_selector,
+			f.username, f.password, f.disabled, f.notify, f.language, f.search_language,
+			f.archive_read_days, f.max_entries, f.mark_as_read_days,
 			f.category_id, c.title as category_title,
 			fi.icon_id,
 			u.timezone,
-			(SELECT count(*) FROM entries WHERE entries.feed_id=f.id AND status='unread') as unread_count,
+			(SELECT count(*) FROM entries WHERE entries.feed_id=f.id AND status='unread' AND (snoozed_until IS NULL OR snoozed_until <= now())) as unread_count,
 			(SELECT count(*) FROM entries WHERE entries.feed_id=f.id AND status='read') as read_count
 		FROM feeds f
 		LEFT JOIN categories c ON c.id=f.category_id
@@ -182,11 +208,13 @@ func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.F
 			f.checked_at at time zone u.timezone,
 			f.parsing_error_count, f.parsing_error_msg,
 			f.scraper_rules, f.rewrite_rules, f.crawler, f.user_agent,
-			f.username, f.password, f.disabled,
+			f.item_selector, f.title_selector, f.link_selector, f.date_selector, f.content_selector,
+			f.username, f.password, f.disabled, f.notify, f.language, f.search_language,
+			f.archive_read_days, f.max_entries, f.mark_as_read_days,
 			f.category_id, c.title as category_title,
 			fi.icon_id,
 			u.timezone,
-			(SELECT count(*) FROM entries WHERE entries.feed_id=f.id AND status='unread') as unread_count,
+			(SELECT count(*) FROM entries WHERE entries.feed_id=f.id AND status='unread' AND (snoozed_until IS NULL OR snoozed_until <= now())) as unread_count,
 			(SELECT count(*) FROM entries WHERE entries.feed_id=f.id AND status='read') as read_count
 		FROM feeds f
 		LEFT JOIN categories c ON c.id=f.category_id
@@ -228,9 +256,20 @@ func (s *Storage) fetchFeedsWithCounters(query string, args ...interface{}) (mod
 			&feed.RewriteRules,
 			&feed.Crawler,
 			&feed.UserAgent,
+			&feed.ItemSelector,
+			&feed.TitleSelector,
+			&feed.LinkSelector,
+			&feed.DateSelector,
+			&feed.ContentSelector,
 			&feed.Username,
 			&feed.Password,
 			&feed.Disabled,
+			&feed.Notify,
+			&feed.Language,
+			&feed.SearchLanguage,
+			&feed.ArchiveReadDays,
+			&feed.MaxEntries,
+			&feed.MarkAsReadDays,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&iconID,
@@ -277,9 +316,21 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 			f.rewrite_rules,
 			f.crawler,
 			f.user_agent,
+			f.item_selector,
+			f.title_selector,
+			f.link_selector,
+			f.date_selector,
+			f.content_selector,
+			f.podcast,
 			f.username,
 			f.password,
 			f.disabled,
+			f.notify,
+			f.language,
+			f.search_language,
+			f.archive_read_days,
+			f.max_entries,
+			f.mark_as_read_days,
 			f.category_id,
 			c.title as category_title,
 			fi.icon_id,
@@ -307,9 +358,21 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 		&feed.RewriteRules,
 		&feed.Crawler,
 		&feed.UserAgent,
+		&feed.ItemSelector,
+		&feed.TitleSelector,
+		&feed.LinkSelector,
+		&feed.DateSelector,
+		&feed.ContentSelector,
+		&feed.Podcast,
 		&feed.Username,
 		&feed.Password,
 		&feed.Disabled,
+		&feed.Notify,
+		&feed.Language,
+		&feed.SearchLanguage,
+		&feed.ArchiveReadDays,
+		&feed.MaxEntries,
+		&feed.MarkAsReadDays,
 		&feed.Category.ID,
 		&feed.Category.Title,
 		&iconID,
@@ -348,10 +411,22 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			password,
 			disabled,
 			scraper_rules,
-			rewrite_rules
+			rewrite_rules,
+			item_selector,
+			title_selector,
+			link_selector,
+			date_selector,
+			content_selector,
+			podcast,
+			notify,
+			language,
+			search_language,
+			archive_read_days,
+			max_entries,
+			mark_as_read_days
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)
 		RETURNING
 			id
 	`
@@ -371,11 +446,27 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.Disabled,
 		feed.ScraperRules,
 		feed.RewriteRules,
+		feed.ItemSelector,
+		feed.TitleSelector,
+		feed.LinkSelector,
+		feed.DateSelector,
+		feed.ContentSelector,
+		feed.Podcast,
+		feed.Notify,
+		feed.Language,
+		feed.SearchLanguage,
+		feed.ArchiveReadDays,
+		feed.MaxEntries,
+		feed.MarkAsReadDays,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
 	}
 
+	if err := s.updateDocumentConfigurations(feed.UserID, feed.ID); err != nil {
+		return err
+	}
+
 	for i := 0; i < len(feed.Entries); i++ {
 		feed.Entries[i].FeedID = feed.ID
 		feed.Entries[i].UserID = feed.UserID
@@ -412,9 +503,21 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			user_agent=$13,
 			username=$14,
 			password=$15,
-			disabled=$16
+			disabled=$16,
+			notify=$17,
+			item_selector=$18,
+			title_selector=$19,
+			link_selector=$20,
+			date_selector=$21,
+			content_selector=$22,
+			podcast=$23,
+			language=$24,
+			search_language=$25,
+			archive_read_days=$26,
+			max_entries=$27,
+			mark_as_read_days=$28
 		WHERE
-			id=$17 AND user_id=$18
+			id=$29 AND user_id=$30
 	`
 	_, err = s.db.Exec(query,
 		feed.FeedURL,
@@ -433,6 +536,18 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.Username,
 		feed.Password,
 		feed.Disabled,
+		feed.Notify,
+		feed.ItemSelector,
+		feed.TitleSelector,
+		feed.LinkSelector,
+		feed.DateSelector,
+		feed.ContentSelector,
+		feed.Podcast,
+		feed.Language,
+		feed.SearchLanguage,
+		feed.ArchiveReadDays,
+		feed.MaxEntries,
+		feed.MarkAsReadDays,
 		feed.ID,
 		feed.UserID,
 	)
@@ -441,7 +556,7 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		return fmt.Errorf(`store: unable to update feed #%d (%s): %v`, feed.ID, feed.FeedURL, err)
 	}
 
-	return nil
+	return s.updateDocumentConfigurations(feed.UserID, feed.ID)
 }
 
 // UpdateFeedError updates feed errors.
@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"miniflux.app/model"
+
+	"github.com/lib/pq"
 )
 
 // HasDuplicateFeverUsername checks if another user have the same fever username.
@@ -69,7 +71,29 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 			nunux_keeper_api_key,
 			pocket_enabled,
 			pocket_access_token,
-			pocket_consumer_key
+			pocket_consumer_key,
+			webhook_enabled,
+			webhook_url,
+			webhook_secret,
+			webhook_new_entries,
+			webhook_save_entry,
+			linkding_enabled,
+			linkding_url,
+			linkding_api_key,
+			linkding_tags,
+			linkding_mark_as_unread,
+			shaarli_enabled,
+			shaarli_url,
+			shaarli_api_secret,
+			shaarli_tags,
+			shaarli_private,
+			matrix_enabled,
+			matrix_url,
+			matrix_access_token,
+			matrix_room_id,
+			telegram_enabled,
+			telegram_bot_token,
+			telegram_chat_id
 		FROM
 			integrations
 		WHERE
@@ -101,6 +125,28 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 		&integration.PocketEnabled,
 		&integration.PocketAccessToken,
 		&integration.PocketConsumerKey,
+		&integration.WebhookEnabled,
+		&integration.WebhookURL,
+		&integration.WebhookSecret,
+		&integration.WebhookNewEntries,
+		&integration.WebhookSaveEntry,
+		&integration.LinkdingEnabled,
+		&integration.LinkdingURL,
+		&integration.LinkdingAPIKey,
+		&integration.LinkdingTags,
+		&integration.LinkdingMarkAsUnread,
+		&integration.ShaarliEnabled,
+		&integration.ShaarliURL,
+		&integration.ShaarliAPISecret,
+		&integration.ShaarliTags,
+		&integration.ShaarliPrivate,
+		&integration.MatrixEnabled,
+		&integration.MatrixURL,
+		&integration.MatrixAccessToken,
+		&integration.MatrixRoomID,
+		&integration.TelegramEnabled,
+		&integration.TelegramBotToken,
+		&integration.TelegramChatID,
 	)
 	switch {
 	case err == sql.ErrNoRows:
@@ -140,9 +186,31 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 			nunux_keeper_api_key=$20,
 			pocket_enabled=$21,
 			pocket_access_token=$22,
-			pocket_consumer_key=$23
+			pocket_consumer_key=$23,
+			webhook_enabled=$24,
+			webhook_url=$25,
+			webhook_secret=$26,
+			webhook_new_entries=$27,
+			webhook_save_entry=$28,
+			linkding_enabled=$29,
+			linkding_url=$30,
+			linkding_api_key=$31,
+			linkding_tags=$32,
+			linkding_mark_as_unread=$33,
+			shaarli_enabled=$34,
+			shaarli_url=$35,
+			shaarli_api_secret=$36,
+			shaarli_tags=$37,
+			shaarli_private=$38,
+			matrix_enabled=$39,
+			matrix_url=$40,
+			matrix_access_token=$41,
+			matrix_room_id=$42,
+			telegram_enabled=$43,
+			telegram_bot_token=$44,
+			telegram_chat_id=$45
 		WHERE
-			user_id=$24
+			user_id=$46
 	`
 	_, err := s.db.Exec(
 		query,
@@ -169,6 +237,28 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 		integration.PocketEnabled,
 		integration.PocketAccessToken,
 		integration.PocketConsumerKey,
+		integration.WebhookEnabled,
+		integration.WebhookURL,
+		integration.WebhookSecret,
+		integration.WebhookNewEntries,
+		integration.WebhookSaveEntry,
+		integration.LinkdingEnabled,
+		integration.LinkdingURL,
+		integration.LinkdingAPIKey,
+		integration.LinkdingTags,
+		integration.LinkdingMarkAsUnread,
+		integration.ShaarliEnabled,
+		integration.ShaarliURL,
+		integration.ShaarliAPISecret,
+		integration.ShaarliTags,
+		integration.ShaarliPrivate,
+		integration.MatrixEnabled,
+		integration.MatrixURL,
+		integration.MatrixAccessToken,
+		integration.MatrixRoomID,
+		integration.TelegramEnabled,
+		integration.TelegramBotToken,
+		integration.TelegramChatID,
 		integration.UserID,
 	)
 
@@ -200,7 +290,7 @@ func (s *Storage) HasSaveEntry(userID int64) (result bool) {
 		WHERE
 			user_id=$1
 		AND
-			(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t')
+			(pinboard_enabled='t' OR instapaper_enabled='t' OR wallabag_enabled='t' OR nunux_keeper_enabled='t' OR pocket_enabled='t' OR linkding_enabled='t' OR shaarli_enabled='t' OR (webhook_enabled='t' AND webhook_save_entry='t'))
 	`
 	if err := s.db.QueryRow(query, userID).Scan(&result); err != nil {
 		result = false
@@ -208,3 +298,78 @@ func (s *Storage) HasSaveEntry(userID int64) (result bool) {
 
 	return result
 }
+
+// IntegrationRules returns the automatic delivery settings of the given user.
+func (s *Storage) IntegrationRules(userID int64) (model.IntegrationRules, error) {
+	query := `
+		SELECT
+			user_id,
+			service,
+			on_bookmark,
+			feed_ids,
+			category_ids,
+			keywords
+		FROM
+			integration_rules
+		WHERE
+			user_id=$1
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch integration rules: %v`, err)
+	}
+	defer rows.Close()
+
+	var rules model.IntegrationRules
+	for rows.Next() {
+		var rule model.IntegrationRule
+		err := rows.Scan(
+			&rule.UserID,
+			&rule.Service,
+			&rule.OnBookmark,
+			(*pq.Int64Array)(&rule.FeedIDs),
+			(*pq.Int64Array)(&rule.CategoryIDs),
+			&rule.Keywords,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch integration rule row: %v`, err)
+		}
+
+		rules = append(rules, &rule)
+	}
+
+	return rules, nil
+}
+
+// UpdateIntegrationRules saves the automatic delivery settings of the given user.
+func (s *Storage) UpdateIntegrationRules(userID int64, rules model.IntegrationRules) error {
+	query := `
+		INSERT INTO integration_rules
+			(user_id, service, on_bookmark, feed_ids, category_ids, keywords)
+		VALUES
+			($1, $2, $3, coalesce($4, '{}'::bigint[]), coalesce($5, '{}'::bigint[]), $6)
+		ON CONFLICT (user_id, service) DO UPDATE SET
+			on_bookmark=EXCLUDED.on_bookmark,
+			feed_ids=EXCLUDED.feed_ids,
+			category_ids=EXCLUDED.category_ids,
+			keywords=EXCLUDED.keywords
+	`
+	for _, rule := range rules {
+		_, err := s.db.Exec(
+			query,
+			userID,
+			rule.Service,
+			rule.OnBookmark,
+			pq.Array(rule.FeedIDs),
+			pq.Array(rule.CategoryIDs),
+			rule.Keywords,
+		)
+
+		if err != nil {
+			return fmt.Errorf(`store: unable to update integration rule %q: %v`, rule.Service, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// Deliveries stuck in the processing state for longer than this delay are picked up again.
+const integrationDeliveryProcessingTimeout = 15
+
+// CreateIntegrationDeliveries queues an entry for delivery to the given services.
+func (s *Storage) CreateIntegrationDeliveries(userID, entryID int64, services []string) error {
+	query := `INSERT INTO integration_deliveries (user_id, entry_id, service) VALUES ($1, $2, $3)`
+	for _, service := range services {
+		if _, err := s.db.Exec(query, userID, entryID, service); err != nil {
+			return fmt.Errorf(`store: unable to queue entry #%d for %q: %v`, entryID, service, err)
+		}
+	}
+
+	return nil
+}
+
+// NewIntegrationDeliveryBatch claims a batch of deliveries ready to be sent.
+func (s *Storage) NewIntegrationDeliveryBatch(batchSize int) (model.IntegrationDeliveries, error) {
+	query := `
+		UPDATE
+			integration_deliveries
+		SET
+			status='processing',
+			updated_at=now()
+		WHERE
+			id IN (
+				SELECT
+					id
+				FROM
+					integration_deliveries
+				WHERE
+					(status='pending' AND next_attempt_at <= now())
+				OR
+					(status='processing' AND updated_at < now() - interval '%d minutes')
+				ORDER BY next_attempt_at ASC
+				LIMIT %d
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING
+			id, user_id, entry_id, service, status, attempts, error_msg, next_attempt_at, created_at, updated_at
+	`
+	rows, err := s.db.Query(fmt.Sprintf(query, integrationDeliveryProcessingTimeout, batchSize))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch batch of integration deliveries: %v`, err)
+	}
+	defer rows.Close()
+
+	var deliveries model.IntegrationDeliveries
+	for rows.Next() {
+		var delivery model.IntegrationDelivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.UserID,
+			&delivery.EntryID,
+			&delivery.Service,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.ErrorMsg,
+			&delivery.NextAttemptAt,
+			&delivery.CreatedAt,
+			&delivery.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch integration delivery row: %v`, err)
+		}
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, nil
+}
+
+// UpdateIntegrationDelivery saves the outcome of a delivery attempt.
+func (s *Storage) UpdateIntegrationDelivery(delivery *model.IntegrationDelivery) error {
+	query := `
+		UPDATE
+			integration_deliveries
+		SET
+			status=$1,
+			attempts=$2,
+			error_msg=$3,
+			next_attempt_at=$4,
+			updated_at=now()
+		WHERE
+			id=$5 AND user_id=$6
+	`
+	_, err := s.db.Exec(
+		query,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.ErrorMsg,
+		delivery.NextAttemptAt,
+		delivery.ID,
+		delivery.UserID,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to update integration delivery #%d: %v`, delivery.ID, err)
+	}
+
+	return nil
+}
+
+// IntegrationDeliveries returns the most recent deliveries of the given user.
+func (s *Storage) IntegrationDeliveries(userID int64, limit int) (model.IntegrationDeliveries, error) {
+	query := `
+		SELECT
+			d.id,
+			d.user_id,
+			d.entry_id,
+			e.title,
+			e.url,
+			d.service,
+			d.status,
+			d.attempts,
+			d.error_msg,
+			d.next_attempt_at,
+			d.created_at,
+			d.updated_at
+		FROM
+			integration_deliveries d
+		JOIN entries e ON e.id=d.entry_id
+		WHERE
+			d.user_id=$1
+		ORDER BY d.created_at DESC, d.id DESC
+		LIMIT $2
+	`
+	rows, err := s.db.Query(query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch integration deliveries: %v`, err)
+	}
+	defer rows.Close()
+
+	var deliveries model.IntegrationDeliveries
+	for rows.Next() {
+		var delivery model.IntegrationDelivery
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.UserID,
+			&delivery.EntryID,
+			&delivery.EntryTitle,
+			&delivery.EntryURL,
+			&delivery.Service,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.ErrorMsg,
+			&delivery.NextAttemptAt,
+			&delivery.CreatedAt,
+			&delivery.UpdatedAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch integration delivery row: %v`, err)
+		}
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	return deliveries, nil
+}
+
+// RetryIntegrationDelivery queues again a failed delivery.
+func (s *Storage) RetryIntegrationDelivery(userID, deliveryID int64) error {
+	query := `
+		UPDATE
+			integration_deliveries
+		SET
+			status='pending',
+			attempts=0,
+			next_attempt_at=now(),
+			updated_at=now()
+		WHERE
+			user_id=$1 AND id=$2 AND status='failed'
+	`
+	result, err := s.db.Exec(query, userID, deliveryID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to retry integration delivery #%d: %v`, deliveryID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to retry integration delivery #%d: %v`, deliveryID, err)
+	}
+
+	if count != 1 {
+		return fmt.Errorf(`store: nothing has been updated`)
+	}
+
+	return nil
+}
+
+// CleanOldIntegrationDeliveries removes finished deliveries older than specified days.
+func (s *Storage) CleanOldIntegrationDeliveries(days int) int64 {
+	query := `
+		DELETE FROM
+			integration_deliveries
+		WHERE
+			status IN ('success', 'failed') AND updated_at < now() - interval '%d days'
+	`
+	result, err := s.db.Exec(fmt.Sprintf(query, days))
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
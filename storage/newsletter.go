@@ -0,0 +1,138 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+// NewsletterToken returns the token used in the newsletter address of the user and generates one if necessary.
+func (s *Storage) NewsletterToken(userID int64) (token string, err error) {
+	query := `SELECT newsletter_token FROM integrations WHERE user_id=$1`
+	err = s.db.QueryRow(query, userID).Scan(&token)
+	if err != nil {
+		return "", fmt.Errorf(`store: unable to fetch newsletter token: %v`, err)
+	}
+
+	if token == "" {
+		// Hexadecimal tokens are not altered by mail servers that lowercase addresses.
+		token = hex.EncodeToString(crypto.GenerateRandomBytes(10))
+
+		query = `UPDATE integrations SET newsletter_token=$1 WHERE user_id=$2`
+		if _, err = s.db.Exec(query, token, userID); err != nil {
+			return "", fmt.Errorf(`store: unable to update newsletter token: %v`, err)
+		}
+	}
+
+	return token, nil
+}
+
+// UserIDByNewsletterToken returns the user ID that owns the given newsletter token, 0 if not found.
+func (s *Storage) UserIDByNewsletterToken(token string) (userID int64, err error) {
+	query := `SELECT user_id FROM integrations WHERE newsletter_token=$1 AND newsletter_token <> ''`
+	err = s.db.QueryRow(query, token).Scan(&userID)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf(`store: unable to fetch user by newsletter token: %v`, err)
+	}
+
+	return userID, nil
+}
+
+// NewsletterFeedID returns the feed generated for the given sender.
+// When the sender is known but the feed has been removed, the feed ID is 0.
+func (s *Storage) NewsletterFeedID(userID int64, sender string) (feedID int64, found bool, err error) {
+	query := `SELECT coalesce(feed_id, 0) FROM newsletter_feeds WHERE user_id=$1 AND sender=$2`
+	err = s.db.QueryRow(query, userID, sender).Scan(&feedID)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, false, nil
+	case err != nil:
+		return 0, false, fmt.Errorf(`store: unable to fetch newsletter feed: %v`, err)
+	}
+
+	return feedID, true, nil
+}
+
+// CreateNewsletterFeed creates a feed for the given newsletter sender.
+func (s *Storage) CreateNewsletterFeed(feed *model.Feed, sender string) error {
+	if err := s.CreateFeed(feed); err != nil {
+		return err
+	}
+
+	query := `INSERT INTO newsletter_feeds (user_id, sender, feed_id) VALUES ($1, $2, $3)`
+	if _, err := s.db.Exec(query, feed.UserID, sender, feed.ID); err != nil {
+		return fmt.Errorf(`store: unable to create newsletter feed: %v`, err)
+	}
+
+	return nil
+}
+
+// CreateNewsletterAttachment stores a file attached to a newsletter.
+func (s *Storage) CreateNewsletterAttachment(attachment *model.NewsletterAttachment) error {
+	attachment.Token = hex.EncodeToString(crypto.GenerateRandomBytes(16))
+
+	query := `
+		INSERT INTO newsletter_attachments
+			(token, user_id, feed_id, filename, mime_type, content)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+	`
+	_, err := s.db.Exec(
+		query,
+		attachment.Token,
+		attachment.UserID,
+		attachment.FeedID,
+		attachment.Filename,
+		attachment.MimeType,
+		attachment.Content,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create newsletter attachment: %v`, err)
+	}
+
+	return nil
+}
+
+// NewsletterAttachment returns a file attached to a newsletter.
+func (s *Storage) NewsletterAttachment(userID int64, token string) (*model.NewsletterAttachment, error) {
+	var attachment model.NewsletterAttachment
+
+	query := `
+		SELECT
+			token, user_id, feed_id, filename, mime_type, content
+		FROM
+			newsletter_attachments
+		WHERE
+			user_id=$1 AND token=$2
+	`
+	err := s.db.QueryRow(query, userID, token).Scan(
+		&attachment.Token,
+		&attachment.UserID,
+		&attachment.FeedID,
+		&attachment.Filename,
+		&attachment.MimeType,
+		&attachment.Content,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch newsletter attachment: %v`, err)
+	}
+
+	return &attachment, nil
+}
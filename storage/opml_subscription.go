@@ -0,0 +1,259 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+const opmlSubscriptionColumns = `id, user_id, url, remove_missing, checked_at, error_msg, created_at`
+
+// OPMLSubscriptions returns the OPML subscriptions of the given user.
+func (s *Storage) OPMLSubscriptions(userID int64) (model.OPMLSubscriptions, error) {
+	query := `SELECT ` + opmlSubscriptionColumns + ` FROM opml_subscriptions WHERE user_id=$1 ORDER BY id ASC`
+	return s.fetchOPMLSubscriptions(query, userID)
+}
+
+// AllOPMLSubscriptions returns the OPML subscriptions of all users.
+func (s *Storage) AllOPMLSubscriptions() (model.OPMLSubscriptions, error) {
+	query := `SELECT ` + opmlSubscriptionColumns + ` FROM opml_subscriptions ORDER BY id ASC`
+	return s.fetchOPMLSubscriptions(query)
+}
+
+func (s *Storage) fetchOPMLSubscriptions(query string, args ...interface{}) (model.OPMLSubscriptions, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch OPML subscriptions: %v`, err)
+	}
+	defer rows.Close()
+
+	subscriptions := make(model.OPMLSubscriptions, 0)
+	for rows.Next() {
+		var subscription model.OPMLSubscription
+		err := rows.Scan(
+			&subscription.ID,
+			&subscription.UserID,
+			&subscription.URL,
+			&subscription.RemoveMissing,
+			&subscription.CheckedAt,
+			&subscription.ErrorMsg,
+			&subscription.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch OPML subscription row: %v`, err)
+		}
+
+		subscriptions = append(subscriptions, &subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// OPMLSubscriptionByID returns an OPML subscription of the given user.
+func (s *Storage) OPMLSubscriptionByID(userID, subscriptionID int64) (*model.OPMLSubscription, error) {
+	var subscription model.OPMLSubscription
+
+	query := `SELECT ` + opmlSubscriptionColumns + ` FROM opml_subscriptions WHERE id=$1 AND user_id=$2`
+	err := s.db.QueryRow(query, subscriptionID, userID).Scan(
+		&subscription.ID,
+		&subscription.UserID,
+		&subscription.URL,
+		&subscription.RemoveMissing,
+		&subscription.CheckedAt,
+		&subscription.ErrorMsg,
+		&subscription.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch OPML subscription #%d: %v`, subscriptionID, err)
+	}
+
+	return &subscription, nil
+}
+
+// OPMLSubscriptionURLExists checks if the user already follows this OPML file.
+func (s *Storage) OPMLSubscriptionURLExists(userID int64, url string) bool {
+	var result bool
+	query := `SELECT true FROM opml_subscriptions WHERE user_id=$1 AND url=$2`
+	s.db.QueryRow(query, userID, url).Scan(&result)
+	return result
+}
+
+// CreateOPMLSubscription creates a new OPML subscription.
+func (s *Storage) CreateOPMLSubscription(subscription *model.OPMLSubscription) error {
+	query := `
+		INSERT INTO opml_subscriptions
+			(user_id, url, remove_missing)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		subscription.UserID,
+		subscription.URL,
+		subscription.RemoveMissing,
+	).Scan(&subscription.ID, &subscription.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create OPML subscription %q: %v`, subscription.URL, err)
+	}
+
+	return nil
+}
+
+// UpdateOPMLSubscriptionStatus records the date and the error of the last synchronization.
+func (s *Storage) UpdateOPMLSubscriptionStatus(subscription *model.OPMLSubscription) error {
+	query := `UPDATE opml_subscriptions SET checked_at=now(), error_msg=$1 WHERE id=$2 RETURNING checked_at`
+	err := s.db.QueryRow(query, subscription.ErrorMsg, subscription.ID).Scan(&subscription.CheckedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update OPML subscription #%d: %v`, subscription.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveOPMLSubscription removes an OPML subscription, the feeds are kept.
+func (s *Storage) RemoveOPMLSubscription(userID, subscriptionID int64) error {
+	query := `DELETE FROM opml_subscriptions WHERE id=$1 AND user_id=$2`
+	if _, err := s.db.Exec(query, subscriptionID, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove OPML subscription #%d: %v`, subscriptionID, err)
+	}
+
+	return nil
+}
+
+// OPMLSubscriptionFeeds returns the feeds created by an OPML subscription indexed by their URL.
+func (s *Storage) OPMLSubscriptionFeeds(subscriptionID int64) (map[string]int64, error) {
+	query := `SELECT id, feed_url FROM feeds WHERE opml_subscription_id=$1`
+	rows, err := s.db.Query(query, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch feeds of OPML subscription #%d: %v`, subscriptionID, err)
+	}
+	defer rows.Close()
+
+	feeds := make(map[string]int64)
+	for rows.Next() {
+		var feedID int64
+		var feedURL string
+		if err := rows.Scan(&feedID, &feedURL); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed row: %v`, err)
+		}
+
+		feeds[feedURL] = feedID
+	}
+
+	return feeds, nil
+}
+
+// SetFeedOPMLSubscription marks the feed as managed by the OPML subscription.
+func (s *Storage) SetFeedOPMLSubscription(userID, feedID, subscriptionID int64) error {
+	query := `UPDATE feeds SET opml_subscription_id=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, subscriptionID, feedID, userID); err != nil {
+		return fmt.Errorf(`store: unable to update feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// UpdateFeedCategory moves a feed to another category.
+func (s *Storage) UpdateFeedCategory(userID, feedID, categoryID int64) error {
+	query := `UPDATE feeds SET category_id=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := s.db.Exec(query, categoryID, feedID, userID); err != nil {
+		return fmt.Errorf(`store: unable to move feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// CreateOPMLSubscriptionChanges records the changes made by a synchronization.
+func (s *Storage) CreateOPMLSubscriptionChanges(changes model.OPMLSubscriptionChanges) error {
+	query := `
+		INSERT INTO opml_subscription_changes
+			(subscription_id, action, feed_url, title, category, details)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			id, created_at
+	`
+	for _, change := range changes {
+		err := s.db.QueryRow(
+			query,
+			change.SubscriptionID,
+			change.Action,
+			change.FeedURL,
+			change.Title,
+			change.Category,
+			change.Details,
+		).Scan(&change.ID, &change.CreatedAt)
+
+		if err != nil {
+			return fmt.Errorf(`store: unable to create OPML subscription change: %v`, err)
+		}
+	}
+
+	return nil
+}
+
+// OPMLSubscriptionChanges returns the most recent changes made by the OPML subscriptions of the given user.
+func (s *Storage) OPMLSubscriptionChanges(userID int64, limit int) (model.OPMLSubscriptionChanges, error) {
+	query := `
+		SELECT
+			c.id,
+			c.subscription_id,
+			s.url,
+			c.action,
+			c.feed_url,
+			c.title,
+			c.category,
+			c.details,
+			c.created_at
+		FROM
+			opml_subscription_changes c
+		JOIN
+			opml_subscriptions s ON s.id=c.subscription_id
+		WHERE
+			s.user_id=$1
+		ORDER BY c.id DESC
+		LIMIT $2
+	`
+	rows, err := s.db.Query(query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch OPML subscription changes: %v`, err)
+	}
+	defer rows.Close()
+
+	changes := make(model.OPMLSubscriptionChanges, 0)
+	for rows.Next() {
+		var change model.OPMLSubscriptionChange
+		err := rows.Scan(
+			&change.ID,
+			&change.SubscriptionID,
+			&change.SubscriptionURL,
+			&change.Action,
+			&change.FeedURL,
+			&change.Title,
+			&change.Category,
+			&change.Details,
+			&change.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch OPML subscription change row: %v`, err)
+		}
+
+		changes = append(changes, &change)
+	}
+
+	return changes, nil
+}
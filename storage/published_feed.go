@@ -0,0 +1,159 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+)
+
+// PublishedFeeds returns the list of published feeds for the given user.
+func (s *Storage) PublishedFeeds(userID int64) (model.PublishedFeeds, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			token,
+			title,
+			source,
+			coalesce(category_id, 0),
+			tag,
+			created_at
+		FROM
+			published_feeds
+		WHERE
+			user_id=$1
+		ORDER BY id DESC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch published feeds: %v`, err)
+	}
+	defer rows.Close()
+
+	feeds := make(model.PublishedFeeds, 0)
+	for rows.Next() {
+		var feed model.PublishedFeed
+		err := rows.Scan(
+			&feed.ID,
+			&feed.UserID,
+			&feed.Token,
+			&feed.Title,
+			&feed.Source,
+			&feed.CategoryID,
+			&feed.Tag,
+			&feed.CreatedAt,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch published feed row: %v`, err)
+		}
+
+		feeds = append(feeds, &feed)
+	}
+
+	return feeds, nil
+}
+
+// PublishedFeedByToken finds a published feed by its secret token.
+func (s *Storage) PublishedFeedByToken(token string) (*model.PublishedFeed, error) {
+	var feed model.PublishedFeed
+
+	query := `
+		SELECT
+			id,
+			user_id,
+			token,
+			title,
+			source,
+			coalesce(category_id, 0),
+			tag,
+			created_at
+		FROM
+			published_feeds
+		WHERE
+			token=$1
+	`
+	err := s.db.QueryRow(query, token).Scan(
+		&feed.ID,
+		&feed.UserID,
+		&feed.Token,
+		&feed.Title,
+		&feed.Source,
+		&feed.CategoryID,
+		&feed.Tag,
+		&feed.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch published feed: %v`, err)
+	}
+
+	return &feed, nil
+}
+
+// CreatePublishedFeed generates a new secret token and publishes the given feed.
+func (s *Storage) CreatePublishedFeed(feed *model.PublishedFeed) error {
+	var categoryID interface{}
+	if feed.Source == model.PublishedFeedSourceCategory {
+		categoryID = feed.CategoryID
+	}
+
+	if feed.Source != model.PublishedFeedSourceTag {
+		feed.Tag = ""
+	}
+
+	feed.Token = crypto.GenerateRandomString(32)
+
+	query := `
+		INSERT INTO published_feeds
+			(user_id, token, title, source, category_id, tag)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		feed.UserID,
+		feed.Token,
+		feed.Title,
+		feed.Source,
+		categoryID,
+		feed.Tag,
+	).Scan(&feed.ID, &feed.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create published feed: %v`, err)
+	}
+
+	return nil
+}
+
+// RemovePublishedFeed revokes a published feed.
+func (s *Storage) RemovePublishedFeed(userID, feedID int64) error {
+	query := `DELETE FROM published_feeds WHERE id=$1 AND user_id=$2`
+	result, err := s.db.Exec(query, feedID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove published feed: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove published feed: %v`, err)
+	}
+
+	if count != 1 {
+		return fmt.Errorf(`store: nothing has been removed`)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// Number of entries updated by each query of the cleanup jobs, to avoid locking the table for too long.
+const cleanupBatchSize = 5000
+
+// removableEntryCondition excludes the entries kept by the retention policies:
+// starred, shared, snoozed and annotated entries.
+const removableEntryCondition = `
+	entries.starred is false
+	AND entries.share_code=''
+	AND entries.snoozed_until IS NULL
+	AND ` + annotatedEntryCondition
+
+// retentionSetting returns the SQL expression of a retention setting: the setting of the feed,
+// otherwise the setting of the category, otherwise the default value.
+func retentionSetting(column, defaultValue string) string {
+	return fmt.Sprintf(`COALESCE(NULLIF(f.%s, 0), NULLIF(c.%s, 0), %s)`, column, column, defaultValue)
+}
+
+// importedEntryCondition excludes the imported entries unless the feed or its category defines the retention setting,
+// the default value only applies to the entries fetched from the feed.
+func importedEntryCondition(column string) string {
+	return fmt.Sprintf(`(entries.imported is false OR COALESCE(NULLIF(f.%s, 0), NULLIF(c.%s, 0)) IS NOT NULL)`, column, column)
+}
+
+// updateEntriesInBatches runs a query updating at most cleanupBatchSize entries until all entries are updated.
+func (s *Storage) updateEntriesInBatches(query string, args ...interface{}) (int64, error) {
+	var total int64
+	for {
+		result, err := s.db.Exec(query, args...)
+		if err != nil {
+			return total, err
+		}
+
+		count, _ := result.RowsAffected()
+		total += count
+		if count < cleanupBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// RemoveExtraEntries changes the status of the oldest entries of the feeds having a maximum number of entries
+// to "removed".
+func (s *Storage) RemoveExtraEntries() (int64, error) {
+	maxEntries := retentionSetting("max_entries", "0")
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1
+		WHERE
+			id=ANY(
+				SELECT
+					id
+				FROM (
+					SELECT
+						entries.id,
+						row_number() OVER (PARTITION BY entries.feed_id ORDER BY entries.published_at DESC, entries.id DESC) AS position,
+						` + maxEntries + ` AS max_entries
+					FROM entries
+					JOIN feeds f ON f.id=entries.feed_id
+					JOIN categories c ON c.id=f.category_id
+					WHERE
+						entries.status<>$1 AND ` + maxEntries + ` > 0 AND ` + removableEntryCondition + `
+				) AS ranked_entries
+				WHERE
+					position > max_entries
+				LIMIT $2
+			)
+	`
+	count, err := s.updateEntriesInBatches(query, model.EntryStatusRemoved, cleanupBatchSize)
+	if err != nil {
+		return count, fmt.Errorf(`store: unable to remove extra entries: %v`, err)
+	}
+
+	return count, nil
+}
+
+// MarkOldEntriesAsRead marks the unread entries older than the retention policy of their feed as read.
+func (s *Storage) MarkOldEntriesAsRead() (int64, error) {
+	markAsReadDays := retentionSetting("mark_as_read_days", "0")
+	query := `
+		UPDATE
+			entries
+		SET
+			status=$1,
+			changed_at=now()
+		WHERE
+			id=ANY(
+				SELECT
+					entries.id
+				FROM entries
+				JOIN feeds f ON f.id=entries.feed_id
+				JOIN categories c ON c.id=f.category_id
+				WHERE
+					entries.status=$2
+				AND
+					entries.snoozed_until IS NULL
+				AND
+					` + markAsReadDays + ` > 0
+				AND
+					entries.published_at < now() - (` + markAsReadDays + ` * interval '1 day')
+				LIMIT $3
+			)
+	`
+	count, err := s.updateEntriesInBatches(query, model.EntryStatusRead, model.EntryStatusUnread, cleanupBatchSize)
+	if err != nil {
+		return count, fmt.Errorf(`store: unable to mark old entries as read: %v`, err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,355 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"miniflux.app/database"
+	"miniflux.app/model"
+
+	"github.com/lib/pq"
+)
+
+// uniqueSuffix is used to create users and feeds that do not conflict with existing ones.
+var uniqueSuffix int64
+
+type retentionFixture struct {
+	t     *testing.T
+	db    *sql.DB
+	store *Storage
+	user  *model.User
+}
+
+// newRetentionFixture creates a user in the database defined by DATABASE_URL, the test is skipped without database.
+func newRetentionFixture(t *testing.T) *retentionFixture {
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		t.Skip("DATABASE_URL is not defined")
+	}
+
+	db, err := database.NewConnectionPool(dsn, 1, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewStorage(db)
+	user := &model.User{Username: "retention" + nextSuffix(), Password: "test123"}
+	if err := store.CreateUser(user); err != nil {
+		db.Close()
+		t.Fatal(err)
+	}
+
+	return &retentionFixture{t: t, db: db, store: store, user: user}
+}
+
+func nextSuffix() string {
+	return fmt.Sprintf("%d-%d", time.Now().UnixNano(), atomic.AddInt64(&uniqueSuffix, 1))
+}
+
+func (f *retentionFixture) close() {
+	f.store.RemoveUser(f.user.ID)
+	f.db.Close()
+}
+
+func (f *retentionFixture) createCategory(archiveReadDays, maxEntries, markAsReadDays int) *model.Category {
+	category := &model.Category{
+		UserID:          f.user.ID,
+		Title:           "Retention " + nextSuffix(),
+		ArchiveReadDays: archiveReadDays,
+		MaxEntries:      maxEntries,
+		MarkAsReadDays:  markAsReadDays,
+	}
+
+	if err := f.store.CreateCategory(category); err != nil {
+		f.t.Fatal(err)
+	}
+
+	return category
+}
+
+func (f *retentionFixture) createFeed(category *model.Category, archiveReadDays, maxEntries, markAsReadDays int) *model.Feed {
+	feed := &model.Feed{
+		UserID:          f.user.ID,
+		FeedURL:         "https://example.org/" + nextSuffix() + ".xml",
+		SiteURL:         "https://example.org/",
+		Title:           "Retention",
+		Category:        category,
+		ArchiveReadDays: archiveReadDays,
+		MaxEntries:      maxEntries,
+		MarkAsReadDays:  markAsReadDays,
+	}
+
+	if err := f.store.CreateFeed(feed); err != nil {
+		f.t.Fatal(err)
+	}
+
+	return feed
+}
+
+// createEntries inserts entries published the given number of days ago, the entry "prefix-1" is the most recent.
+func (f *retentionFixture) createEntries(feed *model.Feed, prefix string, count, days int, status string) {
+	query := `
+		INSERT INTO entries
+			(user_id, feed_id, hash, title, url, published_at, status, changed_at)
+		SELECT
+			$1, $2, $3::text || '-' || n, $3::text, 'https://example.org/' || $3::text || '-' || n,
+			now() - ($4::int * interval '1 day') - (n * interval '1 minute'), $5::entry_status, now()
+		FROM
+			generate_series(1, $6::int) AS n
+	`
+	if _, err := f.db.Exec(query, f.user.ID, feed.ID, prefix, days, status, count); err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+func (f *retentionFixture) exec(query string, args ...interface{}) {
+	if _, err := f.db.Exec(query, args...); err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+func (f *retentionFixture) entryID(feed *model.Feed, hash string) int64 {
+	var entryID int64
+	if err := f.db.QueryRow(`SELECT id FROM entries WHERE feed_id=$1 AND hash=$2`, feed.ID, hash).Scan(&entryID); err != nil {
+		f.t.Fatal(err)
+	}
+
+	return entryID
+}
+
+func (f *retentionFixture) status(feed *model.Feed, hash string) string {
+	var status string
+	if err := f.db.QueryRow(`SELECT status FROM entries WHERE feed_id=$1 AND hash=$2`, feed.ID, hash).Scan(&status); err != nil {
+		f.t.Fatal(err)
+	}
+
+	return status
+}
+
+func (f *retentionFixture) countEntries(feed *model.Feed, status string) int {
+	var count int
+	if err := f.db.QueryRow(`SELECT count(*) FROM entries WHERE feed_id=$1 AND status=$2`, feed.ID, status).Scan(&count); err != nil {
+		f.t.Fatal(err)
+	}
+
+	return count
+}
+
+func TestRemoveExtraEntries(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	category := f.createCategory(0, 0, 0)
+	feed := f.createFeed(category, 0, 3, 0)
+	f.createEntries(feed, "entry", 5, 0, model.EntryStatusUnread)
+	f.exec(`UPDATE entries SET starred='t' WHERE feed_id=$1 AND hash='entry-5'`, feed.ID)
+
+	if _, err := f.store.RemoveExtraEntries(); err != nil {
+		t.Fatal(err)
+	}
+
+	for hash, expected := range map[string]string{
+		"entry-1": model.EntryStatusUnread,
+		"entry-3": model.EntryStatusUnread,
+		"entry-4": model.EntryStatusRemoved,
+		"entry-5": model.EntryStatusUnread,
+	} {
+		if status := f.status(feed, hash); status != expected {
+			t.Errorf(`Only the oldest entries not starred should be removed, %s is %q instead of %q`, hash, status, expected)
+		}
+	}
+}
+
+func TestRemoveExtraEntriesWithCategorySetting(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	category := f.createCategory(0, 2, 0)
+	inheritingFeed := f.createFeed(category, 0, 0, 0)
+	disabledFeed := f.createFeed(category, 0, -1, 0)
+	f.createEntries(inheritingFeed, "entry", 4, 0, model.EntryStatusUnread)
+	f.createEntries(disabledFeed, "entry", 4, 0, model.EntryStatusUnread)
+
+	if _, err := f.store.RemoveExtraEntries(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := f.countEntries(inheritingFeed, model.EntryStatusRemoved); count != 2 {
+		t.Errorf(`The feed should inherit the setting of its category, got %d removed entries`, count)
+	}
+
+	if count := f.countEntries(disabledFeed, model.EntryStatusRemoved); count != 0 {
+		t.Errorf(`The feed should disable the setting of its category, got %d removed entries`, count)
+	}
+}
+
+func TestMarkOldEntriesAsRead(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	category := f.createCategory(0, 0, 10)
+	feed := f.createFeed(category, 0, 0, 0)
+	disabledFeed := f.createFeed(category, 0, 0, -1)
+	globalFeed := f.createFeed(f.createCategory(0, 0, 0), 0, 0, 0)
+
+	f.createEntries(feed, "old", 3, 20, model.EntryStatusUnread)
+	f.createEntries(feed, "recent", 2, 1, model.EntryStatusUnread)
+	f.createEntries(disabledFeed, "old", 3, 20, model.EntryStatusUnread)
+	f.createEntries(globalFeed, "old", 3, 20, model.EntryStatusUnread)
+	f.exec(`UPDATE entries SET snoozed_until=now() + interval '1 day' WHERE feed_id=$1 AND hash='old-3'`, feed.ID)
+
+	if _, err := f.store.MarkOldEntriesAsRead(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := f.countEntries(feed, model.EntryStatusRead); count != 2 {
+		t.Errorf(`Old entries not snoozed should be marked as read, got %d read entries`, count)
+	}
+
+	if status := f.status(feed, "old-3"); status != model.EntryStatusUnread {
+		t.Errorf(`Snoozed entries should be kept unread, got %q`, status)
+	}
+
+	if count := f.countEntries(disabledFeed, model.EntryStatusRead); count != 0 {
+		t.Errorf(`The feed should disable the setting of its category, got %d read entries`, count)
+	}
+
+	if count := f.countEntries(globalFeed, model.EntryStatusRead); count != 0 {
+		t.Errorf(`Entries should not be marked as read without a setting, got %d read entries`, count)
+	}
+}
+
+func TestArchiveEntries(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	feed := f.createFeed(f.createCategory(0, 0, 0), 0, 0, 0)
+	f.createEntries(feed, "old", 7, 60, model.EntryStatusRead)
+	f.createEntries(feed, "recent", 2, 1, model.EntryStatusRead)
+
+	f.exec(`UPDATE entries SET starred='t' WHERE feed_id=$1 AND hash='old-2'`, feed.ID)
+	f.exec(`UPDATE entries SET share_code='code' WHERE feed_id=$1 AND hash='old-3'`, feed.ID)
+	f.exec(`UPDATE entries SET snoozed_until=now() + interval '1 day' WHERE feed_id=$1 AND hash='old-4'`, feed.ID)
+	f.exec(`UPDATE entries SET imported='t' WHERE feed_id=$1 AND hash='old-5'`, feed.ID)
+
+	note := &model.EntryNote{UserID: f.user.ID, EntryID: f.entryID(feed, "old-6"), Content: "Note"}
+	if err := f.store.SaveEntryNote(note); err != nil {
+		t.Fatal(err)
+	}
+
+	highlight := &model.EntryHighlight{UserID: f.user.ID, EntryID: f.entryID(feed, "old-7"), Text: "Highlight"}
+	if err := f.store.CreateEntryHighlight(highlight); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.store.ArchiveEntries(30); err != nil {
+		t.Fatal(err)
+	}
+
+	if status := f.status(feed, "old-1"); status != model.EntryStatusRemoved {
+		t.Errorf(`Old read entries should be archived with the global setting, got %q`, status)
+	}
+
+	for _, hash := range []string{"old-2", "old-3", "old-4", "old-5", "old-6", "old-7", "recent-1", "recent-2"} {
+		if status := f.status(feed, hash); status != model.EntryStatusRead {
+			t.Errorf(`Entry %s should be kept, got %q`, hash, status)
+		}
+	}
+}
+
+func TestArchiveEntriesWithRetentionSettings(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	category := f.createCategory(90, 0, 0)
+	inheritingFeed := f.createFeed(category, 0, 0, 0)
+	feed := f.createFeed(category, 10, 0, 0)
+	disabledFeed := f.createFeed(f.createCategory(0, 0, 0), -1, 0, 0)
+
+	f.createEntries(inheritingFeed, "entry", 2, 60, model.EntryStatusRead)
+	f.createEntries(feed, "entry", 2, 20, model.EntryStatusRead)
+	f.createEntries(disabledFeed, "entry", 2, 60, model.EntryStatusRead)
+
+	if _, err := f.store.ArchiveEntries(30); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := f.countEntries(inheritingFeed, model.EntryStatusRemoved); count != 0 {
+		t.Errorf(`The setting of the category should replace the global setting, got %d archived entries`, count)
+	}
+
+	if count := f.countEntries(feed, model.EntryStatusRemoved); count != 2 {
+		t.Errorf(`The setting of the feed should replace the setting of its category, got %d archived entries`, count)
+	}
+
+	if count := f.countEntries(disabledFeed, model.EntryStatusRemoved); count != 0 {
+		t.Errorf(`The feed should disable the global setting, got %d archived entries`, count)
+	}
+}
+
+func TestRetentionSettingsApplyToImportedEntries(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	globalFeed := f.createFeed(f.createCategory(0, 0, 0), 0, 0, 0)
+	feed := f.createFeed(f.createCategory(0, 0, 0), 10, 0, 0)
+	categoryFeed := f.createFeed(f.createCategory(10, 1, 0), 0, 0, 0)
+
+	f.createEntries(globalFeed, "entry", 2, 60, model.EntryStatusRead)
+	f.createEntries(feed, "entry", 2, 20, model.EntryStatusRead)
+	f.createEntries(categoryFeed, "entry", 2, 1, model.EntryStatusUnread)
+	f.exec(`UPDATE entries SET imported='t' WHERE feed_id=ANY($1)`, pq.Array([]int64{globalFeed.ID, feed.ID, categoryFeed.ID}))
+
+	if _, err := f.store.ArchiveEntries(30); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.store.RemoveExtraEntries(); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := f.countEntries(globalFeed, model.EntryStatusRemoved); count != 0 {
+		t.Errorf(`Imported entries should be kept with the global setting, got %d archived entries`, count)
+	}
+
+	if count := f.countEntries(feed, model.EntryStatusRemoved); count != 2 {
+		t.Errorf(`Imported entries should be archived with the setting of the feed, got %d archived entries`, count)
+	}
+
+	if status := f.status(categoryFeed, "entry-2"); status != model.EntryStatusRemoved {
+		t.Errorf(`Imported entries should be removed with the setting of the category, got %q`, status)
+	}
+}
+
+func TestArchiveEntriesInBatches(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	feed := f.createFeed(f.createCategory(0, 0, 0), 0, 0, 0)
+
+	// More entries than the batch size of the cleanup jobs.
+	f.createEntries(feed, "entry", 5003, 60, model.EntryStatusRead)
+
+	count, err := f.store.ArchiveEntries(30)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count < 5003 {
+		t.Errorf(`All the entries should be archived, got %d`, count)
+	}
+
+	if count := f.countEntries(feed, model.EntryStatusRead); count != 0 {
+		t.Errorf(`All the entries should be archived, %d entries are still read`, count)
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/search"
+)
+
+const savedSearchColumns = `id, user_id, title, query, status, coalesce(category_id, 0)`
+
+// SavedSearchTitleExists checks if the user already has a saved search with this title.
+func (s *Storage) SavedSearchTitleExists(userID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM saved_searches WHERE user_id=$1 AND title=$2`
+	s.db.QueryRow(query, userID, title).Scan(&result)
+	return result
+}
+
+// AnotherSavedSearchExists checks if another saved search of the user has this title.
+func (s *Storage) AnotherSavedSearchExists(userID, savedSearchID int64, title string) bool {
+	var result bool
+	query := `SELECT true FROM saved_searches WHERE user_id=$1 AND id != $2 AND title=$3`
+	s.db.QueryRow(query, userID, savedSearchID, title).Scan(&result)
+	return result
+}
+
+// SavedSearches returns the saved searches of the given user.
+func (s *Storage) SavedSearches(userID int64) (model.SavedSearches, error) {
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE user_id=$1 ORDER BY lower(title) ASC`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch saved searches: %v`, err)
+	}
+	defer rows.Close()
+
+	savedSearches := make(model.SavedSearches, 0)
+	for rows.Next() {
+		var savedSearch model.SavedSearch
+		err := rows.Scan(
+			&savedSearch.ID,
+			&savedSearch.UserID,
+			&savedSearch.Title,
+			&savedSearch.Query,
+			&savedSearch.Status,
+			&savedSearch.CategoryID,
+		)
+
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch saved search row: %v`, err)
+		}
+
+		savedSearches = append(savedSearches, &savedSearch)
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearchesWithCounters returns the saved searches of the given user with the number of unread entries.
+// Dates of the queries are interpreted in the given timezone.
+func (s *Storage) SavedSearchesWithCounters(userID int64, timezone string) (model.SavedSearches, error) {
+	savedSearches, err := s.SavedSearches(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, savedSearch := range savedSearches {
+		query, err := savedSearch.SearchQuery(timezone)
+		if err != nil {
+			logger.Error(`store: saved search #%d: %v`, savedSearch.ID, err)
+			continue
+		}
+
+		builder := s.NewEntryQueryBuilder(userID)
+		builder.WithSavedSearch(savedSearch, query)
+		builder.WithStatus(model.EntryStatusUnread)
+
+		savedSearch.UnreadCount, err = builder.CountEntries()
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to count the entries of saved search #%d: %v`, savedSearch.ID, err)
+		}
+	}
+
+	return savedSearches, nil
+}
+
+// SavedSearchByID returns a saved search of the given user.
+func (s *Storage) SavedSearchByID(userID, savedSearchID int64) (*model.SavedSearch, error) {
+	var savedSearch model.SavedSearch
+
+	query := `SELECT ` + savedSearchColumns + ` FROM saved_searches WHERE id=$1 AND user_id=$2`
+	err := s.db.QueryRow(query, savedSearchID, userID).Scan(
+		&savedSearch.ID,
+		&savedSearch.UserID,
+		&savedSearch.Title,
+		&savedSearch.Query,
+		&savedSearch.Status,
+		&savedSearch.CategoryID,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch saved search #%d: %v`, savedSearchID, err)
+	}
+
+	return &savedSearch, nil
+}
+
+// CreateSavedSearch saves a search query.
+func (s *Storage) CreateSavedSearch(savedSearch *model.SavedSearch) error {
+	query := `
+		INSERT INTO saved_searches
+			(user_id, title, query, status, category_id)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			id
+	`
+	err := s.db.QueryRow(
+		query,
+		savedSearch.UserID,
+		savedSearch.Title,
+		savedSearch.Query,
+		savedSearch.Status,
+		nullableCategoryID(savedSearch.CategoryID),
+	).Scan(&savedSearch.ID)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create saved search %q: %v`, savedSearch.Title, err)
+	}
+
+	return nil
+}
+
+// UpdateSavedSearch updates a saved search.
+func (s *Storage) UpdateSavedSearch(savedSearch *model.SavedSearch) error {
+	query := `UPDATE saved_searches SET title=$1, query=$2, status=$3, category_id=$4 WHERE id=$5 AND user_id=$6`
+	_, err := s.db.Exec(
+		query,
+		savedSearch.Title,
+		savedSearch.Query,
+		savedSearch.Status,
+		nullableCategoryID(savedSearch.CategoryID),
+		savedSearch.ID,
+		savedSearch.UserID,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to update saved search #%d: %v`, savedSearch.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveSavedSearch deletes a saved search.
+func (s *Storage) RemoveSavedSearch(userID, savedSearchID int64) error {
+	result, err := s.db.Exec(`DELETE FROM saved_searches WHERE id=$1 AND user_id=$2`, savedSearchID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove saved search #%d: %v`, savedSearchID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove saved search #%d: %v`, savedSearchID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no saved search has been removed`)
+	}
+
+	return nil
+}
+
+// MarkSavedSearchAsRead updates the unread entries of a saved search published before the given date to the read status.
+func (s *Storage) MarkSavedSearchAsRead(userID int64, savedSearch *model.SavedSearch, query *search.Query, before time.Time) error {
+	builder := s.NewEntryQueryBuilder(userID)
+	builder.WithSavedSearch(savedSearch, query)
+	builder.WithStatus(model.EntryStatusUnread)
+	builder.BeforeDate(before)
+
+	entryIDs, err := builder.GetEntryIDs()
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch the entries of saved search #%d: %v`, savedSearch.ID, err)
+	}
+
+	if len(entryIDs) == 0 {
+		return nil
+	}
+
+	result, err := s.db.Exec(
+		`UPDATE entries SET status=$1, snoozed_until=NULL, changed_at=now() WHERE user_id=$2 AND id=ANY($3)`,
+		model.EntryStatusRead,
+		userID,
+		pq.Array(entryIDs),
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to mark saved search entries as read: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	logger.Debug("[Storage:MarkSavedSearchAsRead] %d items marked as read", count)
+
+	return nil
+}
+
+func nullableCategoryID(categoryID int64) interface{} {
+	if categoryID > 0 {
+		return categoryID
+	}
+	return nil
+}
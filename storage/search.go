@@ -0,0 +1,245 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"strings"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/search"
+)
+
+// searchConditions translates a search query into SQL conditions and returns the updated query arguments.
+// The full-text expression is also returned to sort the results by relevance, it is empty without search terms.
+//
+// Each search term is converted with all the given text search configurations, because entries are indexed
+// with the configuration of their feed: a word matches the German and the French feeds of a user with
+// their own stemming, and the relevance is computed with the lexemes of the language of each entry.
+func searchConditions(query *search.Query, args []interface{}, configurations []string) ([]string, []interface{}, string) {
+	var conditions []string
+
+	placeholder := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	var configurationPlaceholders []string
+	if len(query.Text) > 0 {
+		for _, configuration := range configurations {
+			configurationPlaceholders = append(configurationPlaceholders, placeholder(configuration)+"::regconfig")
+		}
+	}
+
+	var groups []string
+	for _, group := range query.Text {
+		var terms []string
+		for _, term := range group {
+			function := "plainto_tsquery"
+			if term.Phrase {
+				function = "phraseto_tsquery"
+			}
+
+			value := placeholder(term.Value)
+			var variants []string
+			for _, configuration := range configurationPlaceholders {
+				variants = append(variants, fmt.Sprintf("%s(%s, %s)", function, configuration, value))
+			}
+
+			expression := strings.Join(variants, " || ")
+			if len(variants) > 1 {
+				expression = "(" + expression + ")"
+			}
+
+			if term.Negated {
+				expression = "!!" + expression
+			}
+
+			terms = append(terms, expression)
+		}
+
+		groups = append(groups, "("+strings.Join(terms, " || ")+")")
+	}
+
+	var tsquery string
+	if len(groups) > 0 {
+		tsquery = "(" + strings.Join(groups, " && ") + ")"
+		conditions = append(conditions, "e.document_vectors @@ "+tsquery)
+	}
+
+	for _, feed := range query.Feeds {
+		value := placeholder(feed)
+		conditions = append(conditions, fmt.Sprintf(
+			"e.feed_id IN (SELECT sf.id FROM feeds sf WHERE sf.user_id=e.user_id AND (sf.title ILIKE %s OR sf.id::text=%s))",
+			placeholder(containsPattern(feed)),
+			value,
+		))
+	}
+
+	for _, category := range query.Categories {
+		value := placeholder(category)
+		conditions = append(conditions, fmt.Sprintf(
+			"e.feed_id IN (SELECT sf.id FROM feeds sf JOIN categories sc ON sc.id=sf.category_id WHERE sf.user_id=e.user_id AND (sc.title ILIKE %s OR sc.id::text=%s))",
+			placeholder(containsPattern(category)),
+			value,
+		))
+	}
+
+	for _, author := range query.Authors {
+		conditions = append(conditions, "e.author ILIKE "+placeholder(containsPattern(author)))
+	}
+
+	if query.Status != "" {
+		conditions = append(conditions, "e.status = "+placeholder(query.Status))
+		if query.Status == model.EntryStatusUnread {
+			conditions = append(conditions, notSnoozedCondition)
+		}
+	}
+
+	if query.Starred {
+		conditions = append(conditions, "e.starred is true")
+	}
+
+	if query.After != nil {
+		conditions = append(conditions, "e.published_at >= "+placeholder(*query.After))
+	}
+
+	if query.Before != nil {
+		conditions = append(conditions, "e.published_at < "+placeholder(*query.Before))
+	}
+
+	return conditions, args, tsquery
+}
+
+// containsPattern returns a LIKE pattern matching the value anywhere, wildcards of the value are escaped.
+func containsPattern(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + replacer.Replace(value) + "%"
+}
+
+// textSearchConfigurations returns the text search configurations used to query the entries of a user:
+// the configuration of the user, followed by the other configurations of the feeds.
+func (s *Storage) textSearchConfigurations(userID int64) []string {
+	var setting, language string
+	err := s.db.QueryRow(`SELECT search_language, language FROM users WHERE id=$1`, userID).Scan(&setting, &language)
+	if err != nil {
+		logger.Error(`store: unable to fetch the search language of user #%d: %v`, userID, err)
+	}
+
+	configurations := []string{search.UserConfiguration(setting, language)}
+
+	rows, err := s.db.Query(`SELECT DISTINCT document_configuration::text FROM feeds WHERE user_id=$1`, userID)
+	if err != nil {
+		logger.Error(`store: unable to fetch the search languages of feeds: %v`, err)
+		return configurations
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var configuration string
+		if err := rows.Scan(&configuration); err != nil {
+			logger.Error(`store: unable to fetch the search languages of feeds: %v`, err)
+			break
+		}
+
+		configuration = strings.TrimPrefix(configuration, "pg_catalog.")
+		if configuration != configurations[0] {
+			configurations = append(configurations, configuration)
+		}
+	}
+
+	return configurations
+}
+
+// documentVectorsExpression returns the SQL expression that indexes the title and the content of an entry
+// with the text search configuration of its feed, the title has more weight than the content.
+// When entryID is not empty, the note and the highlights of the entry are indexed with a lower weight.
+func documentVectorsExpression(title, content, feedID, entryID string) string {
+	configuration := fmt.Sprintf("(SELECT document_configuration FROM feeds WHERE id=%s)", feedID)
+	expression := fmt.Sprintf(
+		"setweight(to_tsvector(%[1]s, substring(coalesce(%[2]s, '') for 1000000)), 'A') || setweight(to_tsvector(%[1]s, substring(coalesce(%[3]s, '') for 1000000)), 'B')",
+		configuration,
+		title,
+		content,
+	)
+
+	if entryID != "" {
+		expression += fmt.Sprintf(
+			" || setweight(to_tsvector(%[1]s, coalesce((SELECT string_agg(annotation, ' ') FROM (SELECT content AS annotation FROM entry_notes WHERE entry_id=%[2]s UNION ALL SELECT text FROM entry_highlights WHERE entry_id=%[2]s) AS annotations), '')), 'C')",
+			configuration,
+			entryID,
+		)
+	}
+
+	return expression
+}
+
+// updateDocumentConfigurations selects the text search configuration of the feeds of a user,
+// or of a single feed when feedID is not zero. The entries of a feed are indexed again when its configuration changes.
+func (s *Storage) updateDocumentConfigurations(userID, feedID int64) error {
+	query := `
+		SELECT
+			f.id,
+			f.search_language,
+			f.language,
+			f.document_configuration::text,
+			u.search_language,
+			u.language
+		FROM feeds f
+		JOIN users u ON u.id=f.user_id
+		WHERE
+			f.user_id=$1 AND ($2=0 OR f.id=$2)
+	`
+	rows, err := s.db.Query(query, userID, feedID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch feed search languages: %v`, err)
+	}
+
+	changes := make(map[int64]string)
+	for rows.Next() {
+		var id int64
+		var feedSetting, feedLanguage, current, userSetting, userLanguage string
+		if err := rows.Scan(&id, &feedSetting, &feedLanguage, &current, &userSetting, &userLanguage); err != nil {
+			rows.Close()
+			return fmt.Errorf(`store: unable to fetch feed search languages: %v`, err)
+		}
+
+		userConfiguration := search.UserConfiguration(userSetting, userLanguage)
+		configuration := search.FeedConfiguration(feedSetting, feedLanguage, userConfiguration)
+		if configuration != strings.TrimPrefix(current, "pg_catalog.") {
+			changes[id] = configuration
+		}
+	}
+	rows.Close()
+
+	for id, configuration := range changes {
+		if err := s.updateDocumentConfiguration(id, configuration); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Storage) updateDocumentConfiguration(feedID int64, configuration string) error {
+	tx, err := s.begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if _, err := tx.Exec(`UPDATE feeds SET document_configuration=$1::regconfig WHERE id=$2`, configuration, feedID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to update the search language of feed #%d: %v`, feedID, err)
+	}
+
+	query := `UPDATE entries SET document_vectors = ` + documentVectorsExpression("title", "content", "feed_id", "entries.id") + ` WHERE feed_id=$1`
+	if _, err := tx.Exec(query, feedID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to index again the entries of feed #%d: %v`, feedID, err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,26 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/crypto"
+)
+
+// Secret returns the value of an instance secret, a random value of the given size is stored the first time.
+func (s *Storage) Secret(name string, size int) ([]byte, error) {
+	query := `INSERT INTO secrets (name, value) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING`
+	if _, err := s.db.Exec(query, name, crypto.GenerateRandomBytes(size)); err != nil {
+		return nil, fmt.Errorf(`store: unable to create secret %q: %v`, name, err)
+	}
+
+	var value []byte
+	if err := s.db.QueryRow(`SELECT value FROM secrets WHERE name=$1`, name).Scan(&value); err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch secret %q: %v`, name, err)
+	}
+
+	return value, nil
+}
@@ -6,14 +6,75 @@ package storage // import "miniflux.app/storage"
 
 import (
 	"database/sql"
+	"fmt"
 )
 
+// querier is implemented by the connection pool and by transactions.
+type querier interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// transaction is implemented by *sql.Tx.
+type transaction interface {
+	querier
+	Commit() error
+	Rollback() error
+}
+
+// nestedTransaction is used when the storage already runs in a transaction,
+// the changes are committed or rolled back with the outer transaction.
+type nestedTransaction struct {
+	*sql.Tx
+}
+
+func (t nestedTransaction) Commit() error   { return nil }
+func (t nestedTransaction) Rollback() error { return nil }
+
 // Storage handles all operations related to the database.
 type Storage struct {
-	db *sql.DB
+	db querier
+
+	// pool is nil when the storage runs in a transaction.
+	pool *sql.DB
+	tx   *sql.Tx
 }
 
 // NewStorage returns a new Storage.
 func NewStorage(db *sql.DB) *Storage {
-	return &Storage{db}
+	return &Storage{db: db, pool: db}
+}
+
+// WithTransaction runs all the operations of the given function in a single transaction,
+// the transaction is rolled back if the function returns an error.
+func (s *Storage) WithTransaction(fn func(store *Storage) error) error {
+	if s.pool == nil {
+		return fn(s)
+	}
+
+	tx, err := s.pool.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if err := fn(&Storage{db: tx, tx: tx}); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// begin starts a transaction, or returns the current transaction when the storage already runs in one.
+func (s *Storage) begin() (transaction, error) {
+	if s.pool == nil {
+		return nestedTransaction{s.tx}, nil
+	}
+
+	return s.pool.Begin()
 }
@@ -0,0 +1,67 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"errors"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestWithTransactionRollsBackOnError(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	username := "transaction" + nextSuffix()
+	expectedErr := errors.New("import failed")
+
+	err := f.store.WithTransaction(func(tx *Storage) error {
+		if err := tx.CreateUser(&model.User{Username: username, Password: "test123"}); err != nil {
+			return err
+		}
+
+		if !tx.UserExists(username) {
+			t.Error("The user should be visible inside the transaction")
+		}
+
+		return expectedErr
+	})
+
+	if err != expectedErr {
+		t.Fatalf(`Unexpected error, got %v instead of %v`, err, expectedErr)
+	}
+
+	if f.store.UserExists(username) {
+		t.Error("The user should not be created when the transaction is rolled back")
+	}
+}
+
+func TestWithTransactionCommits(t *testing.T) {
+	f := newRetentionFixture(t)
+	defer f.close()
+
+	username := "transaction" + nextSuffix()
+	err := f.store.WithTransaction(func(tx *Storage) error {
+		return tx.CreateUser(&model.User{Username: username, Password: "test123"})
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := f.store.UserByUsername(username)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user == nil {
+		t.Fatal("The user should be created when the transaction is committed")
+	}
+
+	f.store.RemoveUser(user.ID)
+}
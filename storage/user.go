@@ -64,7 +64,7 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		VALUES
 			(LOWER($1), $2, $3, $4)
 		RETURNING
-			id, username, is_admin, language, theme, timezone, entry_direction, keyboard_shortcuts
+			id, username, is_admin, language, theme, timezone, entry_direction, keyboard_shortcuts, search_language
 	`
 
 	err = s.db.QueryRow(query, user.Username, password, user.IsAdmin, extra).Scan(
@@ -76,6 +76,7 @@ func (s *Storage) CreateUser(user *model.User) (err error) {
 		&user.Timezone,
 		&user.EntryDirection,
 		&user.KeyboardShortcuts,
+		&user.SearchLanguage,
 	)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create user: %v`, err)
@@ -123,9 +124,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				language=$5,
 				timezone=$6,
 				entry_direction=$7,
-				keyboard_shortcuts=$8
+				keyboard_shortcuts=$8,
+				search_language=$9
 			WHERE
-				id=$9
+				id=$10
 		`
 
 		_, err = s.db.Exec(
@@ -138,6 +140,7 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.Timezone,
 			user.EntryDirection,
 			user.KeyboardShortcuts,
+			user.SearchLanguage,
 			user.ID,
 		)
 		if err != nil {
@@ -152,9 +155,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				language=$4,
 				timezone=$5,
 				entry_direction=$6,
-				keyboard_shortcuts=$7
+				keyboard_shortcuts=$7,
+				search_language=$8
 			WHERE
-				id=$8
+				id=$9
 		`
 
 		_, err := s.db.Exec(
@@ -166,6 +170,7 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.Timezone,
 			user.EntryDirection,
 			user.KeyboardShortcuts,
+			user.SearchLanguage,
 			user.ID,
 		)
 
@@ -174,7 +179,8 @@ func (s *Storage) UpdateUser(user *model.User) error {
 		}
 	}
 
-	return nil
+	// The language of the user is used to index the entries of the feeds without language.
+	return s.updateDocumentConfigurations(user.ID, 0)
 }
 
 // UserLanguage returns the language of the given user.
@@ -199,6 +205,7 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			timezone,
 			entry_direction,
 			keyboard_shortcuts,
+			search_language,
 			last_login_at,
 			extra
 		FROM
@@ -221,6 +228,7 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			timezone,
 			entry_direction,
 			keyboard_shortcuts,
+			search_language,
 			last_login_at,
 			extra
 		FROM
@@ -243,6 +251,7 @@ func (s *Storage) UserByExtraField(field, value string) (*model.User, error) {
 			timezone,
 			entry_direction,
 			keyboard_shortcuts,
+			search_language,
 			last_login_at,
 			extra
 		FROM
@@ -266,6 +275,7 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.Timezone,
 		&user.EntryDirection,
 		&user.KeyboardShortcuts,
+		&user.SearchLanguage,
 		&user.LastLoginAt,
 		&extra,
 	)
@@ -287,7 +297,7 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 
 // RemoveUser deletes a user.
 func (s *Storage) RemoveUser(userID int64) error {
-	ts, err := s.db.Begin()
+	ts, err := s.begin()
 	if err != nil {
 		return fmt.Errorf(`store: unable to start transaction: %v`, err)
 	}
@@ -321,6 +331,7 @@ func (s *Storage) Users() (model.Users, error) {
 			timezone,
 			entry_direction,
 			keyboard_shortcuts,
+			search_language,
 			last_login_at,
 			extra
 		FROM
@@ -346,6 +357,7 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.Timezone,
 			&user.EntryDirection,
 			&user.KeyboardShortcuts,
+			&user.SearchLanguage,
 			&user.LastLoginAt,
 			&extra,
 		)
@@ -0,0 +1,108 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package syndication // import "miniflux.app/syndication"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Href   string `xml:"href,attr"`
+	Length string `xml:"length,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Updated    string         `xml:"updated"`
+	Published  string         `xml:"published"`
+	Author     *atomPerson    `xml:"author,omitempty"`
+	Links      []atomLink     `xml:"link"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+func serializeAtom(f *feed) ([]byte, error) {
+	document := &atomFeed{
+		ID:      f.feedID(),
+		Title:   f.title,
+		Updated: f.updated.Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: f.feedURL},
+			{Rel: "alternate", Type: "text/html", Href: f.siteURL},
+		},
+	}
+
+	for _, entry := range f.entries {
+		item := atomEntry{
+			ID:        f.entryID(entry),
+			Title:     entry.Title,
+			Updated:   entry.Date.Format(time.RFC3339),
+			Published: entry.Date.Format(time.RFC3339),
+			Links:     []atomLink{{Rel: "alternate", Type: "text/html", Href: entry.URL}},
+			Content:   atomContent{Type: "html", Value: entry.Content},
+		}
+
+		if author := entryAuthor(entry); author != "" {
+			item.Author = &atomPerson{Name: author}
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			link := atomLink{Rel: "enclosure", Type: enclosure.MimeType, Href: enclosure.URL}
+			if enclosure.Size > 0 {
+				link.Length = strconv.FormatInt(enclosure.Size, 10)
+			}
+			item.Links = append(item.Links, link)
+		}
+
+		for _, tag := range entry.Tags {
+			item.Categories = append(item.Categories, atomCategory{Term: tag})
+		}
+
+		document.Entries = append(document.Entries, item)
+	}
+
+	return marshalXML(document)
+}
+
+func marshalXML(document interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&b)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
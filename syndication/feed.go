@@ -0,0 +1,50 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package syndication // import "miniflux.app/syndication"
+
+import (
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// Supported output formats.
+const (
+	FormatAtom = "atom"
+	FormatRSS  = "rss"
+	FormatJSON = "json"
+)
+
+type feed struct {
+	// tagPrefix is used to build stable tag URIs (RFC 4151) for the feed and its entries.
+	tagPrefix string
+	id        int64
+	title     string
+	siteURL   string
+	feedURL   string
+	updated   time.Time
+	entries   model.Entries
+}
+
+func (f *feed) feedID() string {
+	return fmt.Sprintf("%sfeed/%d", f.tagPrefix, f.id)
+}
+
+func (f *feed) entryID(entry *model.Entry) string {
+	return fmt.Sprintf("%sentry/%d", f.tagPrefix, entry.ID)
+}
+
+func entryAuthor(entry *model.Entry) string {
+	if entry.Author != "" {
+		return entry.Author
+	}
+
+	if entry.Feed != nil {
+		return entry.Feed.Title
+	}
+
+	return ""
+}
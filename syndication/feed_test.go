@@ -0,0 +1,174 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package syndication // import "miniflux.app/syndication"
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+	"miniflux.app/reader/parser"
+)
+
+func newTestFeed() *feed {
+	date := time.Date(2019, time.March, 2, 10, 30, 0, 0, time.UTC)
+	return &feed{
+		tagPrefix: "tag:example.org,2019-01-01:",
+		id:        3,
+		title:     "Starred articles",
+		siteURL:   "https://example.org/",
+		feedURL:   "https://example.org/syndication/token/atom",
+		updated:   date,
+		entries: model.Entries{
+			&model.Entry{
+				ID:      42,
+				Title:   "Episode 1",
+				URL:     "https://example.com/episode-1",
+				Content: "<p>Hello &amp; welcome</p>",
+				Date:    date,
+				Tags:    []string{"audio", "interview"},
+				Feed:    &model.Feed{Title: "Podcast"},
+				Enclosures: model.EnclosureList{
+					&model.Enclosure{URL: "https://example.com/episode-1.mp3", MimeType: "audio/mpeg", Size: 1234},
+				},
+			},
+		},
+	}
+}
+
+func TestSerializeAtom(t *testing.T) {
+	data, err := serializeAtom(newTestFeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, parseErr := parser.ParseFeed(string(data))
+	if parseErr != nil {
+		t.Fatalf(`Generated Atom feed is not valid: %v`, parseErr)
+	}
+
+	if feed.Title != "Starred articles" {
+		t.Errorf(`Unexpected feed title, got %q`, feed.Title)
+	}
+
+	if len(feed.Entries) != 1 {
+		t.Fatalf(`Unexpected number of entries, got %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.URL != "https://example.com/episode-1" {
+		t.Errorf(`Unexpected entry URL, got %q`, entry.URL)
+	}
+
+	if entry.Author != "Podcast" {
+		t.Errorf(`The feed title should be used as author, got %q`, entry.Author)
+	}
+
+	if !entry.Date.Equal(newTestFeed().updated) {
+		t.Errorf(`Unexpected entry date, got %v`, entry.Date)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].Size != 1234 {
+		t.Errorf(`Enclosure is missing or incorrect: %v`, entry.Enclosures)
+	}
+
+	if len(entry.Tags) != 2 || entry.Tags[0] != "audio" || entry.Tags[1] != "interview" {
+		t.Errorf(`Tags are missing or incorrect: %q`, entry.Tags)
+	}
+
+	if !strings.Contains(string(data), "<id>tag:example.org,2019-01-01:entry/42</id>") {
+		t.Errorf(`Entry ID is missing: %s`, data)
+	}
+}
+
+func TestSerializeRSS(t *testing.T) {
+	data, err := serializeRSS(newTestFeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, parseErr := parser.ParseFeed(string(data))
+	if parseErr != nil {
+		t.Fatalf(`Generated RSS feed is not valid: %v`, parseErr)
+	}
+
+	if len(feed.Entries) != 1 {
+		t.Fatalf(`Unexpected number of entries, got %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Content != "<p>Hello &amp; welcome</p>" {
+		t.Errorf(`Unexpected entry content, got %q`, entry.Content)
+	}
+
+	if !entry.Date.Equal(newTestFeed().updated) {
+		t.Errorf(`Unexpected entry date, got %v`, entry.Date)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].MimeType != "audio/mpeg" {
+		t.Errorf(`Enclosure is missing or incorrect: %v`, entry.Enclosures)
+	}
+
+	if len(entry.Tags) != 2 || entry.Tags[0] != "audio" || entry.Tags[1] != "interview" {
+		t.Errorf(`Tags are missing or incorrect: %q`, entry.Tags)
+	}
+
+	if !strings.Contains(string(data), `<guid isPermaLink="false">tag:example.org,2019-01-01:entry/42</guid>`) {
+		t.Errorf(`Entry GUID is missing: %s`, data)
+	}
+}
+
+func TestSerializeJSON(t *testing.T) {
+	data, err := serializeJSON(newTestFeed())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var document jsonFeed
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatal(err)
+	}
+
+	if document.Version != "https://jsonfeed.org/version/1" {
+		t.Errorf(`Unexpected version, got %q`, document.Version)
+	}
+
+	if len(document.Items) != 1 {
+		t.Fatalf(`Unexpected number of items, got %d`, len(document.Items))
+	}
+
+	item := document.Items[0]
+	if item.ID != "tag:example.org,2019-01-01:entry/42" {
+		t.Errorf(`Unexpected item ID, got %q`, item.ID)
+	}
+
+	if item.DatePublished != "2019-03-02T10:30:00Z" {
+		t.Errorf(`Unexpected item date, got %q`, item.DatePublished)
+	}
+
+	if len(item.Attachments) != 1 || item.Attachments[0].SizeInBytes != 1234 {
+		t.Errorf(`Attachment is missing or incorrect: %v`, item.Attachments)
+	}
+
+	if len(item.Tags) != 2 || item.Tags[0] != "audio" {
+		t.Errorf(`Tags are missing or incorrect: %q`, item.Tags)
+	}
+}
+
+func TestSerializeJSONWithoutEntries(t *testing.T) {
+	f := newTestFeed()
+	f.entries = nil
+
+	data, err := serializeJSON(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(data), `"items": []`) {
+		t.Errorf(`Items should be an empty list: %s`, data)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package syndication // import "miniflux.app/syndication"
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+	"miniflux.app/url"
+
+	"github.com/gorilla/mux"
+)
+
+// Serve declares the routes of published feeds. They are authenticated only by their secret token.
+func Serve(router *mux.Router, store *storage.Storage) {
+	handler := &handler{store, router}
+	router.HandleFunc("/syndication/{token}/{format:atom|rss|json}", handler.showFeed).Name("publishedFeed").Methods("GET")
+}
+
+type handler struct {
+	store  *storage.Storage
+	router *mux.Router
+}
+
+func (h *handler) showFeed(w http.ResponseWriter, r *http.Request) {
+	token := request.RouteStringParam(r, "token")
+	format := request.RouteStringParam(r, "format")
+
+	publishedFeed, err := h.store.PublishedFeedByToken(token)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if publishedFeed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(publishedFeed.UserID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	switch publishedFeed.Source {
+	case model.PublishedFeedSourceStarred:
+		builder.WithStarred()
+	case model.PublishedFeedSourceCategory:
+		builder.WithCategoryID(publishedFeed.CategoryID)
+	case model.PublishedFeedSourceTag:
+		builder.WithTag(publishedFeed.Tag)
+	}
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection("desc")
+	builder.WithLimit(model.PublishedFeedMaxEntries)
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	// The creation date is used when there is no entry to keep the ETag stable.
+	updated := publishedFeed.CreatedAt
+	for _, entry := range entries {
+		entry.Enclosures, err = h.store.GetEnclosures(entry.ID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		if entry.Date.After(updated) {
+			updated = entry.Date
+		}
+	}
+
+	f := &feed{
+		tagPrefix: tagPrefix(publishedFeed.CreatedAt),
+		id:        publishedFeed.ID,
+		title:     publishedFeed.Title,
+		siteURL:   config.Opts.BaseURL(),
+		feedURL:   config.Opts.RootURL() + route.Path(h.router, "publishedFeed", "token", token, "format", format),
+		updated:   updated,
+		entries:   entries,
+	}
+
+	var body []byte
+	var contentType string
+
+	switch format {
+	case FormatAtom:
+		contentType = "application/atom+xml; charset=utf-8"
+		body, err = serializeAtom(f)
+	case FormatRSS:
+		contentType = "application/rss+xml; charset=utf-8"
+		body, err = serializeRSS(f)
+	case FormatJSON:
+		contentType = "application/feed+json; charset=utf-8"
+		body, err = serializeJSON(f)
+	}
+
+	if err != nil {
+		logger.Error("[Syndication] Unable to generate feed #%d: %v", publishedFeed.ID, err)
+		html.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).WithCaching(crypto.HashFromBytes(body), 5*time.Minute, func(b *response.Builder) {
+		b.WithHeader("Content-Type", contentType)
+		b.WithBody(body)
+		b.Write()
+	})
+}
+
+// tagPrefix returns the beginning of a tag URI minted by this instance at the given date.
+func tagPrefix(date time.Time) string {
+	host := url.Domain(config.Opts.BaseURL())
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	return fmt.Sprintf("tag:%s,%s:", host, date.Format("2006-01-02"))
+}
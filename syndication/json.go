@@ -0,0 +1,76 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package syndication // import "miniflux.app/syndication"
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type jsonFeed struct {
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	HomePageURL string     `json:"home_page_url"`
+	FeedURL     string     `json:"feed_url"`
+	Items       []jsonItem `json:"items"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+type jsonItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentHTML   string           `json:"content_html"`
+	DatePublished string           `json:"date_published"`
+	Author        *jsonAuthor      `json:"author,omitempty"`
+	Attachments   []jsonAttachment `json:"attachments,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+func serializeJSON(f *feed) ([]byte, error) {
+	document := &jsonFeed{
+		Version:     "https://jsonfeed.org/version/1",
+		Title:       f.title,
+		HomePageURL: f.siteURL,
+		FeedURL:     f.feedURL,
+		Items:       make([]jsonItem, 0, len(f.entries)),
+	}
+
+	for _, entry := range f.entries {
+		item := jsonItem{
+			ID:            f.entryID(entry),
+			URL:           entry.URL,
+			Title:         entry.Title,
+			ContentHTML:   entry.Content,
+			DatePublished: entry.Date.Format(time.RFC3339),
+			Tags:          entry.Tags,
+		}
+
+		if author := entryAuthor(entry); author != "" {
+			item.Author = &jsonAuthor{Name: author}
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			item.Attachments = append(item.Attachments, jsonAttachment{
+				URL:         enclosure.URL,
+				MimeType:    enclosure.MimeType,
+				SizeInBytes: enclosure.Size,
+			})
+		}
+
+		document.Items = append(document.Items, item)
+	}
+
+	return json.MarshalIndent(document, "", "  ")
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package syndication // import "miniflux.app/syndication"
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	SelfLink      atomLink  `xml:"http://www.w3.org/2005/Atom link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description"`
+	Comments    string        `xml:"comments,omitempty"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Categories  []string      `xml:"category"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+func serializeRSS(f *feed) ([]byte, error) {
+	document := &rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         f.title,
+			Link:          f.siteURL,
+			Description:   f.title,
+			LastBuildDate: f.updated.Format(time.RFC1123Z),
+			SelfLink:      atomLink{Rel: "self", Type: "application/rss+xml", Href: f.feedURL},
+		},
+	}
+
+	for _, entry := range f.entries {
+		item := rssItem{
+			Title:       entry.Title,
+			Link:        entry.URL,
+			Description: entry.Content,
+			Comments:    entry.CommentsURL,
+			GUID:        rssGUID{IsPermaLink: "false", Value: f.entryID(entry)},
+			PubDate:     entry.Date.Format(time.RFC1123Z),
+			Categories:  entry.Tags,
+		}
+
+		// RSS 2.0 allows only one enclosure per item.
+		if len(entry.Enclosures) > 0 {
+			enclosure := entry.Enclosures[0]
+			item.Enclosure = &rssEnclosure{URL: enclosure.URL, Length: enclosure.Size, Type: enclosure.MimeType}
+		}
+
+		document.Channel.Items = append(document.Channel.Items, item)
+	}
+
+	return marshalXML(document)
+}
@@ -83,20 +83,57 @@ var templateCommonMap = map[string]string{
     <li>
         <a href="{{ route "feeds" }}">{{ t "menu.feeds" }}</a>
     </li>
+    <li>
+        <a href="{{ route "savedSearches" }}">{{ t "menu.saved_searches" }}</a>
+    </li>
     <li>
         <a href="{{ route "addSubscription" }}">{{ t "menu.add_feed" }}</a>
     </li>
+    <li>
+        <a href="{{ route "scrapeSubscription" }}">{{ t "menu.scrape_page" }}</a>
+    </li>
     <li>
         <a href="{{ route "export" }}">{{ t "menu.export" }}</a>
     </li>
     <li>
         <a href="{{ route "import" }}">{{ t "menu.import" }}</a>
     </li>
+    <li>
+        <a href="{{ route "opmlSubscriptions" }}">{{ t "menu.opml_subscriptions" }}</a>
+    </li>
     <li>
         <a href="{{ route "refreshAllFeeds" }}">{{ t "menu.refresh_all_feeds" }}</a>
     </li>
 </ul>
 {{ end }}`,
+	"integration_rules": `{{ define "integration_rules" }}
+<details>
+    <summary>{{ t "form.integration.automatic_delivery" }}</summary>
+    <div class="details-content">
+        <label>
+            <input type="checkbox" name="{{ .service }}_auto_bookmark" value="1" {{ if .rule.OnBookmark }}checked{{ end }}> {{ t "form.integration.automatic_on_bookmark" }}
+        </label>
+
+        <label for="form-{{ .service }}-auto-category-ids">{{ t "form.integration.automatic_categories" }}</label>
+        <select id="form-{{ .service }}-auto-category-ids" name="{{ .service }}_auto_category_ids" multiple>
+            {{ range .categories }}
+                <option value="{{ .ID }}" {{ if $.rule.HasCategory .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+            {{ end }}
+        </select>
+
+        <label for="form-{{ .service }}-auto-feed-ids">{{ t "form.integration.automatic_feeds" }}</label>
+        <select id="form-{{ .service }}-auto-feed-ids" name="{{ .service }}_auto_feed_ids" multiple>
+            {{ range .feeds }}
+                <option value="{{ .ID }}" {{ if $.rule.HasFeed .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+            {{ end }}
+        </select>
+
+        <label for="form-{{ .service }}-auto-keywords">{{ t "form.integration.automatic_keywords" }}</label>
+        <input type="text" name="{{ .service }}_auto_keywords" id="form-{{ .service }}-auto-keywords" value="{{ .rule.Keywords }}" placeholder="golang, postgresql">
+    </div>
+</details>
+{{ end }}
+`,
 	"item_meta": `{{ define "item_meta" }}
 <div class="item-meta">
     <ul>
@@ -137,6 +174,21 @@ var templateCommonMap = map[string]string{
                 data-value="{{ if .entry.Starred }}star{{ else }}unstar{{ end }}"
                 >{{ if .entry.Starred }}★&nbsp;{{ t "entry.bookmark.toggle.off" }}{{ else }}☆&nbsp;{{ t "entry.bookmark.toggle.on" }}{{ end }}</a>
         </li>
+        {{ if .entry.SnoozedUntil }}
+            <li>
+                <time datetime="{{ isodate .entry.SnoozedUntil }}">{{ t "page.entry.snoozed_until" (isodate .entry.SnoozedUntil) }}</time>
+            </li>
+        {{ else if eq .entry.Status "unread" }}
+            <li>
+                <a href="#"
+                    title="{{ t "entry.snooze.title" }}"
+                    data-snooze-entry="true"
+                    data-snooze-url="{{ route "snoozeEntries" }}"
+                    data-value="tomorrow"
+                    data-toast-done="{{ t "entry.snooze.toast" }}"
+                    >{{ t "entry.snooze.label" }}</a>
+            </li>
+        {{ end }}
         <li>
             <a href="#"
                 title="{{ t "entry.status.title" }}"
@@ -239,7 +291,7 @@ var templateCommonMap = map[string]string{
                     <a href="#" data-action="search">&laquo;&nbsp;{{ t "search.label" }}</a>
                 </div>
                 <form action="{{ route "searchEntries" }}" class="search-form {{ if $.searchQuery }}has-search-query{{ end }}">
-                    <input type="search" name="q" id="search-input" placeholder="{{ t "search.placeholder" }}" {{ if $.searchQuery }}value="{{ .searchQuery }}"{{ end }} required>
+                    <input type="search" name="q" id="search-input" placeholder="{{ t "search.placeholder" }}" title="{{ t "search.syntax" }}" {{ if $.searchQuery }}value="{{ .searchQuery }}"{{ end }} required>
                 </form>
             </div>
         </nav>
@@ -296,6 +348,7 @@ var templateCommonMap = map[string]string{
                     <li>{{ t "page.keyboard_shortcuts.download_content" }} = <strong>d</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.toggle_bookmark_status" }} = <strong>f</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.save_article" }} = <strong>s</strong></li>
+                    <li>{{ t "page.keyboard_shortcuts.snooze_entry" }} = <strong>z</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.remove_feed" }} = <strong>#</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.go_to_search" }} = <strong>/</strong></li>
                     <li>{{ t "page.keyboard_shortcuts.close_modal" }} = <strong>Esc</strong></li>
@@ -326,6 +379,24 @@ var templateCommonMap = map[string]string{
     </div>
 </div>
 {{ end }}
+`,
+	"retention_policy": `{{ define "retention_policy" }}
+<details {{ if or .ArchiveReadDays .MaxEntries .MarkAsReadDays }}open{{ end }}>
+    <summary>{{ t "form.retention.title" }}</summary>
+    <div class="details-content">
+        <p class="form-help">{{ t "form.retention.help" }}</p>
+
+        <label for="form-archive-read-days">{{ t "form.retention.label.archive_read_days" }}</label>
+        <input type="number" name="archive_read_days" id="form-archive-read-days" min="-1" value="{{ if .ArchiveReadDays }}{{ .ArchiveReadDays }}{{ end }}">
+
+        <label for="form-max-entries">{{ t "form.retention.label.max_entries" }}</label>
+        <input type="number" name="max_entries" id="form-max-entries" min="-1" value="{{ if .MaxEntries }}{{ .MaxEntries }}{{ end }}">
+
+        <label for="form-mark-as-read-days">{{ t "form.retention.label.mark_as_read_days" }}</label>
+        <input type="number" name="mark_as_read_days" id="form-mark-as-read-days" min="-1" value="{{ if .MarkAsReadDays }}{{ .MarkAsReadDays }}{{ end }}">
+    </div>
+</details>
+{{ end }}
 `,
 	"settings_menu": `{{ define "settings_menu" }}
 <ul>
@@ -335,6 +406,15 @@ var templateCommonMap = map[string]string{
     <li>
         <a href="{{ route "integrations" }}">{{ t "menu.integrations" }}</a>
     </li>
+    <li>
+        <a href="{{ route "digest" }}">{{ t "menu.digest" }}</a>
+    </li>
+    <li>
+        <a href="{{ route "publishedFeeds" }}">{{ t "menu.published_feeds" }}</a>
+    </li>
+    <li>
+        <a href="{{ route "sharedEntries" }}">{{ t "menu.shared_entries" }}</a>
+    </li>
     <li>
         <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
     </li>
@@ -354,11 +434,13 @@ var templateCommonMap = map[string]string{
 }
 
 var templateCommonMapChecksums = map[string]string{
-	"entry_pagination": "4faa91e2eae150c5e4eab4d258e039dfdd413bab7602f0009360e6d52898e353",
-	"feed_list":        "db406e7cb81292ce1d974d63f63270384a286848b2e74fe36bf711b4eb5717dd",
-	"feed_menu":        "318d8662dda5ca9dfc75b909c8461e79c86fb5082df1428f67aaf856f19f4b50",
-	"item_meta":        "d046305e8935ecd8643a94d28af384df29e40fc7ce334123cd057a6522bac23f",
-	"layout":           "a1f67b8908745ee4f9cee6f7bbbb0b242d4dcc101207ad4a9d67242b45683299",
-	"pagination":       "3386e90c6e1230311459e9a484629bc5d5bf39514a75ef2e73bbbc61142f7abb",
-	"settings_menu":    "78e5a487ede18610b23db74184dab023170f9e083cc0625bc2c874d1eea1a4ce",
+	"entry_pagination":  "4faa91e2eae150c5e4eab4d258e039dfdd413bab7602f0009360e6d52898e353",
+	"feed_list":         "db406e7cb81292ce1d974d63f63270384a286848b2e74fe36bf711b4eb5717dd",
+	"feed_menu":         "92fa636860de5aca062f47afa2cf8886b8776629f54816adb6a168ad2e047bbe",
+	"integration_rules": "8fea833191a30cc0026eb8d5d28ec76c643462571ed4e87eb3ad58d9b5020743",
+	"item_meta":         "f6fc38d4a2ec3bb9288cfbb1c6e3294dccbe93aa98d6e813fef73fb2f29910e2",
+	"layout":            "9802036a08df9addd0ce867e29107a7b8ce7e249b48808b597bd13ca2efb8061",
+	"pagination":        "3386e90c6e1230311459e9a484629bc5d5bf39514a75ef2e73bbbc61142f7abb",
+	"retention_policy":  "d56554fba90ca3050e235b6f83cf1421527ac4fb1451021ab4abacd540b59862",
+	"settings_menu":     "6c5bc60f702b3d316e778f5c181cd55ad8e0a2d86560249e89402924397f0d7c",
 }
@@ -0,0 +1,71 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package template // import "miniflux.app/template"
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	texttemplate "text/template"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/locale"
+	"miniflux.app/url"
+)
+
+// RenderEmail process an email template and returns its HTML and plain text versions.
+// Each email template defines a "html" and a "text" block.
+func RenderEmail(name, language string, data interface{}) (htmlBody, textBody []byte, err error) {
+	content, ok := templateEmailsMap[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("template: the email template %s does not exists", name)
+	}
+
+	funcs := emailFuncMap(locale.NewPrinter(language))
+
+	htmlTemplate, err := template.New(name).Funcs(funcs).Parse(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("template: unable to parse email template %s: %v", name, err)
+	}
+
+	textTemplate, err := texttemplate.New(name).Funcs(texttemplate.FuncMap(funcs)).Parse(content)
+	if err != nil {
+		return nil, nil, fmt.Errorf("template: unable to parse email template %s: %v", name, err)
+	}
+
+	var htmlBuffer, textBuffer bytes.Buffer
+	if err := htmlTemplate.ExecuteTemplate(&htmlBuffer, "html", data); err != nil {
+		return nil, nil, fmt.Errorf("template: unable to render email template %s: %v", name, err)
+	}
+
+	if err := textTemplate.ExecuteTemplate(&textBuffer, "text", data); err != nil {
+		return nil, nil, fmt.Errorf("template: unable to render email template %s: %v", name, err)
+	}
+
+	return htmlBuffer.Bytes(), textBuffer.Bytes(), nil
+}
+
+func emailFuncMap(printer *locale.Printer) template.FuncMap {
+	return template.FuncMap{
+		"dict":     dict,
+		"truncate": truncate,
+		"baseURL": func() string {
+			return config.Opts.BaseURL()
+		},
+		"domain": func(websiteURL string) string {
+			return url.Domain(websiteURL)
+		},
+		"isodate": func(ts time.Time) string {
+			return ts.Format("2006-01-02 15:04")
+		},
+		"t": func(key string, args ...interface{}) string {
+			return printer.Printf(key, args...)
+		},
+		"plural": func(key string, n int, args ...interface{}) string {
+			return printer.Plural(key, n, args...)
+		},
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"miniflux.app/http/route"
 	"miniflux.app/locale"
 	"miniflux.app/model"
+	"miniflux.app/proxy"
 	"miniflux.app/timezone"
 	"miniflux.app/url"
 
@@ -32,6 +33,7 @@ type funcMap struct {
 func (f *funcMap) Map() template.FuncMap {
 	return template.FuncMap{
 		"formatFileSize": formatFileSize,
+		"formatDuration": formatDuration,
 		"dict":           dict,
 		"hasKey":         hasKey,
 		"truncate":       truncate,
@@ -202,7 +204,7 @@ func imageProxyFilter(router *mux.Router, data string) string {
 
 func proxify(router *mux.Router, link string) string {
 	// We use base64 url encoding to avoid slash in the URL.
-	return route.Path(router, "proxy", "encodedURL", base64.URLEncoding.EncodeToString([]byte(link)))
+	return route.Path(router, "proxy", "encodedDigest", proxy.Sign(link), "encodedURL", base64.URLEncoding.EncodeToString([]byte(link)))
 }
 
 func formatFileSize(b int64) string {
@@ -218,3 +220,12 @@ func formatFileSize(b int64) string {
 	return fmt.Sprintf("%.1f %ciB",
 		float64(b)/float64(div), "KMGTPE"[exp])
 }
+
+func formatDuration(seconds float64) string {
+	total := int64(seconds)
+	hours, minutes, secs := total/3600, (total%3600)/60, total%60
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, secs)
+	}
+	return fmt.Sprintf("%d:%02d", minutes, secs)
+}
@@ -47,6 +47,10 @@ var templateViewsMap = map[string]string{
             <div class="alert alert-error">{{ t .errorMessage }}</div>
         {{ end }}
 
+        {{ if .suggestScraper }}
+            <p class="alert"><a href="{{ route "scrapeSubscription" }}?url={{ .form.URL }}">{{ t "page.add_feed.scrape_suggestion" }}</a></p>
+        {{ end }}
+
         <label for="form-url">{{ t "page.add_feed.label.url" }}</label>
         <input type="url" name="url" id="form-url" placeholder="https://domain.tld/" value="{{ .form.URL }}" required autofocus>
 
@@ -345,6 +349,52 @@ var templateViewsMap = map[string]string{
     </div>
 </form>
 {{ end }}
+`,
+	"create_saved_search": `{{ define "title"}}{{ t "page.new_saved_search.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_saved_search.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "savedSearches" }}">{{ t "menu.saved_searches" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "saveSavedSearch" }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-title">{{ t "form.saved_search.label.title" }}</label>
+    <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
+
+    <label for="form-query">{{ t "form.saved_search.label.query" }}</label>
+    <input type="text" name="query" id="form-query" value="{{ .form.Query }}" title="{{ t "search.syntax" }}" required>
+
+    <label for="form-status">{{ t "form.saved_search.label.status" }}</label>
+    <select id="form-status" name="status">
+        <option value="" {{ if eq "" .form.Status }}selected="selected"{{ end }}>{{ t "form.saved_search.select.all_entries" }}</option>
+        <option value="unread" {{ if eq "unread" .form.Status }}selected="selected"{{ end }}>{{ t "form.saved_search.select.unread_entries" }}</option>
+        <option value="read" {{ if eq "read" .form.Status }}selected="selected"{{ end }}>{{ t "form.saved_search.select.read_entries" }}</option>
+    </select>
+
+    <label for="form-category">{{ t "form.saved_search.label.category" }}</label>
+    <select id="form-category" name="category_id">
+        <option value="0">{{ t "form.saved_search.select.all_categories" }}</option>
+    {{ range .categories }}
+        <option value="{{ .ID }}" {{ if eq .ID $.form.CategoryID }}selected="selected"{{ end }}>{{ .Title }}</option>
+    {{ end }}
+    </select>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "savedSearches" }}">{{ t "action.cancel" }}</a>
+    </div>
+</form>
+{{ end }}
 `,
 	"create_user": `{{ define "title"}}{{ t "page.new_user.title" }}{{ end }}
 
@@ -377,6 +427,71 @@ var templateViewsMap = map[string]string{
     </div>
 </form>
 {{ end }}
+`,
+	"digest": `{{ define "title"}}{{ t "page.digest.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.digest.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+{{ if not .hasSMTPConfigured }}
+    <p class="alert">{{ t "page.digest.smtp_not_configured" }}</p>
+{{ end }}
+
+<form method="post" autocomplete="off" action="{{ route "updateDigest" }}">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label><input type="checkbox" name="enabled" value="1" {{ if .form.Enabled }}checked{{ end }}> {{ t "form.digest.label.enabled" }}</label>
+
+    <label for="form-email">{{ t "form.digest.label.email" }}</label>
+    <input type="email" name="email" id="form-email" value="{{ .form.Email }}" placeholder="name@example.org">
+
+    <label for="form-frequency">{{ t "form.digest.label.frequency" }}</label>
+    <select id="form-frequency" name="frequency">
+        <option value="daily" {{ if eq "daily" .form.Frequency }}selected="selected"{{ end }}>{{ t "form.digest.select.daily" }}</option>
+        <option value="weekly" {{ if eq "weekly" .form.Frequency }}selected="selected"{{ end }}>{{ t "form.digest.select.weekly" }}</option>
+    </select>
+
+    <label for="form-weekday">{{ t "form.digest.label.weekday" }}</label>
+    <select id="form-weekday" name="weekday">
+        <option value="1" {{ if eq 1 .form.Weekday }}selected="selected"{{ end }}>{{ t "weekday.monday" }}</option>
+        <option value="2" {{ if eq 2 .form.Weekday }}selected="selected"{{ end }}>{{ t "weekday.tuesday" }}</option>
+        <option value="3" {{ if eq 3 .form.Weekday }}selected="selected"{{ end }}>{{ t "weekday.wednesday" }}</option>
+        <option value="4" {{ if eq 4 .form.Weekday }}selected="selected"{{ end }}>{{ t "weekday.thursday" }}</option>
+        <option value="5" {{ if eq 5 .form.Weekday }}selected="selected"{{ end }}>{{ t "weekday.friday" }}</option>
+        <option value="6" {{ if eq 6 .form.Weekday }}selected="selected"{{ end }}>{{ t "weekday.saturday" }}</option>
+        <option value="0" {{ if eq 0 .form.Weekday }}selected="selected"{{ end }}>{{ t "weekday.sunday" }}</option>
+    </select>
+
+    <label for="form-hour">{{ t "form.digest.label.hour" }}</label>
+    <input type="number" name="hour" id="form-hour" value="{{ .form.Hour }}" min="0" max="23" required>
+
+    <label for="form-category-ids">{{ t "form.digest.label.categories" }}</label>
+    <select id="form-category-ids" name="category_ids" multiple>
+    {{ range .categories }}
+        <option value="{{ .ID }}" {{ if $.form.HasCategory .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+    {{ end }}
+    </select>
+
+    <label><input type="checkbox" name="mark_as_read" value="1" {{ if .form.MarkAsRead }}checked{{ end }}> {{ t "form.digest.label.mark_as_read" }}</label>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+    </div>
+</form>
+
+{{ if .digest.LastSentAt }}
+<div class="panel">
+    {{ t "page.digest.last_sent" }} <time datetime="{{ isodate .digest.LastSentAt }}" title="{{ isodate .digest.LastSentAt }}">{{ elapsed .user.Timezone .digest.LastSentAt }}</time>
+</div>
+{{ end }}
+{{ end }}
 `,
 	"edit_category": `{{ define "title"}}{{ t "page.edit_category.title" .category.Title }}{{ end }}
 
@@ -406,6 +521,8 @@ var templateViewsMap = map[string]string{
     <label for="form-title">{{ t "form.category.label.title" }}</label>
     <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
 
+    {{ template "retention_policy" .form }}
+
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
     </div>
@@ -478,6 +595,23 @@ var templateViewsMap = map[string]string{
         <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
         <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}">
 
+        {{ if .feed.IsScraped }}
+        <label for="form-item-selector">{{ t "form.feed.label.item_selector" }}</label>
+        <input type="text" name="item_selector" id="form-item-selector" value="{{ .form.ItemSelector }}" required>
+
+        <label for="form-title-selector">{{ t "form.feed.label.title_selector" }}</label>
+        <input type="text" name="title_selector" id="form-title-selector" value="{{ .form.TitleSelector }}">
+
+        <label for="form-link-selector">{{ t "form.feed.label.link_selector" }}</label>
+        <input type="text" name="link_selector" id="form-link-selector" value="{{ .form.LinkSelector }}">
+
+        <label for="form-date-selector">{{ t "form.feed.label.date_selector" }}</label>
+        <input type="text" name="date_selector" id="form-date-selector" value="{{ .form.DateSelector }}">
+
+        <label for="form-content-selector">{{ t "form.feed.label.content_selector" }}</label>
+        <input type="text" name="content_selector" id="form-content-selector" value="{{ .form.ContentSelector }}">
+        {{ end }}
+
         <label for="form-category">{{ t "form.feed.label.category" }}</label>
         <select id="form-category" name="category_id">
         {{ range .categories }}
@@ -485,8 +619,19 @@ var templateViewsMap = map[string]string{
         {{ end }}
         </select>
 
+        <label for="form-search-language">{{ t "form.feed.label.search_language" }}</label>
+        <select id="form-search-language" name="search_language">
+            <option value="">{{ if .feed.Language }}{{ t "form.feed.select.search_language_detected" .feed.Language }}{{ else }}{{ t "form.feed.select.search_language_automatic" }}{{ end }}</option>
+            {{ range .searchLanguages }}
+                <option value="{{ . }}" {{ if eq . $.form.SearchLanguage }}selected="selected"{{ end }}>{{ t (printf "search_language.%s" .) }}</option>
+            {{ end }}
+        </select>
+
         <label><input type="checkbox" name="crawler" value="1" {{ if .form.Crawler }}checked{{ end }}> {{ t "form.feed.label.crawler" }}</label>
         <label><input type="checkbox" name="disabled" value="1" {{ if .form.Disabled }}checked{{ end }}> {{ t "form.feed.label.disabled" }}</label>
+        <label><input type="checkbox" name="notify" value="1" {{ if .form.Notify }}checked{{ end }}> {{ t "form.feed.label.notify" }}</label>
+
+        {{ template "retention_policy" .form }}
 
         <div class="buttons">
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button> {{ t "action.or" }} <a href="{{ route "feeds" }}">{{ t "action.cancel" }}</a>
@@ -514,6 +659,55 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ end }}
+`,
+	"edit_saved_search": `{{ define "title"}}{{ t "page.edit_saved_search.title" .savedSearch.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.edit_saved_search.title" .savedSearch.Title }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "savedSearches" }}">{{ t "menu.saved_searches" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "savedSearchEntries" "savedSearchID" .savedSearch.ID }}">{{ t "menu.show_saved_search_entries" }}</a>
+        </li>
+    </ul>
+</section>
+
+<form action="{{ route "updateSavedSearch" "savedSearchID" .savedSearch.ID }}" method="post" autocomplete="off">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-title">{{ t "form.saved_search.label.title" }}</label>
+    <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
+
+    <label for="form-query">{{ t "form.saved_search.label.query" }}</label>
+    <input type="text" name="query" id="form-query" value="{{ .form.Query }}" title="{{ t "search.syntax" }}" required>
+
+    <label for="form-status">{{ t "form.saved_search.label.status" }}</label>
+    <select id="form-status" name="status">
+        <option value="" {{ if eq "" .form.Status }}selected="selected"{{ end }}>{{ t "form.saved_search.select.all_entries" }}</option>
+        <option value="unread" {{ if eq "unread" .form.Status }}selected="selected"{{ end }}>{{ t "form.saved_search.select.unread_entries" }}</option>
+        <option value="read" {{ if eq "read" .form.Status }}selected="selected"{{ end }}>{{ t "form.saved_search.select.read_entries" }}</option>
+    </select>
+
+    <label for="form-category">{{ t "form.saved_search.label.category" }}</label>
+    <select id="form-category" name="category_id">
+        <option value="0">{{ t "form.saved_search.select.all_categories" }}</option>
+    {{ range .categories }}
+        <option value="{{ .ID }}" {{ if eq .ID $.form.CategoryID }}selected="selected"{{ end }}>{{ .Title }}</option>
+    {{ end }}
+    </select>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+    </div>
+</form>
 {{ end }}
 `,
 	"edit_user": `{{ define "title"}}{{ t "page.edit_user.title" .selected_user.Username }}{{ end }}
@@ -602,6 +796,22 @@ var templateViewsMap = map[string]string{
                         data-label-done="{{ t "entry.scraper.completed" }}"
                         >{{ t "entry.scraper.label" }}</a>
                 </li>
+                <li>
+                    {{ if .entry.ShareCode }}
+                        <a href="{{ route "sharedEntry" "shareCode" .entry.ShareCode }}"
+                            title="{{ t "entry.shared_entry.title" }}"
+                            target="_blank">{{ t "entry.shared_entry.label" }}</a>
+                    {{ else }}
+                        <a href="#"
+                            title="{{ t "entry.share.title" }}"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "shareEntry" "entryID" .entry.ID }}">{{ t "entry.share.label" }}</a>
+                    {{ end }}
+                </li>
                 {{ if .entry.CommentsURL }}
                     <li>
                         <a href="{{ .entry.CommentsURL | safeURL }}" title="{{ t "entry.comments.title" }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-comments-link="true">{{ t "entry.comments.label" }}</a>
@@ -632,6 +842,54 @@ var templateViewsMap = map[string]string{
         <div class="entry-date">
             <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed $.user.Timezone .entry.Date }}</time>
         </div>
+        {{ if or .entry.SnoozedUntil (eq .entry.Status "unread") }}
+        <details class="entry-snooze">
+            <summary>{{ if .entry.SnoozedUntil }}{{ t "page.entry.snoozed_until" (isodate .entry.SnoozedUntil) }}{{ else }}{{ t "page.entry.snooze" }}{{ end }}</summary>
+            <ul>
+                <li>
+                    <a href="#"
+                        data-snooze-entry="true"
+                        data-snooze-url="{{ route "snoozeEntries" }}"
+                        data-value="later_today"
+                        data-toast-done="{{ t "entry.snooze.toast" }}"
+                        >{{ t "entry.snooze.later_today" }}</a>
+                </li>
+                <li>
+                    <a href="#"
+                        data-snooze-entry="true"
+                        data-snooze-url="{{ route "snoozeEntries" }}"
+                        data-value="tomorrow"
+                        data-toast-done="{{ t "entry.snooze.toast" }}"
+                        >{{ t "entry.snooze.tomorrow" }}</a>
+                </li>
+                <li>
+                    <a href="#"
+                        data-snooze-entry="true"
+                        data-snooze-url="{{ route "snoozeEntries" }}"
+                        data-value="next_week"
+                        data-toast-done="{{ t "entry.snooze.toast" }}"
+                        >{{ t "entry.snooze.next_week" }}</a>
+                </li>
+                <li>
+                    <input type="datetime-local" data-snooze-custom="true" aria-label="{{ t "entry.snooze.custom" }}">
+                    <a href="#"
+                        data-snooze-entry="true"
+                        data-snooze-url="{{ route "snoozeEntries" }}"
+                        data-toast-done="{{ t "entry.snooze.toast" }}"
+                        >{{ t "entry.snooze.custom" }}</a>
+                </li>
+                {{ if .entry.SnoozedUntil }}
+                <li>
+                    <a href="#"
+                        data-unsnooze-entry="true"
+                        data-unsnooze-url="{{ route "unsnoozeEntries" }}"
+                        data-toast-done="{{ t "entry.unsnooze.toast" }}"
+                        >{{ t "entry.unsnooze.label" }}</a>
+                </li>
+                {{ end }}
+            </ul>
+        </details>
+        {{ end }}
     </header>
     {{ if gt (len .entry.Content) 120 }}
     <div class="pagination-top">
@@ -649,13 +907,17 @@ var templateViewsMap = map[string]string{
             <div class="entry-enclosure">
                 {{ if hasPrefix .MimeType "audio/" }}
                     <div class="enclosure-audio">
-                        <audio controls preload="metadata">
+                        <audio controls preload="metadata"
+                            data-enclosure-progression-url="{{ route "saveEnclosureProgression" "enclosureID" .ID }}"
+                            data-last-position="{{ .MediaProgression }}">
                             <source src="{{ .URL }}" type="{{ .MimeType }}">
                         </audio>
                     </div>
                 {{ else if hasPrefix .MimeType "video/" }}
                     <div class="enclosure-video">
-                        <video controls preload="metadata">
+                        <video controls preload="metadata"
+                            data-enclosure-progression-url="{{ route "saveEnclosureProgression" "enclosureID" .ID }}"
+                            data-last-position="{{ .MediaProgression }}">
                             <source src="{{ .URL }}" type="{{ .MimeType }}">
                         </video>
                     </div>
@@ -668,12 +930,125 @@ var templateViewsMap = map[string]string{
                 <div class="entry-enclosure-download">
                     <a href="{{ .URL | safeURL }}" title="{{ t "action.download" }}{{ if gt .Size 0 }} - {{ formatFileSize .Size }}{{ end }} ({{ .MimeType }})" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .URL | safeURL  }}</a>
                     <small>{{ if gt .Size 0 }} - <strong>{{ formatFileSize .Size }}</strong>{{ end }}</small>
+                    {{ if or (hasPrefix .MimeType "audio/") (hasPrefix .MimeType "video/") }}
+                    <small> - <a href="#"
+                        data-toggle-played="true"
+                        data-enclosure-progression-url="{{ route "saveEnclosureProgression" "enclosureID" .ID }}"
+                        data-value="{{ if .Played }}played{{ else }}unplayed{{ end }}"
+                        data-label-loading="{{ t "entry.state.saving" }}"
+                        data-label-played="{{ t "entry.played.toggle.on" }}"
+                        data-label-unplayed="{{ t "entry.played.toggle.off" }}"
+                        >{{ if .Played }}{{ t "entry.played.toggle.off" }}{{ else }}{{ t "entry.played.toggle.on" }}{{ end }}</a></small>
+                    {{ end }}
                 </div>
+                {{ if .Chapters }}
+                    <h3>{{ t "page.entry.podcast.chapters" }}</h3>
+                    <ol class="entry-podcast-chapters">
+                    {{ range .Chapters }}
+                        <li>
+                            <span class="entry-podcast-chapter-time">{{ formatDuration .StartTime }}</span>
+                            {{ if .URL }}
+                                <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Title }}</a>
+                            {{ else }}
+                                {{ .Title }}
+                            {{ end }}
+                        </li>
+                    {{ end }}
+                    </ol>
+                {{ end }}
+                {{ if .Transcripts }}
+                    <h3>{{ t "page.entry.podcast.transcripts" }}</h3>
+                    <ul>
+                    {{ range .Transcripts }}
+                        <li>
+                            <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ if eq .Rel "captions" }}{{ t "page.entry.podcast.captions" }}{{ else }}{{ t "page.entry.podcast.transcript" }}{{ end }}</a>
+                            <small>({{ .MimeType }}{{ if .Language }}, {{ .Language }}{{ end }})</small>
+                        </li>
+                    {{ end }}
+                    </ul>
+                {{ end }}
             </div>
             {{ end }}
         {{ end }}
         </details>
     {{ end }}
+    {{ with .entry.Podcast }}
+    <details class="entry-podcast" open>
+        <summary>{{ t "page.entry.podcast" }}</summary>
+        {{ if or .Season .Episode }}
+            <p class="entry-podcast-episode">
+                {{ if .SeasonName }}{{ .SeasonName }}{{ else if .Season }}{{ t "page.entry.podcast.season" .Season }}{{ end }}
+                {{ if and .Episode (or .SeasonName .Season) }}–{{ end }}
+                {{ if .EpisodeDisplay }}{{ .EpisodeDisplay }}{{ else if .Episode }}{{ t "page.entry.podcast.episode" .Episode }}{{ end }}
+            </p>
+        {{ end }}
+        {{ if .Persons }}
+            <h3>{{ t "page.entry.podcast.persons" }}</h3>
+            <ul>
+            {{ range .Persons }}
+                <li>
+                    {{ if .URL }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Name }}</a>
+                    {{ else }}
+                        {{ .Name }}
+                    {{ end }}
+                    {{ if .Role }}– <em>{{ .Role }}</em>{{ end }}
+                </li>
+            {{ end }}
+            </ul>
+        {{ end }}
+    </details>
+    {{ end }}
+    <details class="entry-annotations" {{ if or .entry.Note .entry.Highlights }}open{{ end }}>
+        <summary>{{ t "page.entry.annotations" }}</summary>
+        <h3>{{ t "page.entry.highlights" }}</h3>
+        {{ if .entry.Highlights }}
+        <ul class="entry-highlights">
+        {{ range .entry.Highlights }}
+            <li>
+                <blockquote>{{ .Text }}</blockquote>
+                <small>
+                    <a href="#"
+                        data-confirm="true"
+                        data-label-question="{{ t "confirm.question" }}"
+                        data-label-yes="{{ t "confirm.yes" }}"
+                        data-label-no="{{ t "confirm.no" }}"
+                        data-label-loading="{{ t "confirm.loading" }}"
+                        data-url="{{ route "removeEntryHighlight" "entryID" $.entry.ID "highlightID" .ID }}">{{ t "action.remove" }}</a>
+                </small>
+            </li>
+        {{ end }}
+        </ul>
+        {{ end }}
+        <p>
+            <a href="#"
+                title="{{ t "page.entry.highlight.title" }}"
+                data-highlight-entry="true"
+                data-highlight-url="{{ route "createEntryHighlight" "entryID" .entry.ID }}"
+                data-label-loading="{{ t "entry.state.saving" }}"
+                data-toast-empty-selection="{{ t "page.entry.highlight.empty_selection" }}"
+                >{{ t "page.entry.highlight.label" }}</a>
+        </p>
+        <h3><label for="entry-note">{{ t "page.entry.note" }}</label></h3>
+        <textarea id="entry-note" data-entry-note="true" placeholder="{{ t "page.entry.note.placeholder" }}">{{ with .entry.Note }}{{ .Content }}{{ end }}</textarea>
+        <a href="#"
+            data-save-note="true"
+            data-save-note-url="{{ route "saveEntryNote" "entryID" .entry.ID }}"
+            data-label-loading="{{ t "entry.state.saving" }}"
+            data-label-save="{{ t "page.entry.note.save" }}"
+            data-toast-done="{{ t "page.entry.note.toast.saved" }}"
+            >{{ t "page.entry.note.save" }}</a>
+    </details>
+    {{ with .entry.Feed.Podcast }}
+        {{ if .Funding }}
+        <div class="entry-podcast-funding">
+            {{ t "page.entry.podcast.funding" }}
+            {{ range .Funding }}
+                <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Title }}</a>
+            {{ end }}
+        </div>
+        {{ end }}
+    {{ end }}
 </section>
 
 <div class="pagination-bottom">
@@ -849,11 +1224,53 @@ var templateViewsMap = map[string]string{
     <div class="alert alert-error">{{ t .errorMessage }}</div>
 {{ end }}
 
+{{ with .report }}
+<div class="alert {{ if .Failed }}alert-error{{ else }}alert-success{{ end }}">
+    {{ t "page.import.report.summary" .Created .Skipped .Failed }}
+</div>
+{{ if .Feeds }}
+<table>
+    <tr>
+        <th>{{ t "page.import.report.table.feed" }}</th>
+        <th>{{ t "page.import.report.table.category" }}</th>
+        <th>{{ t "page.import.report.table.status" }}</th>
+    </tr>
+    {{ range .Feeds }}
+    <tr>
+        <td title="{{ .FeedURL }}">
+            {{ if .FeedID }}<a href="{{ route "feedEntries" "feedID" .FeedID }}">{{ .Title }}</a>{{ else }}{{ .Title }}{{ end }}
+        </td>
+        <td class="column-20">{{ .CategoryName }}</td>
+        <td class="column-25">
+            {{ if eq .Status "created" }}
+                {{ t "page.import.report.status.created" }}
+            {{ else if eq .Status "duplicate" }}
+                {{ t "page.import.report.status.duplicate" }}
+            {{ else }}
+                {{ t "page.import.report.status.failed" }}{{ if .Reason }}: {{ .Reason }}{{ end }}
+            {{ end }}
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+<hr>
+{{ end }}
+
+{{ with .entriesReport }}
+<div class="alert alert-success">
+    {{ t "page.import.entries_report.summary" .EntriesCreated .EntriesUpdated .FeedsCreated }}
+    {{ if .ArchivedEntries }}{{ t "page.import.entries_report.archived" .ArchivedEntries }}{{ end }}
+</div>
+<hr>
+{{ end }}
+
 <form action="{{ route "uploadOPML" }}" method="post" enctype="multipart/form-data">
     <input type="hidden" name="csrf" value="{{ .csrf }}">
 
     <label for="form-file">{{ t "form.import.label.file" }}</label>
     <input type="file" name="file" id="form-file">
+    <p class="form-help">{{ t "page.import.formats" }}</p>
 
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.import" }}</button>
@@ -918,6 +1335,8 @@ var templateViewsMap = map[string]string{
         <label>
             <input type="checkbox" name="pinboard_mark_as_unread" value="1" {{ if .form.PinboardMarkAsUnread }}checked{{ end }}> {{ t "form.integration.pinboard_bookmark" }}
         </label>
+
+        {{ template "integration_rules" dict "service" "pinboard" "rule" (.rules.ForService "pinboard") "categories" .categories "feeds" .feeds }}
     </div>
 
     <h3>Instapaper</h3>
@@ -931,6 +1350,8 @@ var templateViewsMap = map[string]string{
 
         <label for="form-instapaper-password">{{ t "form.integration.instapaper_password" }}</label>
         <input type="password" name="instapaper_password" id="form-instapaper-password" value="{{ .form.InstapaperPassword }}" autocomplete="new-password">
+
+        {{ template "integration_rules" dict "service" "instapaper" "rule" (.rules.ForService "instapaper") "categories" .categories "feeds" .feeds }}
     </div>
 
     <h3>Pocket</h3>
@@ -950,6 +1371,8 @@ var templateViewsMap = map[string]string{
         {{ if not .form.PocketAccessToken }}
             <p><a href="{{ route "pocketAuthorize" }}">{{ t "form.integration.pocket_connect_link" }}</a></p>
         {{ end }}
+
+        {{ template "integration_rules" dict "service" "pocket" "rule" (.rules.ForService "pocket") "categories" .categories "feeds" .feeds }}
     </div>
 
     <h3>Wallabag</h3>
@@ -972,6 +1395,8 @@ var templateViewsMap = map[string]string{
 
         <label for="form-wallabag-password">{{ t "form.integration.wallabag_password" }}</label>
         <input type="password" name="wallabag_password" id="form-wallabag-password" value="{{ .form.WallabagPassword }}" autocomplete="new-password">
+
+        {{ template "integration_rules" dict "service" "wallabag" "rule" (.rules.ForService "wallabag") "categories" .categories "feeds" .feeds }}
     </div>
 
     <h3>Nunux Keeper</h3>
@@ -985,71 +1410,619 @@ var templateViewsMap = map[string]string{
 
         <label for="form-nunux-keeper-api-key">{{ t "form.integration.nunux_keeper_api_key" }}</label>
         <input type="text" name="nunux_keeper_api_key" id="form-nunux-keeper-api-key" value="{{ .form.NunuxKeeperAPIKey }}">
-    </div>
 
-    <div class="buttons">
-        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+        {{ template "integration_rules" dict "service" "nunux_keeper" "rule" (.rules.ForService "nunux_keeper") "categories" .categories "feeds" .feeds }}
     </div>
-</form>
 
-<h3>{{ t "page.integration.miniflux_api" }}</h3>
-<div class="panel">
-    <ul>
-        <li>
-            {{ t "page.integration.miniflux_api_endpoint" }} = <strong>{{ baseURL }}/v1/</strong>
-        </li>
-        <li>
-            {{ t "page.integration.miniflux_api_username" }} = <strong>{{ .user.Username }}</strong>
-        </li>
-        <li>
-            {{ t "page.integration.miniflux_api_password" }} = <strong>{{ t "page.integration.miniflux_api_password_value" }}</strong>
-        </li>
-    </ul>
-</div>
+    <h3>Linkding</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="linkding_enabled" value="1" {{ if .form.LinkdingEnabled }}checked{{ end }}> {{ t "form.integration.linkding_activate" }}
+        </label>
 
-<h3>{{ t "page.integration.bookmarklet" }}</h3>
-<div class="panel">
-    <p>{{ t "page.integration.bookmarklet.help" }}</p>
+        <label for="form-linkding-url">{{ t "form.integration.linkding_endpoint" }}</label>
+        <input type="url" name="linkding_url" id="form-linkding-url" value="{{ .form.LinkdingURL }}" placeholder="https://linkding.example.org/">
 
-    <div class="bookmarklet">
-        <a href="javascript:location.href='{{ rootURL }}{{ route "bookmarklet" }}?uri='+encodeURIComponent(window.location.href)">{{ t "page.integration.bookmarklet.name" }}</a>
-    </div>
+        <label for="form-linkding-api-key">{{ t "form.integration.linkding_api_key" }}</label>
+        <input type="password" name="linkding_api_key" id="form-linkding-api-key" value="{{ .form.LinkdingAPIKey }}" autocomplete="new-password">
 
-    <p>{{ t "page.integration.bookmarklet.instructions" }}</p>
-</div>
+        <label for="form-linkding-tags">{{ t "form.integration.linkding_tags" }}</label>
+        <input type="text" name="linkding_tags" id="form-linkding-tags" value="{{ .form.LinkdingTags }}">
 
-{{ end }}
-`,
-	"login": `{{ define "title"}}{{ t "page.login.title" }}{{ end }}
+        <label>
+            <input type="checkbox" name="linkding_mark_as_unread" value="1" {{ if .form.LinkdingMarkAsUnread }}checked{{ end }}> {{ t "form.integration.linkding_bookmark" }}
+        </label>
 
-{{ define "content"}}
-<section class="login-form">
-    <form action="{{ route "checkLogin" }}" method="post">
-        <input type="hidden" name="csrf" value="{{ .csrf }}">
+        {{ template "integration_rules" dict "service" "linkding" "rule" (.rules.ForService "linkding") "categories" .categories "feeds" .feeds }}
+    </div>
 
-        {{ if .errorMessage }}
-            <div class="alert alert-error">{{ t .errorMessage }}</div>
-        {{ end }}
+    <h3>Shaarli</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="shaarli_enabled" value="1" {{ if .form.ShaarliEnabled }}checked{{ end }}> {{ t "form.integration.shaarli_activate" }}
+        </label>
 
-        <label for="form-username">{{ t "form.user.label.username" }}</label>
-        <input type="text" name="username" id="form-username" value="{{ .form.Username }}" required autofocus>
+        <label for="form-shaarli-url">{{ t "form.integration.shaarli_endpoint" }}</label>
+        <input type="url" name="shaarli_url" id="form-shaarli-url" value="{{ .form.ShaarliURL }}" placeholder="https://shaarli.example.org/">
 
-        <label for="form-password">{{ t "form.user.label.password" }}</label>
-        <input type="password" name="password" id="form-password" value="{{ .form.Password }}" required>
+        <label for="form-shaarli-api-secret">{{ t "form.integration.shaarli_api_secret" }}</label>
+        <input type="password" name="shaarli_api_secret" id="form-shaarli-api-secret" value="{{ .form.ShaarliAPISecret }}" autocomplete="new-password">
 
-        <div class="buttons">
-            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.login" }}</button>
-        </div>
-    </form>
-    {{ if hasOAuth2Provider "google" }}
-    <div class="oauth2">
-        <a href="{{ route "oauth2Redirect" "provider" "google" }}">{{ t "page.login.google_signin" }}</a>
+        <label for="form-shaarli-tags">{{ t "form.integration.shaarli_tags" }}</label>
+        <input type="text" name="shaarli_tags" id="form-shaarli-tags" value="{{ .form.ShaarliTags }}">
+
+        <label>
+            <input type="checkbox" name="shaarli_private" value="1" {{ if .form.ShaarliPrivate }}checked{{ end }}> {{ t "form.integration.shaarli_private" }}
+        </label>
+
+        {{ template "integration_rules" dict "service" "shaarli" "rule" (.rules.ForService "shaarli") "categories" .categories "feeds" .feeds }}
     </div>
+
+    <h3>Matrix</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="matrix_enabled" value="1" {{ if .form.MatrixEnabled }}checked{{ end }}> {{ t "form.integration.matrix_activate" }}
+        </label>
+
+        <label for="form-matrix-url">{{ t "form.integration.matrix_homeserver" }}</label>
+        <input type="url" name="matrix_url" id="form-matrix-url" value="{{ .form.MatrixURL }}" placeholder="https://matrix.org">
+
+        <label for="form-matrix-access-token">{{ t "form.integration.matrix_access_token" }}</label>
+        <input type="password" name="matrix_access_token" id="form-matrix-access-token" value="{{ .form.MatrixAccessToken }}" autocomplete="new-password">
+
+        <label for="form-matrix-room-id">{{ t "form.integration.matrix_room_id" }}</label>
+        <input type="text" name="matrix_room_id" id="form-matrix-room-id" value="{{ .form.MatrixRoomID }}" placeholder="!abcdefghijkl:matrix.org">
+
+        <p>{{ t "form.integration.notifications_help" }}</p>
+    </div>
+
+    <h3>Telegram</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="telegram_enabled" value="1" {{ if .form.TelegramEnabled }}checked{{ end }}> {{ t "form.integration.telegram_activate" }}
+        </label>
+
+        <label for="form-telegram-bot-token">{{ t "form.integration.telegram_bot_token" }}</label>
+        <input type="password" name="telegram_bot_token" id="form-telegram-bot-token" value="{{ .form.TelegramBotToken }}" autocomplete="new-password">
+
+        <label for="form-telegram-chat-id">{{ t "form.integration.telegram_chat_id" }}</label>
+        <input type="text" name="telegram_chat_id" id="form-telegram-chat-id" value="{{ .form.TelegramChatID }}">
+
+        <p>{{ t "form.integration.notifications_help" }}</p>
+    </div>
+
+    <h3>Webhook</h3>
+    <div class="form-section">
+        <label>
+            <input type="checkbox" name="webhook_enabled" value="1" {{ if .form.WebhookEnabled }}checked{{ end }}> {{ t "form.integration.webhook_activate" }}
+        </label>
+
+        <label for="form-webhook-url">{{ t "form.integration.webhook_url" }}</label>
+        <input type="url" name="webhook_url" id="form-webhook-url" value="{{ .form.WebhookURL }}" placeholder="https://example.org/webhook">
+
+        <label>
+            <input type="checkbox" name="webhook_new_entries" value="1" {{ if .form.WebhookNewEntries }}checked{{ end }}> {{ t "form.integration.webhook_new_entries" }}
+        </label>
+
+        <label>
+            <input type="checkbox" name="webhook_save_entry" value="1" {{ if .form.WebhookSaveEntry }}checked{{ end }}> {{ t "form.integration.webhook_save_entry" }}
+        </label>
+
+        {{ if .form.WebhookSecret }}
+            <p>{{ t "form.integration.webhook_secret" }} <strong>{{ .form.WebhookSecret }}</strong></p>
+        {{ end }}
+    </div>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
+    </div>
+</form>
+
+{{ if .deliveries }}
+<h3>{{ t "page.integration.deliveries" }}</h3>
+<table>
+    <tr>
+        <th>{{ t "page.integration.deliveries.table.date" }}</th>
+        <th>{{ t "page.integration.deliveries.table.entry" }}</th>
+        <th>{{ t "page.integration.deliveries.table.service" }}</th>
+        <th>{{ t "page.integration.deliveries.table.status" }}</th>
+        <th>{{ t "page.integration.deliveries.table.actions" }}</th>
+    </tr>
+    {{ range .deliveries }}
+    <tr>
+        <td class="column-20" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</td>
+        <td title="{{ .EntryURL }}"><a href="{{ .EntryURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .EntryTitle }}</a></td>
+        <td class="column-20">{{ .Service }}</td>
+        <td class="column-20" title="{{ .ErrorMsg }}">
+            {{ if eq .Status "success" }}
+                {{ t "page.integration.deliveries.status.success" }}
+            {{ else if eq .Status "failed" }}
+                {{ t "page.integration.deliveries.status.failed" }}
+            {{ else }}
+                {{ t "page.integration.deliveries.status.pending" }}
+            {{ end }}
+            {{ if .ErrorMsg }}<br><span class="parsing-error">{{ .ErrorMsg }}</span>{{ end }}
+        </td>
+        <td class="column-20">
+            {{ if eq .Status "failed" }}
+                <a href="#"
+                    data-confirm="true"
+                    data-label-question="{{ t "confirm.question" }}"
+                    data-label-yes="{{ t "confirm.yes" }}"
+                    data-label-no="{{ t "confirm.no" }}"
+                    data-label-loading="{{ t "confirm.loading" }}"
+                    data-url="{{ route "retryIntegrationDelivery" "deliveryID" .ID }}">{{ t "action.retry" }}</a>
+            {{ end }}
+        </td>
+    </tr>
     {{ end }}
+</table>
+{{ end }}
+
+<h3>{{ t "page.integration.miniflux_api" }}</h3>
+<div class="panel">
+    <ul>
+        <li>
+            {{ t "page.integration.miniflux_api_endpoint" }} = <strong>{{ baseURL }}/v1/</strong>
+        </li>
+        <li>
+            {{ t "page.integration.miniflux_api_username" }} = <strong>{{ .user.Username }}</strong>
+        </li>
+        <li>
+            {{ t "page.integration.miniflux_api_password" }} = <strong>{{ t "page.integration.miniflux_api_password_value" }}</strong>
+        </li>
+    </ul>
+</div>
+
+{{ if .newsletterAddress }}
+<h3>{{ t "page.integration.newsletter" }}</h3>
+<div class="panel">
+    <p>{{ t "page.integration.newsletter.help" }}</p>
+    <ul>
+        <li>
+            {{ t "page.integration.newsletter.address" }} = <strong>{{ .newsletterAddress }}</strong>
+        </li>
+    </ul>
+    <p>{{ t "page.integration.newsletter.unsubscribe" }}</p>
+</div>
+{{ end }}
+
+<h3>{{ t "page.integration.bookmarklet" }}</h3>
+<div class="panel">
+    <p>{{ t "page.integration.bookmarklet.help" }}</p>
+
+    <div class="bookmarklet">
+        <a href="javascript:location.href='{{ rootURL }}{{ route "bookmarklet" }}?uri='+encodeURIComponent(window.location.href)">{{ t "page.integration.bookmarklet.name" }}</a>
+    </div>
+
+    <p>{{ t "page.integration.bookmarklet.instructions" }}</p>
+</div>
+
+{{ end }}
+`,
+	"login": `{{ define "title"}}{{ t "page.login.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="login-form">
+    <form action="{{ route "checkLogin" }}" method="post">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-username">{{ t "form.user.label.username" }}</label>
+        <input type="text" name="username" id="form-username" value="{{ .form.Username }}" required autofocus>
+
+        <label for="form-password">{{ t "form.user.label.password" }}</label>
+        <input type="password" name="password" id="form-password" value="{{ .form.Password }}" required>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.login" }}</button>
+        </div>
+    </form>
+    {{ if hasOAuth2Provider "google" }}
+    <div class="oauth2">
+        <a href="{{ route "oauth2Redirect" "provider" "google" }}">{{ t "page.login.google_signin" }}</a>
+    </div>
+    {{ end }}
+</section>
+<footer id="prompt-home-screen">
+    <a href="#" id="btn-add-to-home-screen">★ {{ t "action.home_screen" }}</a>
+</footer>
+{{ end }}
+`,
+	"opml_subscriptions": `{{ define "title"}}{{ t "page.opml_subscriptions.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.opml_subscriptions.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+<p class="form-help">{{ t "page.opml_subscriptions.help" }}</p>
+
+{{ if not .subscriptions }}
+    <p class="alert alert-info">{{ t "page.opml_subscriptions.no_subscription" }}</p>
+{{ else }}
+<table>
+    <tr>
+        <th>{{ t "page.opml_subscriptions.table.url" }}</th>
+        <th>{{ t "page.opml_subscriptions.table.checked_at" }}</th>
+        <th>{{ t "page.opml_subscriptions.table.actions" }}</th>
+    </tr>
+    {{ range .subscriptions }}
+    <tr>
+        <td title="{{ .URL }}">
+            <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer">{{ .URL }}</a>
+            {{ if .RemoveMissing }}<br><small>{{ t "page.opml_subscriptions.remove_missing" }}</small>{{ end }}
+            {{ if .ErrorMsg }}<br><small class="parsing-error">{{ .ErrorMsg }}</small>{{ end }}
+        </td>
+        <td class="column-20">
+        {{ if .CheckedAt }}
+            <time datetime="{{ isodate .CheckedAt }}" title="{{ isodate .CheckedAt }}">{{ elapsed $.user.Timezone .CheckedAt }}</time>
+        {{ else }}
+            {{ t "page.opml_subscriptions.never_checked" }}
+        {{ end }}
+        </td>
+        <td class="column-20">
+            <a href="{{ route "syncOPMLSubscription" "subscriptionID" .ID }}">{{ t "action.sync" }}</a>,
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeOPMLSubscription" "subscriptionID" .ID }}">{{ t "action.remove" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+<h3>{{ t "page.opml_subscriptions.new" }}</h3>
+<form method="post" autocomplete="off" action="{{ route "createOPMLSubscription" }}">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-url">{{ t "form.opml_subscription.label.url" }}</label>
+    <input type="url" name="url" id="form-url" placeholder="https://domain.tld/feeds.opml" value="{{ .form.URL }}" required>
+
+    <label><input type="checkbox" name="remove_missing" value="1" {{ if .form.RemoveMissing }}checked{{ end }}> {{ t "form.opml_subscription.label.remove_missing" }}</label>
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.subscribe" }}</button>
+    </div>
+</form>
+
+{{ if .changes }}
+<h3>{{ t "page.opml_subscriptions.changes" }}</h3>
+<table>
+    <tr>
+        <th>{{ t "page.opml_subscriptions.table.feed" }}</th>
+        <th>{{ t "page.opml_subscriptions.table.category" }}</th>
+        <th>{{ t "page.opml_subscriptions.table.action" }}</th>
+        <th>{{ t "page.opml_subscriptions.table.date" }}</th>
+    </tr>
+    {{ range .changes }}
+    <tr>
+        <td title="{{ .FeedURL }}">{{ if .Title }}{{ .Title }}{{ else }}{{ .FeedURL }}{{ end }}</td>
+        <td class="column-20">{{ .Category }}</td>
+        <td class="column-25">
+            {{ if eq .Action "added" }}
+                {{ t "page.opml_subscriptions.action.added" }}
+            {{ else if eq .Action "removed" }}
+                {{ t "page.opml_subscriptions.action.removed" }}
+            {{ else if eq .Action "moved" }}
+                {{ t "page.opml_subscriptions.action.moved" .Details }}
+            {{ else }}
+                {{ t "page.opml_subscriptions.action.failed" }}{{ if .Details }}: {{ .Details }}{{ end }}
+            {{ end }}
+        </td>
+        <td class="column-20" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+{{ end }}
+`,
+	"published_feeds": `{{ define "title"}}{{ t "page.published_feeds.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.published_feeds.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<p class="form-help">{{ t "page.published_feeds.help" }}</p>
+
+{{ if not .publishedFeeds }}
+    <p class="alert alert-info">{{ t "page.published_feeds.no_feed" }}</p>
+{{ else }}
+<table>
+    <tr>
+        <th>{{ t "page.published_feeds.table.title" }}</th>
+        <th>{{ t "page.published_feeds.table.links" }}</th>
+        <th>{{ t "page.published_feeds.table.date" }}</th>
+        <th>{{ t "page.published_feeds.table.actions" }}</th>
+    </tr>
+    {{ range .publishedFeeds }}
+    <tr>
+        <td title="{{ .Title }}">{{ .Title }}</td>
+        <td class="column-20">
+            <a href="{{ rootURL }}{{ route "publishedFeed" "token" .Token "format" "atom" }}" target="_blank" rel="noopener noreferrer">Atom</a>,
+            <a href="{{ rootURL }}{{ route "publishedFeed" "token" .Token "format" "rss" }}" target="_blank" rel="noopener noreferrer">RSS</a>,
+            <a href="{{ rootURL }}{{ route "publishedFeed" "token" .Token "format" "json" }}" target="_blank" rel="noopener noreferrer">JSON</a>
+        </td>
+        <td class="column-20" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</td>
+        <td class="column-20">
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removePublishedFeed" "publishedFeedID" .ID }}">{{ t "action.revoke" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+<h3>{{ t "page.published_feeds.new" }}</h3>
+<form method="post" autocomplete="off" action="{{ route "createPublishedFeed" }}">
+    <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+    {{ if .errorMessage }}
+        <div class="alert alert-error">{{ t .errorMessage }}</div>
+    {{ end }}
+
+    <label for="form-source">{{ t "form.published_feed.label.source" }}</label>
+    <select id="form-source" name="source">
+        <option value="starred" {{ if eq "starred" .form.Source }}selected="selected"{{ end }}>{{ t "form.published_feed.select.starred" }}</option>
+        <option value="category" {{ if eq "category" .form.Source }}selected="selected"{{ end }}>{{ t "form.published_feed.select.category" }}</option>
+        <option value="tag" {{ if eq "tag" .form.Source }}selected="selected"{{ end }}>{{ t "form.published_feed.select.tag" }}</option>
+    </select>
+
+    <label for="form-category">{{ t "form.published_feed.label.category" }}</label>
+    <select id="form-category" name="category_id">
+    {{ range .categories }}
+        <option value="{{ .ID }}" {{ if eq .ID $.form.CategoryID }}selected="selected"{{ end }}>{{ .Title }}</option>
+    {{ end }}
+    </select>
+
+    <label for="form-tag">{{ t "form.published_feed.label.tag" }}</label>
+    <input type="text" name="tag" id="form-tag" value="{{ .form.Tag }}">
+    <p class="form-help">{{ t "form.published_feed.help.tag" }}</p>
+
+    <label for="form-title">{{ t "form.published_feed.label.title" }}</label>
+    <input type="text" name="title" id="form-title" value="{{ .form.Title }}">
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.publish" }}</button>
+    </div>
+</form>
+{{ end }}
+`,
+	"saved_search_entries": `{{ define "title"}}{{ .savedSearch.Title }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ .savedSearch.Title }} ({{ .total }})</h1>
+    <ul>
+        {{ if .entries }}
+        <li>
+            <a href="#"
+                data-action="markPageAsRead"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
+        </li>
+        <li>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "markSavedSearchAsRead" "savedSearchID" .savedSearch.ID }}">{{ t "menu.mark_all_as_read" }}</a>
+        </li>
+        {{ end }}
+        <li>
+            <a href="{{ route "editSavedSearch" "savedSearchID" .savedSearch.ID }}">{{ t "menu.edit_saved_search" }}</a>
+        </li>
+        <li>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeSavedSearch" "savedSearchID" .savedSearch.ID }}"
+                data-redirect-url="{{ route "savedSearches" }}">{{ t "action.remove" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if .errorMessage }}
+    <p class="alert alert-error">{{ t .errorMessage }}</p>
+{{ else if not .entries }}
+    {{ if .showOnlyUnreadEntries }}
+        <p class="alert">{{ t "alert.no_unread_entry" }}</p>
+    {{ else }}
+        <p class="alert alert-info">{{ t "alert.no_search_result" }}</p>
+    {{ end }}
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "savedSearchEntry" "savedSearchID" $.savedSearch.ID "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    <section class="page-footer">
+        <ul>
+            <li>
+                <a href="#"
+                    data-action="markPageAsRead"
+                    data-label-question="{{ t "confirm.question" }}"
+                    data-label-yes="{{ t "confirm.yes" }}"
+                    data-label-no="{{ t "confirm.no" }}"
+                    data-label-loading="{{ t "confirm.loading" }}"
+                    data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
+            </li>
+        </ul>
+    </section>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"saved_searches": `{{ define "title"}}{{ t "page.saved_searches.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.saved_searches.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "createSavedSearch" }}">{{ t "menu.create_saved_search" }}</a>
+        </li>
+    </ul>
 </section>
-<footer id="prompt-home-screen">
-    <a href="#" id="btn-add-to-home-screen">★ {{ t "action.home_screen" }}</a>
-</footer>
+
+{{ if not .savedSearches }}
+    <p class="alert">{{ t "alert.no_saved_search" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .savedSearches }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ route "savedSearchEntries" "savedSearchID" .ID }}">{{ .Title }}</a>
+                </span>
+                (<span title="{{ t "page.saved_searches.unread_count" }}">{{ .UnreadCount }}</span>)
+            </div>
+            <div class="item-meta">
+                <ul>
+                    <li><code>{{ .Query }}</code></li>
+                    <li>
+                        <a href="{{ route "editSavedSearch" "savedSearchID" .ID }}">{{ t "menu.edit_saved_search" }}</a>
+                    </li>
+                    <li>
+                        <a href="#"
+                            data-confirm="true"
+                            data-label-question="{{ t "confirm.question" }}"
+                            data-label-yes="{{ t "confirm.yes" }}"
+                            data-label-no="{{ t "confirm.no" }}"
+                            data-label-loading="{{ t "confirm.loading" }}"
+                            data-url="{{ route "removeSavedSearch" "savedSearchID" .ID }}">{{ t "action.remove" }}</a>
+                    </li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+{{ end }}
+
+{{ end }}
+`,
+	"scrape_subscription": `{{ define "title"}}{{ t "page.scrape_feed.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.scrape_feed.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if not .categories }}
+    <p class="alert alert-error">{{ t "page.add_feed.no_category" }}</p>
+{{ else }}
+    <form action="{{ route "submitScrapeSubscription" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <p class="form-help">{{ t "page.scrape_feed.help" }}</p>
+
+        <label for="form-url">{{ t "page.add_feed.label.url" }}</label>
+        <input type="url" name="url" id="form-url" placeholder="https://domain.tld/" value="{{ .form.URL }}" required autofocus>
+
+        <label for="form-category">{{ t "form.feed.label.category" }}</label>
+        <select id="form-category" name="category_id">
+            {{ range .categories }}
+                <option value="{{ .ID }}" {{ if eq $.form.CategoryID .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+            {{ end }}
+        </select>
+
+        <label for="form-item-selector">{{ t "form.feed.label.item_selector" }}</label>
+        <input type="text" name="item_selector" id="form-item-selector" placeholder="article" value="{{ .form.ItemSelector }}" required>
+
+        <label for="form-title-selector">{{ t "form.feed.label.title_selector" }}</label>
+        <input type="text" name="title_selector" id="form-title-selector" placeholder="h2" value="{{ .form.TitleSelector }}">
+
+        <label for="form-link-selector">{{ t "form.feed.label.link_selector" }}</label>
+        <input type="text" name="link_selector" id="form-link-selector" placeholder="a[href]" value="{{ .form.LinkSelector }}">
+
+        <label for="form-date-selector">{{ t "form.feed.label.date_selector" }}</label>
+        <input type="text" name="date_selector" id="form-date-selector" placeholder="time" value="{{ .form.DateSelector }}">
+
+        <label for="form-content-selector">{{ t "form.feed.label.content_selector" }}</label>
+        <input type="text" name="content_selector" id="form-content-selector" placeholder="p.summary" value="{{ .form.ContentSelector }}">
+
+        <details>
+            <summary>{{ t "page.add_feed.legend.advanced_options" }}</summary>
+            <div class="details-content">
+                <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
+                <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}" autocomplete="off">
+            </div>
+        </details>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" formaction="{{ route "previewScrapeSubscription" }}" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.preview" }}</button>
+            {{ if .preview }}
+                {{ t "action.or" }} <button type="submit" class="button">{{ t "action.subscribe" }}</button>
+            {{ end }}
+        </div>
+    </form>
+
+    {{ if .preview }}
+    <h3>{{ .preview.Title }}</h3>
+    <div class="items">
+        {{ range .preview.Entries }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ .URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Title }}</a>
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul>
+                    <li><time datetime="{{ isodate .Date }}" title="{{ isodate .Date }}">{{ elapsed $.user.Timezone .Date }}</time></li>
+                    <li>{{ domain .URL }}</li>
+                </ul>
+            </div>
+        </article>
+        {{ end }}
+    </div>
+    {{ end }}
+{{ end }}
+
 {{ end }}
 `,
 	"search_entries": `{{ define "title"}}{{ t "page.search.title" }} ({{ .total }}){{ end }}
@@ -1057,9 +2030,21 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.search.title" }} ({{ .total }})</h1>
+    <ul>
+        {{ if and .searchQuery (not .errorMessage) }}
+        <li>
+            <a href="{{ route "createSavedSearch" }}?q={{ .searchQuery }}">{{ t "menu.save_search" }}</a>
+        </li>
+        {{ end }}
+        <li>
+            <a href="{{ route "savedSearches" }}">{{ t "menu.saved_searches" }}</a>
+        </li>
+    </ul>
 </section>
 
-{{ if not .entries }}
+{{ if .errorMessage }}
+    <p class="alert alert-error">{{ t .errorMessage }}</p>
+{{ else if not .entries }}
     <p class="alert alert-info">{{ t "alert.no_search_result" }}</p>
 {{ else }}
     <div class="items">
@@ -1173,6 +2158,14 @@ var templateViewsMap = map[string]string{
         <option value="desc" {{ if eq "desc" $.form.EntryDirection }}selected="selected"{{ end }}>{{ t "form.prefs.select.recent_first" }}</option>
     </select>
 
+    <label for="form-search-language">{{ t "form.prefs.label.search_language" }}</label>
+    <select id="form-search-language" name="search_language">
+        <option value="">{{ t "form.prefs.select.search_language_automatic" }}</option>
+        {{ range .searchLanguages }}
+            <option value="{{ . }}" {{ if eq . $.form.SearchLanguage }}selected="selected"{{ end }}>{{ t (printf "search_language.%s" .) }}</option>
+        {{ end }}
+    </select>
+
     <label><input type="checkbox" name="keyboard_shortcuts" value="1" {{ if .form.KeyboardShortcuts }}checked{{ end }}> {{ t "form.prefs.label.keyboard_shortcuts" }}</label>
 
     <div class="buttons">
@@ -1190,6 +2183,140 @@ var templateViewsMap = map[string]string{
 </div>
 {{ end }}
 
+{{ end }}
+`,
+	"shared_entries": `{{ define "title"}}{{ t "page.shared_entries.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.shared_entries.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_shared_entry" }}</p>
+{{ else }}
+<table>
+    <tr>
+        <th>{{ t "page.shared_entries.table.title" }}</th>
+        <th>{{ t "page.shared_entries.table.actions" }}</th>
+    </tr>
+    {{ range .entries }}
+    <tr>
+        <td>
+            <a href="{{ route "sharedEntry" "shareCode" .ShareCode }}" target="_blank">{{ .Title }}</a>
+        </td>
+        <td class="column-20">
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "unshareEntry" "entryID" .ID }}">{{ t "action.revoke" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+{{ end }}
+`,
+	"shared_entry": `{{ define "title"}}{{ .entry.Title }}{{ end }}
+
+{{ define "content"}}
+<section class="entry">
+    <header class="entry-header">
+        <h1>
+            <a href="{{ .entry.URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Title }}</a>
+        </h1>
+        <div class="entry-meta">
+            <span class="entry-website">
+                <a href="{{ .entry.Feed.SiteURL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Feed.Title }}</a>
+            </span>
+            {{ if .entry.Author }}
+                <span class="entry-author">
+                    – <em>{{ .entry.Author }}</em>
+                </span>
+            {{ end }}
+        </div>
+        <div class="entry-date">
+            <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ isodate .entry.Date }}</time>
+        </div>
+    </header>
+    <article class="entry-content">
+        {{ noescape (proxyFilter .entry.Content) }}
+    </article>
+    {{ if .entry.Enclosures }}
+    <details class="entry-enclosures">
+        <summary>{{ t "page.entry.attachments" }} ({{ len .entry.Enclosures }})</summary>
+        {{ range .entry.Enclosures }}
+            {{ if ne .URL "" }}
+            <div class="entry-enclosure">
+                {{ if hasPrefix .MimeType "audio/" }}
+                    <div class="enclosure-audio">
+                        <audio controls preload="metadata">
+                            <source src="{{ .URL }}" type="{{ .MimeType }}">
+                        </audio>
+                    </div>
+                {{ else if hasPrefix .MimeType "video/" }}
+                    <div class="enclosure-video">
+                        <video controls preload="metadata">
+                            <source src="{{ .URL }}" type="{{ .MimeType }}">
+                        </video>
+                    </div>
+                {{ else if hasPrefix .MimeType "image/" }}
+                    <div class="enclosure-image">
+                        <img src="{{ proxyURL .URL }}" title="{{ .URL }} ({{ .MimeType }})" loading="lazy" alt="{{ .URL }} ({{ .MimeType }})">
+                    </div>
+                {{ end }}
+
+                <div class="entry-enclosure-download">
+                    <a href="{{ .URL | safeURL }}" title="{{ t "action.download" }}{{ if gt .Size 0 }} - {{ formatFileSize .Size }}{{ end }} ({{ .MimeType }})" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .URL | safeURL  }}</a>
+                    <small>{{ if gt .Size 0 }} - <strong>{{ formatFileSize .Size }}</strong>{{ end }}</small>
+                </div>
+            </div>
+            {{ end }}
+        {{ end }}
+        </details>
+    {{ end }}
+</section>
+{{ end }}
+`,
+	"snoozed_entries": `{{ define "title"}}{{ t "page.snoozed.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.snoozed.title" }} ({{ .total }})</h1>
+    <ul>
+        <li>
+            <a href="{{ route "unread" }}">{{ t "menu.unread" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_snoozed_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item touch-item item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "feedEntry" "feedID" .Feed.ID "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry }}
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
 {{ end }}
 `,
 	"unread_entries": `{{ define "title"}}{{ t "page.unread.title" }} {{ if gt .countUnread 0 }}({{ .countUnread }}){{ end }} {{ end }}
@@ -1197,8 +2324,9 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.unread.title" }} (<span class="unread-counter">{{ .countUnread }}</span>)</h1>
-    {{ if .entries }}
+    {{ if or .entries .countSnoozed }}
     <ul>
+        {{ if .entries }}
         <li>
             <a href="#"
                 data-action="markPageAsRead"
@@ -1217,6 +2345,12 @@ var templateViewsMap = map[string]string{
                 data-label-no="{{ t "confirm.no" }}"
                 data-label-loading="{{ t "confirm.loading" }}">{{ t "menu.mark_all_as_read" }}</a>
         </li>
+        {{ end }}
+        {{ if .countSnoozed }}
+        <li>
+            <a href="{{ route "snoozed" }}">{{ t "menu.snoozed_entries" .countSnoozed }}</a>
+        </li>
+        {{ end }}
     </ul>
     {{ end }}
 </section>
@@ -1309,28 +2443,39 @@ var templateViewsMap = map[string]string{
 }
 
 var templateViewsMapChecksums = map[string]string{
-	"about":               "4035658497363d7af7f79be83190404eb21ec633fe8ec636bdfc219d9fc78cfc",
-	"add_subscription":    "0dbea93b6fc07423fa066122ad960c69616b829533371a2dbadec1e22d4f1ae0",
-	"bookmark_entries":    "65588da78665699dd3f287f68325e9777d511f1a57fee4131a5bb6d00bb68df8",
-	"categories":          "2c5dd0ed6355bd5acc393bbf6117d20458b5581aab82036008324f6bbbe2af75",
-	"category_entries":    "dee7b9cd60c6c46f01dd4289940679df31c1fce28ce4aa7249fa459023e1eeb4",
-	"category_feeds":      "527c2ffbc4fcec775071424ba1022ae003525dba53a28cc41f48fb7b30aa984b",
-	"choose_subscription": "84c9730cadd78e6ee5a6b4c499aab33acddb4324ac01924d33387543eec4d702",
-	"create_category":     "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
-	"create_user":         "9b73a55233615e461d1f07d99ad1d4d3b54532588ab960097ba3e090c85aaf3a",
-	"edit_category":       "b1c0b38f1b714c5d884edcd61e5b5295a5f1c8b71c469b35391e4dcc97cc6d36",
-	"edit_feed":           "cc0b5dbb73f81398410958b41771ed38246bc7ae4bd548228f0d48c49a598c2a",
-	"edit_user":           "c692db9de1a084c57b93e95a14b041d39bf489846cbb91fc982a62b72b77062a",
-	"entry":               "513183f0f0b11a199630562f5a85eb9a5646051aae278cbc682bac13d62e65cc",
-	"feed_entries":        "9c70b82f55e4b311eff20be1641733612e3c1b406ce8010861e4c417d97b6dcc",
-	"feeds":               "ec7d3fa96735bd8422ba69ef0927dcccddc1cc51327e0271f0312d3f881c64fd",
-	"history_entries":     "87e17d39de70eb3fdbc4000326283be610928758eae7924e4b08dcb446f3b6a9",
-	"import":              "1b59b3bd55c59fcbc6fbb346b414dcdd26d1b4e0c307e437bb58b3f92ef01ad1",
-	"integrations":        "6104ff6ff3ac3c1ae5e850c78250aab6e99e2342a337589f3848459fa333766a",
-	"login":               "0657174d13229bb6d0bc470ccda06bb1f15c1af65c86b20b41ffa5c819eef0cc",
-	"search_entries":      "274950d03298c24f3942e209c0faed580a6d57be9cf76a6c236175a7e766ac6a",
-	"sessions":            "5d5c677bddbd027e0b0c9f7a0dd95b66d9d95b4e130959f31fb955b926c2201c",
-	"settings":            "56f7c06f24eef317353582b0191aa9a5985f46ed755accf97e723ceb4bba4469",
-	"unread_entries":      "e38f7ffce17dfad3151b08cd33771a2cefe8ca9db42df04fc98bd1d675dd6075",
-	"users":               "17d0b7c760557e20f888d83d6a1b0d4506dab071a593cc42080ec0dbf16adf9e",
+	"about":                "4035658497363d7af7f79be83190404eb21ec633fe8ec636bdfc219d9fc78cfc",
+	"add_subscription":     "67905de223fea942029ed7c0700bed4690aa4006d6c95804a2ca5861f1b6ed60",
+	"bookmark_entries":     "65588da78665699dd3f287f68325e9777d511f1a57fee4131a5bb6d00bb68df8",
+	"categories":           "2c5dd0ed6355bd5acc393bbf6117d20458b5581aab82036008324f6bbbe2af75",
+	"category_entries":     "dee7b9cd60c6c46f01dd4289940679df31c1fce28ce4aa7249fa459023e1eeb4",
+	"category_feeds":       "527c2ffbc4fcec775071424ba1022ae003525dba53a28cc41f48fb7b30aa984b",
+	"choose_subscription":  "84c9730cadd78e6ee5a6b4c499aab33acddb4324ac01924d33387543eec4d702",
+	"create_category":      "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
+	"create_saved_search":  "77e5da1595ad7254afc8264e39f86eef89bf6622dab37e22c066522bc7f065e8",
+	"create_user":          "9b73a55233615e461d1f07d99ad1d4d3b54532588ab960097ba3e090c85aaf3a",
+	"digest":               "b446ed2acca3a1f742fe1eb9276136ac4820713eb847573759774044710df3f5",
+	"edit_category":        "553306bdf5d066112b125bb42c7d0c136222f87eb3b2d5f07f4443d2a77233af",
+	"edit_feed":            "6562bdfca04a22105d22cbad8abb479de5fd62c32481023d67ea4ce2bbad1065",
+	"edit_saved_search":    "b63ee90a11510535803a510f825e7e115bc767c46f24be9d63159bc4b9eeca80",
+	"edit_user":            "c692db9de1a084c57b93e95a14b041d39bf489846cbb91fc982a62b72b77062a",
+	"entry":                "9befad410f789d2476b441b8ee740db974a391d8a369e93b2b7a5b57f19a82cc",
+	"feed_entries":         "9c70b82f55e4b311eff20be1641733612e3c1b406ce8010861e4c417d97b6dcc",
+	"feeds":                "ec7d3fa96735bd8422ba69ef0927dcccddc1cc51327e0271f0312d3f881c64fd",
+	"history_entries":      "87e17d39de70eb3fdbc4000326283be610928758eae7924e4b08dcb446f3b6a9",
+	"import":               "b96e92ee29bb5a8c472f867f42d8b2e685d3a2a2fbbb5a046fb81deb34070f5d",
+	"integrations":         "b3660d1c3f89a698831f2d709cb4ce9b1bff4abce0d8fd420e4811b179f32a02",
+	"login":                "0657174d13229bb6d0bc470ccda06bb1f15c1af65c86b20b41ffa5c819eef0cc",
+	"opml_subscriptions":   "4992612584f3f82c7a4b488c1d87e67ecaf54eede1671b0e9290b50d09480227",
+	"published_feeds":      "007986d35f7ae19c7dcf85138aa3d538dccff32559718a72adabf9d02ce8ae1d",
+	"saved_search_entries": "e8bbb9e1ff40029d10924c79d47e4484c993eaefcf4d7a524fcf501988edfa39",
+	"saved_searches":       "08d82383957b70c89272fea6f55e16afd486eaa8a48f814b2f96ea79cf0afc52",
+	"scrape_subscription":  "ae16e82551ec50bc0b71dc92d8081b1b291bc8a6ab147db1bbe09eb34188ae15",
+	"search_entries":       "0e500579f19f2eadb46d676bad34667ed19f6bea8576a3b078928b31196b5987",
+	"sessions":             "5d5c677bddbd027e0b0c9f7a0dd95b66d9d95b4e130959f31fb955b926c2201c",
+	"settings":             "55cf5e87b6d7199aba4fe7d0f900d5a517d9ae8a4525feeb37639fe0e2f30d0d",
+	"shared_entries":       "7c77a366cdd94aa617e53628a914835bea8d3b1d1c0523ea2bc512b77df84afe",
+	"shared_entry":         "9fcbda13354ae0fab2372333e8638ae0c5c6ed8dda36ac7af9d31a8d97707400",
+	"snoozed_entries":      "09cbaaac60a413eeb7dbb9750b981b3271377963175eaaacb625c01b2504beeb",
+	"unread_entries":       "8f6c20168e22a27266d5da7536705a9bd60e8a60bc1ac945245997610266794b",
+	"users":                "17d0b7c760557e20f888d83d6a1b0d4506dab071a593cc42080ec0dbf16adf9e",
 }
@@ -8,6 +8,8 @@ package tests
 
 import (
 	"testing"
+
+	miniflux "miniflux.app/client"
 )
 
 func TestCreateCategory(t *testing.T) {
@@ -81,6 +83,36 @@ func TestUpdateCategory(t *testing.T) {
 	}
 }
 
+func TestUpdateCategoryRetentionPolicy(t *testing.T) {
+	client := createClient(t)
+	category, err := client.CreateCategory("News")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	maxEntries, markAsReadDays := 1000, 3
+	category, err = client.ModifyCategory(category.ID, &miniflux.CategoryModification{
+		MaxEntries:     &maxEntries,
+		MarkAsReadDays: &markAsReadDays,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if category.Title != "News" {
+		t.Fatalf(`The title should not be changed, got %q`, category.Title)
+	}
+
+	if category.ArchiveReadDays != 0 || category.MaxEntries != 1000 || category.MarkAsReadDays != 3 {
+		t.Fatalf(`Wrong retention policy, got %d, %d and %d`, category.ArchiveReadDays, category.MaxEntries, category.MarkAsReadDays)
+	}
+
+	archiveReadDays := -2
+	if _, err := client.ModifyCategory(category.ID, &miniflux.CategoryModification{ArchiveReadDays: &archiveReadDays}); err == nil {
+		t.Fatal(`An invalid retention policy should be refused`)
+	}
+}
+
 func TestListCategories(t *testing.T) {
 	categoryName := "My category"
 	client := createClient(t)
@@ -0,0 +1,104 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestEntryNote(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	result, err := client.FeedEntries(feed.ID, &miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	if err := client.SaveEntryNote(entryID, "Remember *zanzibar*"); err != nil {
+		t.Fatal(err)
+	}
+
+	note, err := client.EntryNote(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if note.Content != "Remember *zanzibar*" {
+		t.Fatalf(`Invalid note content, got %q`, note.Content)
+	}
+
+	entry, err := client.Entry(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Note == nil || entry.Note.Content != note.Content {
+		t.Fatalf(`The note should be returned with the entry, got %v`, entry.Note)
+	}
+
+	results, err := client.Entries(&miniflux.Filter{Search: "zanzibar"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 || results.Entries[0].ID != entryID {
+		t.Fatalf(`The note should be searchable, got %d entries`, results.Total)
+	}
+
+	if err := client.SaveEntryNote(entryID, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.EntryNote(entryID); err == nil {
+		t.Fatal(`An empty note should remove the note`)
+	}
+}
+
+func TestEntryHighlights(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	result, err := client.FeedEntries(feed.ID, &miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	if _, err := client.CreateEntryHighlight(entryID, "  "); err == nil {
+		t.Fatal(`An empty highlight should be rejected`)
+	}
+
+	highlight, err := client.CreateEntryHighlight(entryID, "a highlighted passage")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if highlight.ID == 0 || highlight.EntryID != entryID {
+		t.Fatalf(`Invalid highlight, got %+v`, highlight)
+	}
+
+	highlights, err := client.EntryHighlights(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(highlights) != 1 || highlights[0].Text != "a highlighted passage" {
+		t.Fatalf(`Invalid highlights, got %v`, highlights)
+	}
+
+	if err := client.DeleteEntryHighlight(entryID, highlight.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteEntryHighlight(entryID, highlight.ID); err == nil {
+		t.Fatal(`Removing a highlight twice should fail`)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestSnoozeEntry(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	result, err := client.FeedEntries(feed.ID, &miniflux.Filter{Status: "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	if err := client.SnoozeEntry(entryID, "tomorrow"); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := client.Entry(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.SnoozedUntil == nil {
+		t.Fatal(`The snooze date should be returned with the entry`)
+	}
+
+	unread, err := client.FeedEntries(feed.ID, &miniflux.Filter{Status: "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if unread.Total != result.Total-1 {
+		t.Fatalf(`Snoozed entries should be hidden from unread entries, got %d instead of %d`, unread.Total, result.Total-1)
+	}
+
+	snoozed, err := client.Entries(&miniflux.Filter{Snoozed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snoozed.Total != 1 || snoozed.Entries[0].ID != entryID {
+		t.Fatalf(`Invalid snoozed entries, got %d entries`, snoozed.Total)
+	}
+
+	if err := client.UnsnoozeEntry(entryID); err != nil {
+		t.Fatal(err)
+	}
+
+	unread, err = client.FeedEntries(feed.ID, &miniflux.Filter{Status: "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if unread.Total != result.Total {
+		t.Fatalf(`The entry should be unread again, got %d entries instead of %d`, unread.Total, result.Total)
+	}
+}
+
+func TestMarkSnoozedEntryAsRead(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	result, err := client.FeedEntries(feed.ID, &miniflux.Filter{Status: "unread", Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	if err := client.SnoozeEntry(entryID, "tomorrow"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.UpdateEntries([]int64{entryID}, "read"); err != nil {
+		t.Fatal(err)
+	}
+
+	entry, err := client.Entry(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Status != "read" || entry.SnoozedUntil != nil {
+		t.Fatalf(`The snooze of an entry marked as read should be cancelled, got %q and %v`, entry.Status, entry.SnoozedUntil)
+	}
+}
+
+func TestSnoozeEntryWithInvalidDate(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	result, err := client.FeedEntries(feed.ID, &miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, value := range []string{"someday", "2000-01-01T08:00"} {
+		if err := client.SnoozeEntry(result.Entries[0].ID, value); err == nil {
+			t.Fatalf(`Snoozing until %q should not be possible`, value)
+		}
+	}
+}
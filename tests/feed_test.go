@@ -66,6 +66,50 @@ func TestUpdateFeedURL(t *testing.T) {
 	}
 }
 
+func TestUpdateFeedSearchLanguage(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	language := "german"
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{SearchLanguage: &language})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.SearchLanguage != language {
+		t.Fatalf(`Wrong SearchLanguage, got %q instead of %q`, updatedFeed.SearchLanguage, language)
+	}
+
+	language = "klingon"
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{SearchLanguage: &language}); err == nil {
+		t.Fatal(`An unsupported search language should be refused`)
+	}
+}
+
+func TestUpdateFeedRetentionPolicy(t *testing.T) {
+	client := createClient(t)
+	feed, _ := createFeed(t, client)
+
+	archiveReadDays, maxEntries, markAsReadDays := -1, 500, 7
+	updatedFeed, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{
+		ArchiveReadDays: &archiveReadDays,
+		MaxEntries:      &maxEntries,
+		MarkAsReadDays:  &markAsReadDays,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedFeed.ArchiveReadDays != -1 || updatedFeed.MaxEntries != 500 || updatedFeed.MarkAsReadDays != 7 {
+		t.Fatalf(`Wrong retention policy, got %d, %d and %d`, updatedFeed.ArchiveReadDays, updatedFeed.MaxEntries, updatedFeed.MarkAsReadDays)
+	}
+
+	maxEntries = -10
+	if _, err := client.UpdateFeed(feed.ID, &miniflux.FeedModification{MaxEntries: &maxEntries}); err == nil {
+		t.Fatal(`An invalid retention policy should be refused`)
+	}
+}
+
 func TestUpdateFeedSiteURL(t *testing.T) {
 	client := createClient(t)
 	feed, _ := createFeed(t, client)
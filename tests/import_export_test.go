@@ -11,6 +11,8 @@ import (
 	"io/ioutil"
 	"strings"
 	"testing"
+
+	miniflux "miniflux.app/client"
 )
 
 func TestExport(t *testing.T) {
@@ -44,3 +46,151 @@ func TestImport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBackupRoundTrip(t *testing.T) {
+	client := createClient(t)
+
+	category, err := client.CreateCategory("Backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(testFeedURL, category.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.FeedEntries(feedID, &miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results.Entries) == 0 {
+		t.Fatal(`The feed should have entries`)
+	}
+
+	entryID := results.Entries[0].ID
+	if err := client.SaveEntryNote(entryID, "Backup note"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.CreateEntryHighlight(entryID, "Backup highlight"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.SnoozeEntry(entryID, "tomorrow"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Backup search", Query: "miniflux", CategoryID: category.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := client.ExportBackup()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		report, err := client.ImportBackup(ioutil.NopCloser(bytes.NewReader(archive)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if report.CategoriesCreated != 0 || report.FeedsCreated != 0 || report.EntriesCreated != 0 {
+			t.Errorf(`Importing an archive in the same account should not create anything, got %+v`, report)
+		}
+
+		if report.FeedsSkipped != 1 {
+			t.Errorf(`Incorrect number of skipped feeds, got %d`, report.FeedsSkipped)
+		}
+	}
+
+	user, err := client.Me()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.Language != "en_US" || user.Timezone != "UTC" {
+		t.Errorf(`The settings should be restored, got %q and %q`, user.Language, user.Timezone)
+	}
+
+	note, err := client.EntryNote(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if note.Content != "Backup note" {
+		t.Errorf(`The note should be kept, got %q`, note.Content)
+	}
+
+	highlights, err := client.EntryHighlights(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(highlights) != 1 {
+		t.Errorf(`Importing an archive in the same account should not duplicate highlights, got %d`, len(highlights))
+	}
+
+	snoozed, err := client.Entries(&miniflux.Filter{Snoozed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snoozed.Total != 1 || snoozed.Entries[0].ID != entryID {
+		t.Errorf(`The snooze should be kept, got %d snoozed entries`, snoozed.Total)
+	}
+
+	savedSearches, err := client.SavedSearches()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(savedSearches) != 1 || savedSearches[0].CategoryID != category.ID {
+		t.Errorf(`Importing an archive in the same account should not duplicate saved searches, got %d`, len(savedSearches))
+	}
+}
+
+func TestImportArticles(t *testing.T) {
+	client := createClient(t)
+
+	data := `[{
+		"guid": "imported-article",
+		"title": "Imported article",
+		"link": "` + testWebsiteURL + `imported-article",
+		"marked": true,
+		"unread": false,
+		"feed_url": "` + testFeedURL + `",
+		"feed_title": "Miniflux",
+		"site_url": "` + testWebsiteURL + `"
+	}]`
+
+	for i := 0; i < 2; i++ {
+		if err := client.Import(ioutil.NopCloser(strings.NewReader(data))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	feeds, err := client.Feeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 1 || feeds[0].FeedURL != testFeedURL {
+		t.Fatalf(`The feed of the article should be created once, got %d feeds`, len(feeds))
+	}
+
+	results, err := client.Entries(&miniflux.Filter{Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 {
+		t.Fatalf(`Importing the same article twice should not duplicate it, got %d entries`, results.Total)
+	}
+
+	if entry := results.Entries[0]; entry.Status != "read" || entry.URL != testWebsiteURL+"imported-article" {
+		t.Errorf(`Unexpected imported entry: %q, %q`, entry.Status, entry.URL)
+	}
+}
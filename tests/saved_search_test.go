@@ -0,0 +1,131 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateSavedSearch(t *testing.T) {
+	client := createClient(t)
+	savedSearch, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Releases", Query: "2.0.8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if savedSearch.ID == 0 {
+		t.Fatalf(`Invalid saved search ID, got "%v"`, savedSearch.ID)
+	}
+
+	if savedSearch.UserID <= 0 {
+		t.Fatalf(`Invalid userID, got "%v"`, savedSearch.UserID)
+	}
+
+	if savedSearch.Title != "Releases" || savedSearch.Query != "2.0.8" {
+		t.Fatalf(`Invalid saved search, got "%v"`, savedSearch)
+	}
+}
+
+func TestCannotCreateInvalidSavedSearch(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Empty"}); err == nil {
+		t.Fatal(`The query should be mandatory`)
+	}
+
+	if _, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Invalid", Query: "before:yesterday"}); err == nil {
+		t.Fatal(`Invalid queries should be rejected`)
+	}
+
+	if _, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Status", Query: "go", Status: "removed"}); err == nil {
+		t.Fatal(`Invalid status should be rejected`)
+	}
+
+	if _, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Releases", Query: "2.0.8"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Releases", Query: "2.0.9"}); err == nil {
+		t.Fatal(`Duplicated saved searches should not be allowed`)
+	}
+}
+
+func TestUpdateSavedSearch(t *testing.T) {
+	client := createClient(t)
+	savedSearch, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Releases", Query: "2.0.8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updatedSearch, err := client.UpdateSavedSearch(savedSearch.ID, &miniflux.SavedSearch{Title: "Unread releases", Query: "2.0.8", Status: "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedSearch.ID != savedSearch.ID || updatedSearch.Title != "Unread releases" || updatedSearch.Status != "unread" {
+		t.Fatalf(`Invalid saved search, got "%v"`, updatedSearch)
+	}
+}
+
+func TestSavedSearchEntries(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	savedSearch, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Releases", Query: "2.0.8", Status: "unread"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	savedSearches, err := client.SavedSearches()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(savedSearches) != 1 || savedSearches[0].UnreadCount != 1 {
+		t.Fatalf(`Invalid saved searches, got "%v"`, savedSearches)
+	}
+
+	results, err := client.SavedSearchEntries(savedSearch.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 {
+		t.Fatalf(`We should have only one entry instead of %d`, results.Total)
+	}
+
+	if err := client.MarkSavedSearchAsRead(savedSearch.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err = client.SavedSearchEntries(savedSearch.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Fatalf(`All entries of the saved search should be read, got %d unread entries`, results.Total)
+	}
+}
+
+func TestDeleteSavedSearch(t *testing.T) {
+	client := createClient(t)
+	savedSearch, err := client.CreateSavedSearch(&miniflux.SavedSearch{Title: "Releases", Query: "2.0.8"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteSavedSearch(savedSearch.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteSavedSearch(savedSearch.ID); err == nil {
+		t.Fatal(`Removing a saved search twice should fail`)
+	}
+}
@@ -38,6 +38,11 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 
 	categoryForm := form.CategoryForm{
 		Title: category.Title,
+		RetentionForm: form.RetentionForm{
+			ArchiveReadDays: category.ArchiveReadDays,
+			MaxEntries:      category.MaxEntries,
+			MarkAsReadDays:  category.MarkAsReadDays,
+		},
 	}
 
 	view.Set("form", categoryForm)
@@ -0,0 +1,47 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+)
+
+func (h *handler) saveEnclosureProgression(w http.ResponseWriter, r *http.Request) {
+	mediaProgression, played, err := decodeEnclosureProgressionPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+	enclosure, err := h.store.EnclosureByID(request.UserID(r), enclosureID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if mediaProgression != nil {
+		enclosure.MediaProgression = *mediaProgression
+	}
+
+	if played != nil {
+		enclosure.Played = *played
+	}
+
+	if err := h.store.UpdateEnclosureProgression(enclosure); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, "OK")
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+func (h *handler) createEntryHighlight(w http.ResponseWriter, r *http.Request) {
+	text, err := decodeEntryHighlightPayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	entryID := request.RouteInt64Param(r, "entryID")
+	if !h.store.EntryExists(userID, entryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	highlight := &model.EntryHighlight{UserID: userID, EntryID: entryID, Text: strings.TrimSpace(text)}
+	if err := highlight.ValidateEntryHighlight(); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.CreateEntryHighlight(highlight); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, highlight)
+}
+
+func (h *handler) removeEntryHighlight(w http.ResponseWriter, r *http.Request) {
+	err := h.store.RemoveEntryHighlight(
+		request.UserID(r),
+		request.RouteInt64Param(r, "entryID"),
+		request.RouteInt64Param(r, "highlightID"),
+	)
+	if err != nil {
+		logger.Error("[UI:RemoveEntryHighlight] %v", err)
+	}
+
+	json.OK(w, r, "OK")
+}
@@ -36,9 +36,10 @@ func (h *handler) saveEntry(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	go func() {
-		integration.SendEntry(entry, settings)
-	}()
+	if err := integration.QueueEntry(h.store, entry, settings); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
 
 	json.Created(w, r, map[string]string{"message": "saved"})
 }
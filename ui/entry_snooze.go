@@ -0,0 +1,67 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/timezone"
+)
+
+func (h *handler) snoozeEntries(w http.ResponseWriter, r *http.Request) {
+	entryIDs, value, err := decodeEntrySnoozePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if len(entryIDs) == 0 {
+		json.BadRequest(w, r, errors.New("The list of entry IDs is empty"))
+		return
+	}
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	until, err := model.SnoozeTime(value, timezone.Now(user.Timezone))
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.SnoozeEntries(user.ID, entryIDs, until); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, "OK")
+}
+
+func (h *handler) unsnoozeEntries(w http.ResponseWriter, r *http.Request) {
+	entryIDs, _, err := decodeEntrySnoozePayload(r.Body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if len(entryIDs) == 0 {
+		json.BadRequest(w, r, errors.New("The list of entry IDs is empty"))
+		return
+	}
+
+	if err := h.store.UnsnoozeEntries(request.UserID(r), entryIDs); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, "OK")
+}
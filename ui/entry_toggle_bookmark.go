@@ -9,6 +9,8 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/integration"
+	"miniflux.app/logger"
 )
 
 func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
@@ -18,5 +20,9 @@ func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := integration.QueueStarredEntry(h.store, request.UserID(r), entryID); err != nil {
+		logger.Error("[UI:ToggleBookmark] %v", err)
+	}
+
 	json.OK(w, r, "OK")
 }
@@ -10,6 +10,7 @@ import (
 	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/search"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -52,6 +53,21 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		Username:     feed.Username,
 		Password:     feed.Password,
 		Disabled:     feed.Disabled,
+		Notify:       feed.Notify,
+
+		SearchLanguage: feed.SearchLanguage,
+
+		RetentionForm: form.RetentionForm{
+			ArchiveReadDays: feed.ArchiveReadDays,
+			MaxEntries:      feed.MaxEntries,
+			MarkAsReadDays:  feed.MarkAsReadDays,
+		},
+
+		ItemSelector:    feed.ItemSelector,
+		TitleSelector:   feed.TitleSelector,
+		LinkSelector:    feed.LinkSelector,
+		DateSelector:    feed.DateSelector,
+		ContentSelector: feed.ContentSelector,
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
@@ -64,6 +80,7 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
 	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
 	view.Set("defaultUserAgent", client.DefaultUserAgent)
+	view.Set("searchLanguages", search.Configurations())
 
 	html.OK(w, r, view.Render("edit_feed"))
 }
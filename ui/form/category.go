@@ -14,6 +14,8 @@ import (
 // CategoryForm represents a feed form in the UI
 type CategoryForm struct {
 	Title string
+
+	RetentionForm
 }
 
 // Validate makes sure the form values are valid.
@@ -21,18 +23,22 @@ func (c CategoryForm) Validate() error {
 	if c.Title == "" {
 		return errors.NewLocalizedError("error.title_required")
 	}
-	return nil
+	return c.RetentionForm.Validate()
 }
 
 // Merge update the given category fields.
 func (c CategoryForm) Merge(category *model.Category) *model.Category {
 	category.Title = c.Title
+	category.ArchiveReadDays = c.ArchiveReadDays
+	category.MaxEntries = c.MaxEntries
+	category.MarkAsReadDays = c.MarkAsReadDays
 	return category
 }
 
 // NewCategoryForm returns a new CategoryForm.
 func NewCategoryForm(r *http.Request) *CategoryForm {
 	return &CategoryForm{
-		Title: r.FormValue("title"),
+		Title:         r.FormValue("title"),
+		RetentionForm: newRetentionForm(r),
 	}
 }
@@ -0,0 +1,95 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/mail"
+	"strconv"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// DigestForm represents the email digest settings form.
+type DigestForm struct {
+	Enabled     bool
+	Email       string
+	Frequency   string
+	Hour        int
+	Weekday     int
+	CategoryIDs []int64
+	MarkAsRead  bool
+}
+
+// Validate makes sure the form values are valid.
+func (d *DigestForm) Validate() error {
+	if !d.Enabled {
+		return nil
+	}
+
+	if _, err := mail.ParseAddress(d.Email); err != nil {
+		return errors.NewLocalizedError("error.invalid_email")
+	}
+
+	if d.Frequency != model.DigestFrequencyDaily && d.Frequency != model.DigestFrequencyWeekly {
+		return errors.NewLocalizedError("error.invalid_digest_frequency")
+	}
+
+	if d.Hour < 0 || d.Hour > 23 || d.Weekday < 0 || d.Weekday > 6 {
+		return errors.NewLocalizedError("error.invalid_digest_schedule")
+	}
+
+	return nil
+}
+
+// Merge updates the fields of the given digest settings.
+// Only the address is stored, without the display name accepted by the validation.
+func (d *DigestForm) Merge(digest *model.Digest) *model.Digest {
+	digest.Enabled = d.Enabled
+	digest.Email = d.Email
+	if address, err := mail.ParseAddress(d.Email); err == nil {
+		digest.Email = address.Address
+	}
+	digest.Frequency = d.Frequency
+	digest.Hour = d.Hour
+	digest.Weekday = d.Weekday
+	digest.CategoryIDs = d.CategoryIDs
+	digest.MarkAsRead = d.MarkAsRead
+	return digest
+}
+
+// HasCategory returns true if the given category is selected.
+func (d *DigestForm) HasCategory(categoryID int64) bool {
+	for _, id := range d.CategoryIDs {
+		if id == categoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDigestForm returns a new DigestForm.
+func NewDigestForm(r *http.Request) *DigestForm {
+	hour, err := strconv.Atoi(r.FormValue("hour"))
+	if err != nil {
+		hour = -1
+	}
+
+	weekday, err := strconv.Atoi(r.FormValue("weekday"))
+	if err != nil {
+		weekday = -1
+	}
+
+	return &DigestForm{
+		Enabled:     r.FormValue("enabled") == "1",
+		Email:       r.FormValue("email"),
+		Frequency:   r.FormValue("frequency"),
+		Hour:        hour,
+		Weekday:     weekday,
+		CategoryIDs: formIDValues(r, "category_ids"),
+		MarkAsRead:  r.FormValue("mark_as_read") == "1",
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestDigestFormStoresEmailAddress(t *testing.T) {
+	digestForm := &DigestForm{
+		Enabled:   true,
+		Email:     "John Doe <john@example.org>",
+		Frequency: model.DigestFrequencyDaily,
+		Hour:      8,
+		Weekday:   1,
+	}
+
+	if err := digestForm.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	digest := digestForm.Merge(&model.Digest{})
+	if digest.Email != "john@example.org" {
+		t.Errorf(`Only the email address should be stored, got %q`, digest.Email)
+	}
+}
+
+func TestDigestFormRejectsInvalidEmail(t *testing.T) {
+	digestForm := &DigestForm{
+		Enabled:   true,
+		Email:     "john",
+		Frequency: model.DigestFrequencyDaily,
+		Hour:      8,
+		Weekday:   1,
+	}
+
+	if err := digestForm.Validate(); err == nil {
+		t.Error(`Invalid email addresses should be rejected`)
+	}
+}
@@ -7,9 +7,11 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+	"miniflux.app/search"
 )
 
 // FeedForm represents a feed form in the UI
@@ -25,6 +27,17 @@ type FeedForm struct {
 	Username     string
 	Password     string
 	Disabled     bool
+	Notify       bool
+
+	SearchLanguage string
+
+	RetentionForm
+
+	ItemSelector    string
+	TitleSelector   string
+	LinkSelector    string
+	DateSelector    string
+	ContentSelector string
 }
 
 // ValidateModification validates FeedForm fields
@@ -32,7 +45,12 @@ func (f FeedForm) ValidateModification() error {
 	if f.FeedURL == "" || f.SiteURL == "" || f.Title == "" || f.CategoryID == 0 {
 		return errors.NewLocalizedError("error.fields_mandatory")
 	}
-	return nil
+
+	if f.SearchLanguage != "" && !search.IsValidConfiguration(f.SearchLanguage) {
+		return errors.NewLocalizedError("error.invalid_search_language")
+	}
+
+	return f.RetentionForm.Validate()
 }
 
 // Merge updates the fields of the given feed.
@@ -50,6 +68,12 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.Username = f.Username
 	feed.Password = f.Password
 	feed.Disabled = f.Disabled
+	feed.Notify = f.Notify
+	feed.SearchLanguage = f.SearchLanguage
+	feed.ArchiveReadDays = f.ArchiveReadDays
+	feed.MaxEntries = f.MaxEntries
+	feed.MarkAsReadDays = f.MarkAsReadDays
+	feed.WithSelectors(f.ItemSelector, f.TitleSelector, f.LinkSelector, f.DateSelector, f.ContentSelector)
 	return feed
 }
 
@@ -72,5 +96,16 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		Username:     r.FormValue("feed_username"),
 		Password:     r.FormValue("feed_password"),
 		Disabled:     r.FormValue("disabled") == "1",
+		Notify:       r.FormValue("notify") == "1",
+
+		SearchLanguage: r.FormValue("search_language"),
+
+		RetentionForm: newRetentionForm(r),
+
+		ItemSelector:    strings.TrimSpace(r.FormValue("item_selector")),
+		TitleSelector:   strings.TrimSpace(r.FormValue("title_selector")),
+		LinkSelector:    strings.TrimSpace(r.FormValue("link_selector")),
+		DateSelector:    strings.TrimSpace(r.FormValue("date_selector")),
+		ContentSelector: strings.TrimSpace(r.FormValue("content_selector")),
 	}
 }
@@ -6,6 +6,7 @@ package form // import "miniflux.app/ui/form"
 
 import (
 	"net/http"
+	"strconv"
 
 	"miniflux.app/model"
 )
@@ -34,6 +35,28 @@ type IntegrationForm struct {
 	PocketEnabled        bool
 	PocketAccessToken    string
 	PocketConsumerKey    string
+	WebhookEnabled       bool
+	WebhookURL           string
+	WebhookSecret        string
+	WebhookNewEntries    bool
+	WebhookSaveEntry     bool
+	LinkdingEnabled      bool
+	LinkdingURL          string
+	LinkdingAPIKey       string
+	LinkdingTags         string
+	LinkdingMarkAsUnread bool
+	ShaarliEnabled       bool
+	ShaarliURL           string
+	ShaarliAPISecret     string
+	ShaarliTags          string
+	ShaarliPrivate       bool
+	MatrixEnabled        bool
+	MatrixURL            string
+	MatrixAccessToken    string
+	MatrixRoomID         string
+	TelegramEnabled      bool
+	TelegramBotToken     string
+	TelegramChatID       string
 }
 
 // Merge copy form values to the model.
@@ -60,6 +83,27 @@ func (i IntegrationForm) Merge(integration *model.Integration) {
 	integration.PocketEnabled = i.PocketEnabled
 	integration.PocketAccessToken = i.PocketAccessToken
 	integration.PocketConsumerKey = i.PocketConsumerKey
+	integration.WebhookEnabled = i.WebhookEnabled
+	integration.WebhookURL = i.WebhookURL
+	integration.WebhookNewEntries = i.WebhookNewEntries
+	integration.WebhookSaveEntry = i.WebhookSaveEntry
+	integration.LinkdingEnabled = i.LinkdingEnabled
+	integration.LinkdingURL = i.LinkdingURL
+	integration.LinkdingAPIKey = i.LinkdingAPIKey
+	integration.LinkdingTags = i.LinkdingTags
+	integration.LinkdingMarkAsUnread = i.LinkdingMarkAsUnread
+	integration.ShaarliEnabled = i.ShaarliEnabled
+	integration.ShaarliURL = i.ShaarliURL
+	integration.ShaarliAPISecret = i.ShaarliAPISecret
+	integration.ShaarliTags = i.ShaarliTags
+	integration.ShaarliPrivate = i.ShaarliPrivate
+	integration.MatrixEnabled = i.MatrixEnabled
+	integration.MatrixURL = i.MatrixURL
+	integration.MatrixAccessToken = i.MatrixAccessToken
+	integration.MatrixRoomID = i.MatrixRoomID
+	integration.TelegramEnabled = i.TelegramEnabled
+	integration.TelegramBotToken = i.TelegramBotToken
+	integration.TelegramChatID = i.TelegramChatID
 }
 
 // NewIntegrationForm returns a new AuthForm.
@@ -87,5 +131,57 @@ func NewIntegrationForm(r *http.Request) *IntegrationForm {
 		PocketEnabled:        r.FormValue("pocket_enabled") == "1",
 		PocketAccessToken:    r.FormValue("pocket_access_token"),
 		PocketConsumerKey:    r.FormValue("pocket_consumer_key"),
+		WebhookEnabled:       r.FormValue("webhook_enabled") == "1",
+		WebhookURL:           r.FormValue("webhook_url"),
+		WebhookNewEntries:    r.FormValue("webhook_new_entries") == "1",
+		WebhookSaveEntry:     r.FormValue("webhook_save_entry") == "1",
+		LinkdingEnabled:      r.FormValue("linkding_enabled") == "1",
+		LinkdingURL:          r.FormValue("linkding_url"),
+		LinkdingAPIKey:       r.FormValue("linkding_api_key"),
+		LinkdingTags:         r.FormValue("linkding_tags"),
+		LinkdingMarkAsUnread: r.FormValue("linkding_mark_as_unread") == "1",
+		ShaarliEnabled:       r.FormValue("shaarli_enabled") == "1",
+		ShaarliURL:           r.FormValue("shaarli_url"),
+		ShaarliAPISecret:     r.FormValue("shaarli_api_secret"),
+		ShaarliTags:          r.FormValue("shaarli_tags"),
+		ShaarliPrivate:       r.FormValue("shaarli_private") == "1",
+		MatrixEnabled:        r.FormValue("matrix_enabled") == "1",
+		MatrixURL:            r.FormValue("matrix_url"),
+		MatrixAccessToken:    r.FormValue("matrix_access_token"),
+		MatrixRoomID:         r.FormValue("matrix_room_id"),
+		TelegramEnabled:      r.FormValue("telegram_enabled") == "1",
+		TelegramBotToken:     r.FormValue("telegram_bot_token"),
+		TelegramChatID:       r.FormValue("telegram_chat_id"),
 	}
 }
+
+// NewIntegrationRules returns the automatic delivery settings submitted with the integration form.
+func NewIntegrationRules(r *http.Request, services []string) model.IntegrationRules {
+	var rules model.IntegrationRules
+	for _, service := range services {
+		rules = append(rules, &model.IntegrationRule{
+			Service:     service,
+			OnBookmark:  r.FormValue(service+"_auto_bookmark") == "1",
+			FeedIDs:     formIDValues(r, service+"_auto_feed_ids"),
+			CategoryIDs: formIDValues(r, service+"_auto_category_ids"),
+			Keywords:    r.FormValue(service + "_auto_keywords"),
+		})
+	}
+
+	return rules
+}
+
+func formIDValues(r *http.Request, name string) []int64 {
+	ids := make([]int64, 0)
+	if err := r.ParseForm(); err != nil {
+		return ids
+	}
+
+	for _, value := range r.Form[name] {
+		if id, err := strconv.ParseInt(value, 10, 64); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
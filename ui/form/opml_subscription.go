@@ -0,0 +1,41 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"miniflux.app/errors"
+)
+
+// OPMLSubscriptionForm represents the form used to follow a remote OPML file.
+type OPMLSubscriptionForm struct {
+	URL           string
+	RemoveMissing bool
+}
+
+// Validate makes sure the form values are valid.
+func (o *OPMLSubscriptionForm) Validate() error {
+	if o.URL == "" {
+		return errors.NewLocalizedError("error.opml_subscription_url_required")
+	}
+
+	u, err := url.Parse(o.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.NewLocalizedError("error.invalid_opml_subscription_url")
+	}
+
+	return nil
+}
+
+// NewOPMLSubscriptionForm returns a new OPMLSubscriptionForm.
+func NewOPMLSubscriptionForm(r *http.Request) *OPMLSubscriptionForm {
+	return &OPMLSubscriptionForm{
+		URL:           strings.TrimSpace(r.FormValue("url")),
+		RemoveMissing: r.FormValue("remove_missing") == "1",
+	}
+}
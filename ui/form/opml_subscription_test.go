@@ -0,0 +1,25 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+)
+
+func TestValidOPMLSubscriptionForm(t *testing.T) {
+	form := &OPMLSubscriptionForm{URL: "https://example.org/feeds.opml"}
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOPMLSubscriptionFormWithInvalidURL(t *testing.T) {
+	for _, value := range []string{"", "example.org/feeds.opml", "ftp://example.org/feeds.opml", "https://"} {
+		form := &OPMLSubscriptionForm{URL: value}
+		if err := form.Validate(); err == nil {
+			t.Errorf(`The URL %q should be rejected`, value)
+		}
+	}
+}
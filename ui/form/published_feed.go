@@ -0,0 +1,55 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// PublishedFeedForm represents the form used to publish a new feed.
+type PublishedFeedForm struct {
+	Title      string
+	Source     string
+	CategoryID int64
+	Tag        string
+}
+
+// Validate makes sure the form values are valid.
+func (p *PublishedFeedForm) Validate() error {
+	switch p.Source {
+	case model.PublishedFeedSourceStarred:
+		return nil
+	case model.PublishedFeedSourceCategory:
+		if p.CategoryID > 0 {
+			return nil
+		}
+	case model.PublishedFeedSourceTag:
+		if p.Tag != "" {
+			return nil
+		}
+	}
+
+	return errors.NewLocalizedError("error.invalid_published_feed_source")
+}
+
+// NewPublishedFeedForm returns a new PublishedFeedForm.
+func NewPublishedFeedForm(r *http.Request) *PublishedFeedForm {
+	categoryID, err := strconv.ParseInt(r.FormValue("category_id"), 10, 64)
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &PublishedFeedForm{
+		Title:      r.FormValue("title"),
+		Source:     r.FormValue("source"),
+		CategoryID: categoryID,
+		Tag:        strings.TrimSpace(r.FormValue("tag")),
+	}
+}
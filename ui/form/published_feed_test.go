@@ -0,0 +1,54 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestPublishedFeedFormValidate(t *testing.T) {
+	validForms := []*PublishedFeedForm{
+		{Source: "starred"},
+		{Source: "category", CategoryID: 1},
+		{Source: "tag", Tag: "golang"},
+	}
+
+	for _, form := range validForms {
+		if err := form.Validate(); err != nil {
+			t.Errorf(`The form %+v should be valid: %v`, form, err)
+		}
+	}
+
+	invalidForms := []*PublishedFeedForm{
+		{Source: ""},
+		{Source: "category"},
+		{Source: "tag"},
+		{Source: "feed", CategoryID: 1, Tag: "golang"},
+	}
+
+	for _, form := range invalidForms {
+		if err := form.Validate(); err == nil {
+			t.Errorf(`The form %+v should not be valid`, form)
+		}
+	}
+}
+
+func TestNewPublishedFeedFormWithTag(t *testing.T) {
+	values := url.Values{"source": {"tag"}, "tag": {"  golang "}, "title": {""}}
+	r, _ := http.NewRequest("POST", "/published-feeds", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewPublishedFeedForm(r)
+	if form.Source != "tag" || form.Tag != "golang" {
+		t.Errorf(`Unexpected form values: %+v`, form)
+	}
+
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
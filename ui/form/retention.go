@@ -0,0 +1,54 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// RetentionForm represents the retention policy of a feed or a category.
+type RetentionForm struct {
+	ArchiveReadDays int
+	MaxEntries      int
+	MarkAsReadDays  int
+}
+
+// Validate makes sure the retention settings are valid.
+func (f RetentionForm) Validate() error {
+	if err := model.ValidateRetentionPolicy(f.ArchiveReadDays, f.MaxEntries, f.MarkAsReadDays); err != nil {
+		return errors.NewLocalizedError("error.invalid_retention_policy")
+	}
+
+	return nil
+}
+
+func newRetentionForm(r *http.Request) RetentionForm {
+	return RetentionForm{
+		ArchiveReadDays: retentionValue(r, "archive_read_days"),
+		MaxEntries:      retentionValue(r, "max_entries"),
+		MarkAsReadDays:  retentionValue(r, "mark_as_read_days"),
+	}
+}
+
+// retentionValue returns the value of a retention field, an empty field inherits the setting.
+func retentionValue(r *http.Request, name string) int {
+	value := strings.TrimSpace(r.FormValue(name))
+	if value == "" {
+		return 0
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		// Let the validation refuse the value.
+		return -2
+	}
+
+	return number
+}
@@ -0,0 +1,41 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestNewRetentionForm(t *testing.T) {
+	values := url.Values{"archive_read_days": {""}, "max_entries": {" 500 "}, "mark_as_read_days": {"-1"}}
+	r, _ := http.NewRequest("POST", "/", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := newRetentionForm(r)
+	if form.ArchiveReadDays != 0 || form.MaxEntries != 500 || form.MarkAsReadDays != -1 {
+		t.Fatalf(`Unexpected retention form: %+v`, form)
+	}
+
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInvalidRetentionForm(t *testing.T) {
+	values := url.Values{"max_entries": {"many"}}
+	r, _ := http.NewRequest("POST", "/", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if err := newRetentionForm(r).Validate(); err == nil {
+		t.Error(`A retention setting that is not a number should be refused`)
+	}
+
+	if err := (RetentionForm{ArchiveReadDays: -7}).Validate(); err == nil {
+		t.Error(`A retention setting lower than -1 should be refused`)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+	"miniflux.app/search"
+)
+
+// SavedSearchForm represents the form used to save a search.
+type SavedSearchForm struct {
+	Title      string
+	Query      string
+	Status     string
+	CategoryID int64
+}
+
+// Validate makes sure the form values are valid.
+func (s SavedSearchForm) Validate() error {
+	if s.Title == "" {
+		return errors.NewLocalizedError("error.title_required")
+	}
+
+	if s.Query == "" {
+		return errors.NewLocalizedError("error.saved_search_query_required")
+	}
+
+	if s.Status != "" && s.Status != model.EntryStatusUnread && s.Status != model.EntryStatusRead {
+		return errors.NewLocalizedError("error.invalid_saved_search_status")
+	}
+
+	if _, err := search.Parse(s.Query, "UTC"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Merge updates the fields of the given saved search.
+func (s SavedSearchForm) Merge(savedSearch *model.SavedSearch) *model.SavedSearch {
+	savedSearch.Title = s.Title
+	savedSearch.Query = s.Query
+	savedSearch.Status = s.Status
+	savedSearch.CategoryID = s.CategoryID
+	return savedSearch
+}
+
+// NewSavedSearchForm returns a new SavedSearchForm.
+func NewSavedSearchForm(r *http.Request) *SavedSearchForm {
+	categoryID, err := strconv.ParseInt(r.FormValue("category_id"), 10, 64)
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &SavedSearchForm{
+		Title:      strings.TrimSpace(r.FormValue("title")),
+		Query:      strings.TrimSpace(r.FormValue("query")),
+		Status:     r.FormValue("status"),
+		CategoryID: categoryID,
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"testing"
+)
+
+func TestValidSavedSearchForm(t *testing.T) {
+	form := &SavedSearchForm{Title: "Releases", Query: `"release notes" category:Dev`, Status: "unread"}
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInvalidSavedSearchForm(t *testing.T) {
+	forms := []*SavedSearchForm{
+		{Query: "golang"},
+		{Title: "Go"},
+		{Title: "Go", Query: "golang", Status: "removed"},
+		{Title: "Go", Query: "golang OR"},
+	}
+
+	for _, form := range forms {
+		if err := form.Validate(); err == nil {
+			t.Errorf(`The form %+v should be rejected`, form)
+		}
+	}
+}
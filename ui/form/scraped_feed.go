@@ -0,0 +1,69 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/reader/selector"
+)
+
+// ScrapedFeedForm represents the form used to create a feed from a web page.
+type ScrapedFeedForm struct {
+	URL             string
+	CategoryID      int64
+	UserAgent       string
+	ItemSelector    string
+	TitleSelector   string
+	LinkSelector    string
+	DateSelector    string
+	ContentSelector string
+}
+
+// Validate makes sure the form values are valid.
+func (s *ScrapedFeedForm) Validate() error {
+	if s.URL == "" || s.CategoryID == 0 {
+		return errors.NewLocalizedError("error.feed_mandatory_fields")
+	}
+
+	if s.ItemSelector == "" {
+		return errors.NewLocalizedError("error.item_selector_mandatory")
+	}
+
+	return nil
+}
+
+// Rules returns the CSS selectors of the form.
+func (s *ScrapedFeedForm) Rules() *selector.Rules {
+	return &selector.Rules{
+		Item:    s.ItemSelector,
+		Title:   s.TitleSelector,
+		Link:    s.LinkSelector,
+		Date:    s.DateSelector,
+		Content: s.ContentSelector,
+	}
+}
+
+// NewScrapedFeedForm returns a new ScrapedFeedForm.
+func NewScrapedFeedForm(r *http.Request) *ScrapedFeedForm {
+	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &ScrapedFeedForm{
+		URL:             strings.TrimSpace(r.FormValue("url")),
+		CategoryID:      int64(categoryID),
+		UserAgent:       r.FormValue("user_agent"),
+		ItemSelector:    strings.TrimSpace(r.FormValue("item_selector")),
+		TitleSelector:   strings.TrimSpace(r.FormValue("title_selector")),
+		LinkSelector:    strings.TrimSpace(r.FormValue("link_selector")),
+		DateSelector:    strings.TrimSpace(r.FormValue("date_selector")),
+		ContentSelector: strings.TrimSpace(r.FormValue("content_selector")),
+	}
+}
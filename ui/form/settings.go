@@ -9,6 +9,7 @@ import (
 
 	"miniflux.app/errors"
 	"miniflux.app/model"
+	"miniflux.app/search"
 )
 
 // SettingsForm represents the settings form.
@@ -21,6 +22,7 @@ type SettingsForm struct {
 	Timezone          string
 	EntryDirection    string
 	KeyboardShortcuts bool
+	SearchLanguage    string
 }
 
 // Merge updates the fields of the given user.
@@ -31,6 +33,7 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.Timezone = s.Timezone
 	user.EntryDirection = s.EntryDirection
 	user.KeyboardShortcuts = s.KeyboardShortcuts
+	user.SearchLanguage = s.SearchLanguage
 
 	if s.Password != "" {
 		user.Password = s.Password
@@ -45,6 +48,10 @@ func (s *SettingsForm) Validate() error {
 		return errors.NewLocalizedError("error.settings_mandatory_fields")
 	}
 
+	if s.SearchLanguage != "" && !search.IsValidConfiguration(s.SearchLanguage) {
+		return errors.NewLocalizedError("error.invalid_search_language")
+	}
+
 	if s.Confirmation == "" {
 		// Firefox insists on auto-completing the password field.
 		// If the confirmation field is blank, the user probably
@@ -74,5 +81,6 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		Timezone:          r.FormValue("timezone"),
 		EntryDirection:    r.FormValue("entry_direction"),
 		KeyboardShortcuts: r.FormValue("keyboard_shortcuts") == "1",
+		SearchLanguage:    r.FormValue("search_language"),
 	}
 }
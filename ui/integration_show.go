@@ -10,6 +10,7 @@ import (
 	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/newsletter"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -51,11 +52,75 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 		PocketEnabled:        integration.PocketEnabled,
 		PocketAccessToken:    integration.PocketAccessToken,
 		PocketConsumerKey:    integration.PocketConsumerKey,
+		WebhookEnabled:       integration.WebhookEnabled,
+		WebhookURL:           integration.WebhookURL,
+		WebhookSecret:        integration.WebhookSecret,
+		WebhookNewEntries:    integration.WebhookNewEntries,
+		WebhookSaveEntry:     integration.WebhookSaveEntry,
+		LinkdingEnabled:      integration.LinkdingEnabled,
+		LinkdingURL:          integration.LinkdingURL,
+		LinkdingAPIKey:       integration.LinkdingAPIKey,
+		LinkdingTags:         integration.LinkdingTags,
+		LinkdingMarkAsUnread: integration.LinkdingMarkAsUnread,
+		ShaarliEnabled:       integration.ShaarliEnabled,
+		ShaarliURL:           integration.ShaarliURL,
+		ShaarliAPISecret:     integration.ShaarliAPISecret,
+		ShaarliTags:          integration.ShaarliTags,
+		ShaarliPrivate:       integration.ShaarliPrivate,
+		MatrixEnabled:        integration.MatrixEnabled,
+		MatrixURL:            integration.MatrixURL,
+		MatrixAccessToken:    integration.MatrixAccessToken,
+		MatrixRoomID:         integration.MatrixRoomID,
+		TelegramEnabled:      integration.TelegramEnabled,
+		TelegramBotToken:     integration.TelegramBotToken,
+		TelegramChatID:       integration.TelegramChatID,
+	}
+
+	rules, err := h.store.IntegrationRules(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feeds, err := h.store.Feeds(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	deliveries, err := h.store.IntegrationDeliveries(user.ID, 50)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	deliveries.UseTimezone(user.Timezone)
+
+	var newsletterAddress string
+	if newsletter.IsEnabled() {
+		token, err := h.store.NewsletterToken(user.ID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		newsletterAddress = newsletter.Address(token, "name")
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", integrationForm)
+	view.Set("rules", rules)
+	view.Set("categories", categories)
+	view.Set("feeds", feeds)
+	view.Set("deliveries", deliveries)
+	view.Set("newsletterAddress", newsletterAddress)
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
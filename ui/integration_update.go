@@ -9,9 +9,11 @@ import (
 	"fmt"
 	"net/http"
 
+	"miniflux.app/crypto"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/request"
 	"miniflux.app/http/route"
+	"miniflux.app/integration"
 	"miniflux.app/locale"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
@@ -26,33 +28,52 @@ func (h *handler) updateIntegration(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	integration, err := h.store.Integration(user.ID)
+	rules := form.NewIntegrationRules(r, integration.AutomaticServices())
+
+	userIntegration, err := h.store.Integration(user.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
 	integrationForm := form.NewIntegrationForm(r)
-	integrationForm.Merge(integration)
+	integrationForm.Merge(userIntegration)
 
-	if integration.FeverUsername != "" && h.store.HasDuplicateFeverUsername(user.ID, integration.FeverUsername) {
+	if userIntegration.FeverUsername != "" && h.store.HasDuplicateFeverUsername(user.ID, userIntegration.FeverUsername) {
 		sess.NewFlashErrorMessage(printer.Printf("error.duplicate_fever_username"))
 		html.Redirect(w, r, route.Path(h.router, "integrations"))
 		return
 	}
 
-	if integration.FeverEnabled {
-		integration.FeverToken = fmt.Sprintf("%x", md5.Sum([]byte(integration.FeverUsername+":"+integration.FeverPassword)))
+	if userIntegration.FeverEnabled {
+		userIntegration.FeverToken = fmt.Sprintf("%x", md5.Sum([]byte(userIntegration.FeverUsername+":"+userIntegration.FeverPassword)))
 	} else {
-		integration.FeverToken = ""
+		userIntegration.FeverToken = ""
+	}
+
+	if userIntegration.WebhookEnabled {
+		if userIntegration.WebhookURL == "" {
+			sess.NewFlashErrorMessage(printer.Printf("error.webhook_url_required"))
+			html.Redirect(w, r, route.Path(h.router, "integrations"))
+			return
+		}
+
+		if userIntegration.WebhookSecret == "" {
+			userIntegration.WebhookSecret = crypto.GenerateRandomString(32)
+		}
 	}
 
-	err = h.store.UpdateIntegration(integration)
+	err = h.store.UpdateIntegration(userIntegration)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	if err := h.store.UpdateIntegrationRules(user.ID, rules); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	sess.NewFlashMessage(printer.Printf("alert.prefs_saved"))
 	html.Redirect(w, r, route.Path(h.router, "integrations"))
 }
@@ -134,6 +134,8 @@ func (m *middleware) isPublicRoute(r *http.Request) bool {
 		"favicon",
 		"webManifest",
 		"robots",
+		"sharedEntry",
+		"proxy",
 		"healthcheck":
 		return true
 	default:
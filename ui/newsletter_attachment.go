@@ -0,0 +1,36 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+)
+
+func (h *handler) showNewsletterAttachment(w http.ResponseWriter, r *http.Request) {
+	attachment, err := h.store.NewsletterAttachment(request.UserID(r), request.RouteStringParam(r, "token"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if attachment == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	response.New(w, r).WithCaching(crypto.HashFromBytes(attachment.Content), 72*time.Hour, func(b *response.Builder) {
+		b.WithHeader("Content-Type", attachment.MimeType)
+		b.WithAttachment(attachment.Filename)
+		b.WithBody(attachment.Content)
+		b.WithoutCompression()
+		b.Write()
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/opml"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) createOPMLSubscription(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	subscriptions, err := h.store.OPMLSubscriptions(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	changes, err := h.store.OPMLSubscriptionChanges(user.ID, model.OPMLSubscriptionMaxChanges)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	subscriptions.UseTimezone(user.Timezone)
+	changes.UseTimezone(user.Timezone)
+	subscriptionForm := form.NewOPMLSubscriptionForm(r)
+
+	view.Set("form", subscriptionForm)
+	view.Set("subscriptions", subscriptions)
+	view.Set("changes", changes)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := subscriptionForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("opml_subscriptions"))
+		return
+	}
+
+	if h.store.OPMLSubscriptionURLExists(user.ID, subscriptionForm.URL) {
+		view.Set("errorMessage", "error.opml_subscription_already_exists")
+		html.OK(w, r, view.Render("opml_subscriptions"))
+		return
+	}
+
+	subscription := &model.OPMLSubscription{
+		UserID:        user.ID,
+		URL:           subscriptionForm.URL,
+		RemoveMissing: subscriptionForm.RemoveMissing,
+	}
+
+	if err := h.store.CreateOPMLSubscription(subscription); err != nil {
+		logger.Error("[UI:CreateOPMLSubscription] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_opml_subscription")
+		html.OK(w, r, view.Render("opml_subscriptions"))
+		return
+	}
+
+	jobs, err := opml.NewHandler(h.store).Sync(subscription)
+	if err != nil {
+		logger.Error("[UI:CreateOPMLSubscription] %v", err)
+	}
+
+	go func() {
+		h.pool.Push(jobs)
+	}()
+
+	html.Redirect(w, r, route.Path(h.router, "opmlSubscriptions"))
+}
@@ -0,0 +1,52 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showOPMLSubscriptionsPage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	subscriptions, err := h.store.OPMLSubscriptions(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	changes, err := h.store.OPMLSubscriptionChanges(user.ID, model.OPMLSubscriptionMaxChanges)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	subscriptions.UseTimezone(user.Timezone)
+	changes.UseTimezone(user.Timezone)
+
+	view.Set("form", &form.OPMLSubscriptionForm{})
+	view.Set("subscriptions", subscriptions)
+	view.Set("changes", changes)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	html.OK(w, r, view.Render("opml_subscriptions"))
+}
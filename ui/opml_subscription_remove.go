@@ -0,0 +1,24 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+)
+
+func (h *handler) removeOPMLSubscription(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := request.RouteInt64Param(r, "subscriptionID")
+	err := h.store.RemoveOPMLSubscription(request.UserID(r), subscriptionID)
+	if err != nil {
+		logger.Error("[UI:RemoveOPMLSubscription] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "opmlSubscriptions"))
+}
@@ -0,0 +1,40 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/reader/opml"
+)
+
+func (h *handler) syncOPMLSubscription(w http.ResponseWriter, r *http.Request) {
+	subscriptionID := request.RouteInt64Param(r, "subscriptionID")
+	subscription, err := h.store.OPMLSubscriptionByID(request.UserID(r), subscriptionID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	jobs, err := opml.NewHandler(h.store).Sync(subscription)
+	if err != nil {
+		logger.Error("[UI:SyncOPMLSubscription] %v", err)
+	}
+
+	go func() {
+		h.pool.Push(jobs)
+	}()
+
+	html.Redirect(w, r, route.Path(h.router, "opmlSubscriptions"))
+}
@@ -5,13 +5,18 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
 	"net/http"
 
 	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/importer"
 	"miniflux.app/logger"
+	"miniflux.app/model"
 	"miniflux.app/reader/opml"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -52,13 +57,8 @@ func (h *handler) uploadOPML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if impErr := opml.NewHandler(h.store).Import(user.ID, file); impErr != nil {
-		view.Set("errorMessage", impErr)
-		html.OK(w, r, view.Render("import"))
-		return
-	}
-
-	html.Redirect(w, r, route.Path(h.router, "feeds"))
+	h.importFile(view, user, file)
+	html.OK(w, r, view.Render("import"))
 }
 
 func (h *handler) fetchOPML(w http.ResponseWriter, r *http.Request) {
@@ -95,11 +95,44 @@ func (h *handler) fetchOPML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if impErr := opml.NewHandler(h.store).Import(user.ID, resp.Body); impErr != nil {
+	h.importFile(view, user, resp.Body)
+	html.OK(w, r, view.Render("import"))
+}
+
+// importFile imports the articles exported by another feed reader, or the feeds of an OPML file.
+func (h *handler) importFile(view *view.View, user *model.User, file io.Reader) {
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		view.Set("errorMessage", err)
+		return
+	}
+
+	if importer.IsSupported(data) {
+		report, err := importer.NewHandler(h.store).Import(user.ID, data)
+		if err != nil {
+			logger.Error("[UI:Import] %v", err)
+			view.Set("errorMessage", err)
+		}
+
+		if report != nil {
+			go func() {
+				h.pool.Push(report.Jobs())
+			}()
+		}
+
+		view.Set("entriesReport", report)
+		return
+	}
+
+	report, impErr := opml.NewHandler(h.store).Import(user.ID, bytes.NewReader(data))
+	if impErr != nil {
 		view.Set("errorMessage", impErr)
-		html.OK(w, r, view.Render("import"))
 		return
 	}
 
-	html.Redirect(w, r, route.Path(h.router, "feeds"))
+	go func() {
+		h.pool.Push(report.Jobs())
+	}()
+
+	view.Set("report", report)
 }
@@ -31,3 +31,71 @@ func decodeEntryStatusPayload(r io.ReadCloser) (entryIDs []int64, status string,
 
 	return p.EntryIDs, p.Status, nil
 }
+
+func decodeEnclosureProgressionPayload(r io.ReadCloser) (mediaProgression *int64, played *bool, err error) {
+	type payload struct {
+		MediaProgression *int64 `json:"media_progression"`
+		Played           *bool  `json:"played"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err = decoder.Decode(&p); err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	if p.MediaProgression != nil {
+		if err := model.ValidateMediaProgression(*p.MediaProgression); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return p.MediaProgression, p.Played, nil
+}
+
+func decodeEntryNotePayload(r io.ReadCloser) (content string, err error) {
+	type payload struct {
+		Content string `json:"content"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err = decoder.Decode(&p); err != nil {
+		return "", fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p.Content, nil
+}
+
+func decodeEntryHighlightPayload(r io.ReadCloser) (text string, err error) {
+	type payload struct {
+		Text string `json:"text"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err = decoder.Decode(&p); err != nil {
+		return "", fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p.Text, nil
+}
+
+func decodeEntrySnoozePayload(r io.ReadCloser) (entryIDs []int64, until string, err error) {
+	type payload struct {
+		EntryIDs []int64 `json:"entry_ids"`
+		Until    string  `json:"until"`
+	}
+
+	var p payload
+	decoder := json.NewDecoder(r)
+	defer r.Close()
+	if err = decoder.Decode(&p); err != nil {
+		return nil, "", fmt.Errorf("invalid JSON payload: %v", err)
+	}
+
+	return p.EntryIDs, p.Until, nil
+}
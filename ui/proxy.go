@@ -10,13 +10,12 @@ import (
 	"net/http"
 	"time"
 
-	"miniflux.app/config"
 	"miniflux.app/crypto"
-	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response"
 	"miniflux.app/http/response/html"
 	"miniflux.app/logger"
+	"miniflux.app/proxy"
 )
 
 func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
@@ -39,37 +38,27 @@ func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
 	}
 
 	imageURL := string(decodedURL)
-	logger.Debug(`[Proxy] Fetching %q`, imageURL)
-
-	req, err := http.NewRequest("GET", imageURL, nil)
-	if err != nil {
-		html.ServerError(w, r, err)
+	if !proxy.Verify(imageURL, request.RouteStringParam(r, "encodedDigest")) {
+		html.Forbidden(w, r)
 		return
 	}
-	req.Header.Add("User-Agent", client.DefaultUserAgent)
-	req.Header.Add("Connection", "close")
 
-	clt := &http.Client{
-		Timeout: time.Duration(config.Opts.HTTPClientTimeout()) * time.Second,
-	}
+	logger.Debug(`[Proxy] Fetching %q`, imageURL)
 
-	resp, err := clt.Do(req)
+	download, err := proxy.Open(imageURL)
 	if err != nil {
-		html.ServerError(w, r, err)
-		return
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
+		logger.Error("[Proxy] %v", err)
 		html.NotFound(w, r)
 		return
 	}
+	defer download.Close()
 
 	etag := crypto.HashFromBytes(decodedURL)
 
 	response.New(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
-		b.WithHeader("Content-Type", resp.Header.Get("Content-Type"))
-		b.WithBody(resp.Body)
+		b.WithHeader("Content-Type", download.ContentType)
+		b.WithHeader("Content-Security-Policy", "default-src 'none'; style-src 'unsafe-inline'; sandbox")
+		b.WithBody(download)
 		b.WithoutCompression()
 		b.Write()
 	})
@@ -0,0 +1,104 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) createPublishedFeed(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	publishedFeeds, err := h.store.PublishedFeeds(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	publishedFeeds.UseTimezone(user.Timezone)
+	publishedFeedForm := form.NewPublishedFeedForm(r)
+
+	view.Set("form", publishedFeedForm)
+	view.Set("publishedFeeds", publishedFeeds)
+	view.Set("categories", categories)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := publishedFeedForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("published_feeds"))
+		return
+	}
+
+	publishedFeed := &model.PublishedFeed{
+		UserID: user.ID,
+		Title:  publishedFeedForm.Title,
+		Source: publishedFeedForm.Source,
+	}
+
+	if publishedFeed.Source == model.PublishedFeedSourceCategory {
+		category, err := h.store.Category(user.ID, publishedFeedForm.CategoryID)
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		if category == nil {
+			view.Set("errorMessage", "error.invalid_published_feed_source")
+			html.OK(w, r, view.Render("published_feeds"))
+			return
+		}
+
+		publishedFeed.CategoryID = category.ID
+		if publishedFeed.Title == "" {
+			publishedFeed.Title = category.Title
+		}
+	}
+
+	if publishedFeed.Source == model.PublishedFeedSourceTag {
+		publishedFeed.Tag = publishedFeedForm.Tag
+		if publishedFeed.Title == "" {
+			publishedFeed.Title = publishedFeed.Tag
+		}
+	}
+
+	if publishedFeed.Title == "" {
+		publishedFeed.Title = locale.NewPrinter(user.Language).Printf("page.starred.title")
+	}
+
+	if err := h.store.CreatePublishedFeed(publishedFeed); err != nil {
+		logger.Error("[UI:CreatePublishedFeed] %v", err)
+		view.Set("errorMessage", "error.unable_to_create_published_feed")
+		html.OK(w, r, view.Render("published_feeds"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "publishedFeeds"))
+}
@@ -0,0 +1,80 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showSavedSearchEntriesPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	savedSearch, err := h.store.SavedSearchByID(user.ID, request.RouteInt64Param(r, "savedSearchID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	var entries model.Entries
+	var count int
+	offset := request.QueryIntParam(r, "offset", 0)
+
+	query, err := savedSearch.SearchQuery(user.Timezone)
+	if err != nil {
+		view.Set("errorMessage", err)
+	} else {
+		builder := h.store.NewEntryQueryBuilder(user.ID)
+		builder.WithSavedSearch(savedSearch, query)
+		builder.WithoutStatus(model.EntryStatusRemoved)
+		builder.WithOrder(model.DefaultSortingOrder)
+		builder.WithDirection(user.EntryDirection)
+		builder.WithOffset(offset)
+		builder.WithLimit(nbItemsPerPage)
+
+		entries, err = builder.GetEntries()
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		count, err = builder.CountEntries()
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	view.Set("savedSearch", savedSearch)
+	view.Set("entries", entries)
+	view.Set("total", count)
+	view.Set("pagination", getPagination(route.Path(h.router, "savedSearchEntries", "savedSearchID", savedSearch.ID), count, offset))
+	view.Set("menu", "search")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	view.Set("hasSaveEntry", h.store.HasSaveEntry(user.ID))
+	view.Set("showOnlyUnreadEntries", savedSearch.Status == model.EntryStatusUnread)
+
+	html.OK(w, r, view.Render("saved_search_entries"))
+}
@@ -0,0 +1,81 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) updateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	savedSearch, err := h.store.SavedSearchByID(user.ID, request.RouteInt64Param(r, "savedSearchID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if savedSearch == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	savedSearchForm := form.NewSavedSearchForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", savedSearchForm)
+	view.Set("savedSearch", savedSearch)
+	view.Set("categories", categories)
+	view.Set("menu", "search")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+
+	if err := savedSearchForm.Validate(); err != nil {
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("edit_saved_search"))
+		return
+	}
+
+	if savedSearchForm.CategoryID > 0 && !h.store.CategoryExists(user.ID, savedSearchForm.CategoryID) {
+		view.Set("errorMessage", "error.category_not_found")
+		html.OK(w, r, view.Render("edit_saved_search"))
+		return
+	}
+
+	if h.store.AnotherSavedSearchExists(user.ID, savedSearch.ID, savedSearchForm.Title) {
+		view.Set("errorMessage", "error.saved_search_already_exists")
+		html.OK(w, r, view.Render("edit_saved_search"))
+		return
+	}
+
+	if err := h.store.UpdateSavedSearch(savedSearchForm.Merge(savedSearch)); err != nil {
+		logger.Error("[UI:UpdateSavedSearch] %v", err)
+		view.Set("errorMessage", "error.unable_to_update_saved_search")
+		html.OK(w, r, view.Render("edit_saved_search"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "savedSearchEntries", "savedSearchID", savedSearch.ID))
+}
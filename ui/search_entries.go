@@ -11,6 +11,7 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/model"
+	"miniflux.app/search"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
@@ -24,26 +25,36 @@ func (h *handler) showSearchEntriesPage(w http.ResponseWriter, r *http.Request)
 
 	searchQuery := request.QueryStringParam(r, "q", "")
 	offset := request.QueryIntParam(r, "offset", 0)
-	builder := h.store.NewEntryQueryBuilder(user.ID)
-	builder.WithSearchQuery(searchQuery)
-	builder.WithoutStatus(model.EntryStatusRemoved)
-	builder.WithOffset(offset)
-	builder.WithLimit(nbItemsPerPage)
 
-	entries, err := builder.GetEntries()
-	if err != nil {
-		html.ServerError(w, r, err)
-		return
-	}
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	var entries model.Entries
+	var count int
 
-	count, err := builder.CountEntries()
+	query, err := search.Parse(searchQuery, user.Timezone)
 	if err != nil {
-		html.ServerError(w, r, err)
-		return
+		view.Set("errorMessage", err)
+	} else {
+		builder := h.store.NewEntryQueryBuilder(user.ID)
+		builder.WithSearchQuery(query)
+		builder.WithoutStatus(model.EntryStatusRemoved)
+		builder.WithOffset(offset)
+		builder.WithLimit(nbItemsPerPage)
+
+		entries, err = builder.GetEntries()
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		count, err = builder.CountEntries()
+		if err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
 	}
 
-	sess := session.New(h.store, request.SessionID(r))
-	view := view.New(h.tpl, r, sess)
 	pagination := getPagination(route.Path(h.router, "searchEntries"), count, offset)
 	pagination.SearchQuery = searchQuery
 
@@ -11,6 +11,7 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/locale"
 	"miniflux.app/model"
+	"miniflux.app/search"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -33,6 +34,7 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		Timezone:          user.Timezone,
 		EntryDirection:    user.EntryDirection,
 		KeyboardShortcuts: user.KeyboardShortcuts,
+		SearchLanguage:    user.SearchLanguage,
 	}
 
 	timezones, err := h.store.Timezones()
@@ -45,6 +47,7 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 	view.Set("themes", model.Themes())
 	view.Set("languages", locale.AvailableLanguages())
 	view.Set("timezones", timezones)
+	view.Set("searchLanguages", search.Configurations())
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
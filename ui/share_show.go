@@ -0,0 +1,43 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+// showSharedEntryPage renders a read-only version of a shared entry, it does not require a user session.
+func (h *handler) showSharedEntryPage(w http.ResponseWriter, r *http.Request) {
+	shareCode := request.RouteStringParam(r, "shareCode")
+	if shareCode == "" {
+		html.NotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewAnonymousQueryBuilder()
+	builder.WithShareCode(shareCode)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("entry", entry)
+
+	html.OK(w, r, view.Render("shared_entry"))
+}
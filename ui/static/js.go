@@ -71,6 +71,19 @@ element.innerHTML=element.dataset.labelLoading;let request=new RequestBuilder(el
 function handleBookmark(element){let toasting=!element;let currentEntry=findEntry(element);if(currentEntry){toggleBookmark(currentEntry,toasting);}}
 function toggleBookmark(parentElement,toasting){let element=parentElement.querySelector("a[data-toggle-bookmark]");if(!element){return;}
 element.innerHTML=element.dataset.labelLoading;let request=new RequestBuilder(element.dataset.bookmarkUrl);request.withCallback(()=>{if(element.dataset.value==="star"){element.innerHTML=element.dataset.labelStar;element.dataset.value="unstar";if(toasting){toast(element.dataset.toastUnstar);}}else{element.innerHTML=element.dataset.labelUnstar;element.dataset.value="star";if(toasting){toast(element.dataset.toastStar);}}});request.execute();}
+let selectedEntryText="";function rememberSelectedEntryText(){let content=document.querySelector(".entry-content");let selection=window.getSelection();if(content&&selection.rangeCount>0&&content.contains(selection.anchorNode)){selectedEntryText=selection.toString().trim();}}
+function handleEntryHighlight(element){if(selectedEntryText===""){toast(element.dataset.toastEmptySelection);return;}
+element.innerHTML=element.dataset.labelLoading;let request=new RequestBuilder(element.dataset.highlightUrl);request.withBody({text:selectedEntryText});request.withCallback(()=>window.location.reload());request.execute();}
+function handleSaveNote(element){let textarea=document.querySelector("textarea[data-entry-note]");if(!textarea){return;}
+element.innerHTML=element.dataset.labelLoading;let request=new RequestBuilder(element.dataset.saveNoteUrl);request.withBody({content:textarea.value});request.withCallback((response)=>{element.innerHTML=element.dataset.labelSave;if(response.ok){toast(element.dataset.toastDone);}});request.execute();}
+function handleSnoozeEntry(element){let currentEntry=findEntry(element);if(!currentEntry){return;}
+if(!element){element=currentEntry.querySelector("a[data-snooze-entry][data-value=tomorrow]");if(!element){return;}}
+let until=element.dataset.value;if(!until){let input=document.querySelector("input[data-snooze-custom]");until=input?input.value:"";if(!until){return;}}
+let request=new RequestBuilder(element.dataset.snoozeUrl);request.withBody({entry_ids:[parseInt(currentEntry.dataset.id,10)],until:until});request.withCallback((response)=>{if(!response.ok){return;}
+toast(element.dataset.toastDone);if(isListView()){if(currentEntry.classList.contains("current-item")){goToNextListItem();}
+currentEntry.remove();}});request.execute();}
+function handleUnsnoozeEntry(element){let currentEntry=findEntry(element);if(!currentEntry){return;}
+let request=new RequestBuilder(element.dataset.unsnoozeUrl);request.withBody({entry_ids:[parseInt(currentEntry.dataset.id,10)]});request.withCallback((response)=>{if(response.ok){toast(element.dataset.toastDone);element.parentNode.remove();}});request.execute();}
 function handleFetchOriginalContent(){if(isListView()){return;}
 let element=document.querySelector("a[data-fetch-content-entry]");if(!element){return;}
 if(element.dataset.completed){return;}
@@ -102,15 +115,20 @@ function isEntry(){return document.querySelector("section.entry")!==null;}
 function isListView(){return document.querySelector(".items")!==null;}
 function findEntry(element){if(isListView()){if(element){return DomHelper.findParent(element,"item");}else{return document.querySelector(".current-item");}}else{return document.querySelector(".entry");}}
 function handleConfirmationMessage(linkElement,callback){linkElement.style.display="none";let containerElement=linkElement.parentNode;let questionElement=document.createElement("span");let yesElement=document.createElement("a");yesElement.href="#";yesElement.appendChild(document.createTextNode(linkElement.dataset.labelYes));yesElement.onclick=(event)=>{event.preventDefault();let loadingElement=document.createElement("span");loadingElement.className="loading";loadingElement.appendChild(document.createTextNode(linkElement.dataset.labelLoading));questionElement.remove();containerElement.appendChild(loadingElement);callback(linkElement.dataset.url,linkElement.dataset.redirectUrl);};let noElement=document.createElement("a");noElement.href="#";noElement.appendChild(document.createTextNode(linkElement.dataset.labelNo));noElement.onclick=(event)=>{event.preventDefault();linkElement.style.display="inline";questionElement.remove();};questionElement.className="confirm";questionElement.appendChild(document.createTextNode(linkElement.dataset.labelQuestion+" "));questionElement.appendChild(yesElement);questionElement.appendChild(document.createTextNode(", "));questionElement.appendChild(noElement);containerElement.appendChild(questionElement);}
+function handleMediaPlayers(){let elements=document.querySelectorAll("audio[data-enclosure-progression-url], video[data-enclosure-progression-url]");elements.forEach((element)=>{let lastSavedPosition=parseInt(element.dataset.lastPosition,10)||0;let restorePosition=()=>{if(lastSavedPosition>0&&lastSavedPosition<element.duration){element.currentTime=lastSavedPosition;}};if(element.readyState>=1){restorePosition();}else{element.addEventListener("loadedmetadata",restorePosition,{once:true});}
+let savePosition=(force)=>{let currentPosition=Math.floor(element.currentTime);if(force||Math.abs(currentPosition-lastSavedPosition)>=10){lastSavedPosition=currentPosition;saveEnclosureProgression(element.dataset.enclosureProgressionUrl,{media_progression:currentPosition});}};element.addEventListener("timeupdate",()=>savePosition(false));element.addEventListener("pause",()=>{if(!element.ended){savePosition(true);}});element.addEventListener("ended",()=>{lastSavedPosition=0;saveEnclosureProgression(element.dataset.enclosureProgressionUrl,{media_progression:0,played:true});let enclosureElement=element.closest(".entry-enclosure");let playedElement=enclosureElement?enclosureElement.querySelector("a[data-toggle-played]"):null;if(playedElement){playedElement.innerHTML=playedElement.dataset.labelUnplayed;playedElement.dataset.value="played";}});});}
+function handleTogglePlayed(element){let played=element.dataset.value!=="played";element.innerHTML=element.dataset.labelLoading;saveEnclosureProgression(element.dataset.enclosureProgressionUrl,{played:played},()=>{element.innerHTML=played?element.dataset.labelUnplayed:element.dataset.labelPlayed;element.dataset.value=played?"played":"unplayed";});}
+function saveEnclosureProgression(url,payload,callback){let request=new RequestBuilder(url);request.withBody(payload);if(callback){request.withCallback(callback);}
+request.execute();}
 function toast(msg){if(!msg)return;document.querySelector('.toast-wrap .toast-msg').innerHTML=msg;let toastWrapper=document.querySelector('.toast-wrap');toastWrapper.classList.remove('toastAnimate');setTimeout(function(){toastWrapper.classList.add('toastAnimate');},100);}
-document.addEventListener("DOMContentLoaded",function(){handleSubmitButtons();if(!document.querySelector("body[data-disable-keyboard-shortcuts=true]")){let keyboardHandler=new KeyboardHandler();keyboardHandler.on("g u",()=>goToPage("unread"));keyboardHandler.on("g b",()=>goToPage("starred"));keyboardHandler.on("g h",()=>goToPage("history"));keyboardHandler.on("g f",()=>goToFeedOrFeeds());keyboardHandler.on("g c",()=>goToPage("categories"));keyboardHandler.on("g s",()=>goToPage("settings"));keyboardHandler.on("ArrowLeft",()=>goToPrevious());keyboardHandler.on("ArrowRight",()=>goToNext());keyboardHandler.on("k",()=>goToPrevious());keyboardHandler.on("p",()=>goToPrevious());keyboardHandler.on("j",()=>goToNext());keyboardHandler.on("n",()=>goToNext());keyboardHandler.on("h",()=>goToPage("previous"));keyboardHandler.on("l",()=>goToPage("next"));keyboardHandler.on("o",()=>openSelectedItem());keyboardHandler.on("v",()=>openOriginalLink());keyboardHandler.on("V",()=>openOriginalLink(true));keyboardHandler.on("c",()=>openCommentLink());keyboardHandler.on("C",()=>openCommentLink(true));keyboardHandler.on("m",()=>handleEntryStatus());keyboardHandler.on("A",()=>markPageAsRead());keyboardHandler.on("s",()=>handleSaveEntry());keyboardHandler.on("d",()=>handleFetchOriginalContent());keyboardHandler.on("f",()=>handleBookmark());keyboardHandler.on("?",()=>showKeyboardShortcuts());keyboardHandler.on("#",()=>unsubscribeFromFeed());keyboardHandler.on("/",(e)=>setFocusToSearchInput(e));keyboardHandler.on("Escape",()=>ModalHandler.close());keyboardHandler.listen();}
-let touchHandler=new TouchHandler();touchHandler.listen();onClick("a[data-save-entry]",(event)=>handleSaveEntry(event.target));onClick("a[data-toggle-bookmark]",(event)=>handleBookmark(event.target));onClick("a[data-fetch-content-entry]",()=>handleFetchOriginalContent());onClick("a[data-action=search]",(event)=>setFocusToSearchInput(event));onClick("a[data-action=markPageAsRead]",()=>handleConfirmationMessage(event.target,()=>markPageAsRead()));onClick("a[data-toggle-status]",(event)=>handleEntryStatus(event.target));onClick("a[data-confirm]",(event)=>handleConfirmationMessage(event.target,(url,redirectURL)=>{let request=new RequestBuilder(url);request.withCallback(()=>{if(redirectURL){window.location.href=redirectURL;}else{window.location.reload();}});request.execute();}));if(document.documentElement.clientWidth<600){onClick(".logo",()=>toggleMainMenu());onClick(".header nav li",(event)=>onClickMainMenuListItem(event));}
+document.addEventListener("DOMContentLoaded",function(){handleSubmitButtons();if(!document.querySelector("body[data-disable-keyboard-shortcuts=true]")){let keyboardHandler=new KeyboardHandler();keyboardHandler.on("g u",()=>goToPage("unread"));keyboardHandler.on("g b",()=>goToPage("starred"));keyboardHandler.on("g h",()=>goToPage("history"));keyboardHandler.on("g f",()=>goToFeedOrFeeds());keyboardHandler.on("g c",()=>goToPage("categories"));keyboardHandler.on("g s",()=>goToPage("settings"));keyboardHandler.on("ArrowLeft",()=>goToPrevious());keyboardHandler.on("ArrowRight",()=>goToNext());keyboardHandler.on("k",()=>goToPrevious());keyboardHandler.on("p",()=>goToPrevious());keyboardHandler.on("j",()=>goToNext());keyboardHandler.on("n",()=>goToNext());keyboardHandler.on("h",()=>goToPage("previous"));keyboardHandler.on("l",()=>goToPage("next"));keyboardHandler.on("o",()=>openSelectedItem());keyboardHandler.on("v",()=>openOriginalLink());keyboardHandler.on("V",()=>openOriginalLink(true));keyboardHandler.on("c",()=>openCommentLink());keyboardHandler.on("C",()=>openCommentLink(true));keyboardHandler.on("m",()=>handleEntryStatus());keyboardHandler.on("A",()=>markPageAsRead());keyboardHandler.on("s",()=>handleSaveEntry());keyboardHandler.on("z",()=>handleSnoozeEntry());keyboardHandler.on("d",()=>handleFetchOriginalContent());keyboardHandler.on("f",()=>handleBookmark());keyboardHandler.on("?",()=>showKeyboardShortcuts());keyboardHandler.on("#",()=>unsubscribeFromFeed());keyboardHandler.on("/",(e)=>setFocusToSearchInput(e));keyboardHandler.on("Escape",()=>ModalHandler.close());keyboardHandler.listen();}
+let touchHandler=new TouchHandler();touchHandler.listen();handleMediaPlayers();onClick("a[data-save-entry]",(event)=>handleSaveEntry(event.target));onClick("a[data-toggle-bookmark]",(event)=>handleBookmark(event.target));onClick("a[data-fetch-content-entry]",()=>handleFetchOriginalContent());onClick("a[data-action=search]",(event)=>setFocusToSearchInput(event));onClick("a[data-action=markPageAsRead]",()=>handleConfirmationMessage(event.target,()=>markPageAsRead()));onClick("a[data-toggle-status]",(event)=>handleEntryStatus(event.target));onClick("a[data-toggle-played]",(event)=>handleTogglePlayed(event.target));onClick("a[data-highlight-entry]",(event)=>handleEntryHighlight(event.target));onClick("a[data-save-note]",(event)=>handleSaveNote(event.target));onClick("a[data-snooze-entry]",(event)=>handleSnoozeEntry(event.target));onClick("a[data-unsnooze-entry]",(event)=>handleUnsnoozeEntry(event.target));document.addEventListener("selectionchange",()=>rememberSelectedEntryText());onClick("a[data-confirm]",(event)=>handleConfirmationMessage(event.target,(url,redirectURL)=>{let request=new RequestBuilder(url);request.withCallback(()=>{if(redirectURL){window.location.href=redirectURL;}else{window.location.reload();}});request.execute();}));if(document.documentElement.clientWidth<600){onClick(".logo",()=>toggleMainMenu());onClick(".header nav li",(event)=>onClickMainMenuListItem(event));}
 if("serviceWorker"in navigator){let scriptElement=document.getElementById("service-worker-script");if(scriptElement){navigator.serviceWorker.register(scriptElement.src);}}
 window.addEventListener('beforeinstallprompt',(e)=>{e.preventDefault();let deferredPrompt=e;const promptHomeScreen=document.getElementById('prompt-home-screen');if(promptHomeScreen){promptHomeScreen.style.display="block";const btnAddToHomeScreen=document.getElementById('btn-add-to-home-screen');if(btnAddToHomeScreen){btnAddToHomeScreen.addEventListener('click',(e)=>{e.preventDefault();deferredPrompt.prompt();deferredPrompt.userChoice.then(()=>{deferredPrompt=null;promptHomeScreen.style.display="none";});});}}});});})();`,
 	"sw": `'use strict';self.addEventListener("fetch",(event)=>{if(event.request.url.includes("/feed/icon/")){event.respondWith(caches.open("feed_icons").then((cache)=>{return cache.match(event.request).then((response)=>{return response||fetch(event.request).then((response)=>{cache.put(event.request,response.clone());return response;});});}));}});`,
 }
 
 var JavascriptsChecksums = map[string]string{
-	"app": "f26277a4397cc129a40190d5b7f008611a4cbe052e3fad71b7a4f5de473a1bf9",
+	"app": "755b5119c647d8fd8cb91e4a64a57e91fe51b4a873456d82d2641ecce16d29ca",
 	"sw":  "55fffa223919cc18572788fb9c62fccf92166c0eb5d3a1d6f91c31f24d020be9",
 }
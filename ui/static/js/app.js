@@ -251,6 +251,111 @@ function toggleBookmark(parentElement, toasting) {
     request.execute();
 }
 
+// Remember the text selected in the entry content, clicking on a link clears the selection.
+let selectedEntryText = "";
+
+function rememberSelectedEntryText() {
+    let content = document.querySelector(".entry-content");
+    let selection = window.getSelection();
+    if (content && selection.rangeCount > 0 && content.contains(selection.anchorNode)) {
+        selectedEntryText = selection.toString().trim();
+    }
+}
+
+// Send the Ajax request to highlight the selected text.
+function handleEntryHighlight(element) {
+    if (selectedEntryText === "") {
+        toast(element.dataset.toastEmptySelection);
+        return;
+    }
+
+    element.innerHTML = element.dataset.labelLoading;
+
+    let request = new RequestBuilder(element.dataset.highlightUrl);
+    request.withBody({text: selectedEntryText});
+    request.withCallback(() => window.location.reload());
+    request.execute();
+}
+
+// Send the Ajax request to save the note of an entry.
+function handleSaveNote(element) {
+    let textarea = document.querySelector("textarea[data-entry-note]");
+    if (!textarea) {
+        return;
+    }
+
+    element.innerHTML = element.dataset.labelLoading;
+
+    let request = new RequestBuilder(element.dataset.saveNoteUrl);
+    request.withBody({content: textarea.value});
+    request.withCallback((response) => {
+        element.innerHTML = element.dataset.labelSave;
+        if (response.ok) {
+            toast(element.dataset.toastDone);
+        }
+    });
+    request.execute();
+}
+
+// Hide the entry from the unread entries until the chosen time.
+function handleSnoozeEntry(element) {
+    let currentEntry = findEntry(element);
+    if (!currentEntry) {
+        return;
+    }
+
+    if (!element) {
+        element = currentEntry.querySelector("a[data-snooze-entry][data-value=tomorrow]");
+        if (!element) {
+            return;
+        }
+    }
+
+    let until = element.dataset.value;
+    if (!until) {
+        let input = document.querySelector("input[data-snooze-custom]");
+        until = input ? input.value : "";
+        if (!until) {
+            return;
+        }
+    }
+
+    let request = new RequestBuilder(element.dataset.snoozeUrl);
+    request.withBody({entry_ids: [parseInt(currentEntry.dataset.id, 10)], until: until});
+    request.withCallback((response) => {
+        if (!response.ok) {
+            return;
+        }
+
+        toast(element.dataset.toastDone);
+
+        if (isListView()) {
+            if (currentEntry.classList.contains("current-item")) {
+                goToNextListItem();
+            }
+            currentEntry.remove();
+        }
+    });
+    request.execute();
+}
+
+function handleUnsnoozeEntry(element) {
+    let currentEntry = findEntry(element);
+    if (!currentEntry) {
+        return;
+    }
+
+    let request = new RequestBuilder(element.dataset.unsnoozeUrl);
+    request.withBody({entry_ids: [parseInt(currentEntry.dataset.id, 10)]});
+    request.withCallback((response) => {
+        if (response.ok) {
+            toast(element.dataset.toastDone);
+            element.parentNode.remove();
+        }
+    });
+    request.execute();
+}
+
 // Send the Ajax request to download the original web page.
 function handleFetchOriginalContent() {
     if (isListView()) {
@@ -546,6 +651,72 @@ function handleConfirmationMessage(linkElement, callback) {
     containerElement.appendChild(questionElement);
 }
 
+// Restore the playback position of audio and video attachments and keep it in sync with the server.
+function handleMediaPlayers() {
+    let elements = document.querySelectorAll("audio[data-enclosure-progression-url], video[data-enclosure-progression-url]");
+    elements.forEach((element) => {
+        let lastSavedPosition = parseInt(element.dataset.lastPosition, 10) || 0;
+
+        let restorePosition = () => {
+            if (lastSavedPosition > 0 && lastSavedPosition < element.duration) {
+                element.currentTime = lastSavedPosition;
+            }
+        };
+
+        if (element.readyState >= 1) {
+            restorePosition();
+        } else {
+            element.addEventListener("loadedmetadata", restorePosition, {once: true});
+        }
+
+        let savePosition = (force) => {
+            let currentPosition = Math.floor(element.currentTime);
+            if (force || Math.abs(currentPosition - lastSavedPosition) >= 10) {
+                lastSavedPosition = currentPosition;
+                saveEnclosureProgression(element.dataset.enclosureProgressionUrl, {media_progression: currentPosition});
+            }
+        };
+
+        element.addEventListener("timeupdate", () => savePosition(false));
+        element.addEventListener("pause", () => {
+            if (!element.ended) {
+                savePosition(true);
+            }
+        });
+        element.addEventListener("ended", () => {
+            lastSavedPosition = 0;
+            saveEnclosureProgression(element.dataset.enclosureProgressionUrl, {media_progression: 0, played: true});
+
+            let enclosureElement = element.closest(".entry-enclosure");
+            let playedElement = enclosureElement ? enclosureElement.querySelector("a[data-toggle-played]") : null;
+            if (playedElement) {
+                playedElement.innerHTML = playedElement.dataset.labelUnplayed;
+                playedElement.dataset.value = "played";
+            }
+        });
+    });
+}
+
+// Mark an audio or video attachment as played or unplayed.
+function handleTogglePlayed(element) {
+    let played = element.dataset.value !== "played";
+    element.innerHTML = element.dataset.labelLoading;
+
+    saveEnclosureProgression(element.dataset.enclosureProgressionUrl, {played: played}, () => {
+        element.innerHTML = played ? element.dataset.labelUnplayed : element.dataset.labelPlayed;
+        element.dataset.value = played ? "played" : "unplayed";
+    });
+}
+
+function saveEnclosureProgression(url, payload, callback) {
+    let request = new RequestBuilder(url);
+    request.withBody(payload);
+    if (callback) {
+        request.withCallback(callback);
+    }
+    request.execute();
+}
+
 function toast(msg) {
     if (!msg) return;
     document.querySelector('.toast-wrap .toast-msg').innerHTML = msg;
@@ -25,6 +25,7 @@ document.addEventListener("DOMContentLoaded", function () {
         keyboardHandler.on("m", () => handleEntryStatus());
         keyboardHandler.on("A", () => markPageAsRead());
         keyboardHandler.on("s", () => handleSaveEntry());
+        keyboardHandler.on("z", () => handleSnoozeEntry());
         keyboardHandler.on("d", () => handleFetchOriginalContent());
         keyboardHandler.on("f", () => handleBookmark());
         keyboardHandler.on("?", () => showKeyboardShortcuts());
@@ -37,12 +38,20 @@ document.addEventListener("DOMContentLoaded", function () {
     let touchHandler = new TouchHandler();
     touchHandler.listen();
 
+    handleMediaPlayers();
+
     onClick("a[data-save-entry]", (event) => handleSaveEntry(event.target));
     onClick("a[data-toggle-bookmark]", (event) => handleBookmark(event.target));
     onClick("a[data-fetch-content-entry]", () => handleFetchOriginalContent());
     onClick("a[data-action=search]", (event) => setFocusToSearchInput(event));
     onClick("a[data-action=markPageAsRead]", () => handleConfirmationMessage(event.target, () => markPageAsRead()));
     onClick("a[data-toggle-status]", (event) => handleEntryStatus(event.target));
+    onClick("a[data-toggle-played]", (event) => handleTogglePlayed(event.target));
+    onClick("a[data-highlight-entry]", (event) => handleEntryHighlight(event.target));
+    onClick("a[data-save-note]", (event) => handleSaveNote(event.target));
+    onClick("a[data-snooze-entry]", (event) => handleSnoozeEntry(event.target));
+    onClick("a[data-unsnooze-entry]", (event) => handleUnsnoozeEntry(event.target));
+    document.addEventListener("selectionchange", () => rememberSelectedEntryText());
 
     onClick("a[data-confirm]", (event) => handleConfirmationMessage(event.target, (url, redirectURL) => {
         let request = new RequestBuilder(url);
@@ -0,0 +1,61 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/client"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/logger"
+	"miniflux.app/reader/feed"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) previewScrapeSubscription(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	v := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	scrapedFeedForm := form.NewScrapedFeedForm(r)
+
+	v.Set("categories", categories)
+	v.Set("form", scrapedFeedForm)
+	v.Set("menu", "feeds")
+	v.Set("user", user)
+	v.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	v.Set("countErrorFeeds", h.store.CountErrorFeeds(user.ID))
+	v.Set("defaultUserAgent", client.DefaultUserAgent)
+
+	if err := scrapedFeedForm.Validate(); err != nil {
+		v.Set("errorMessage", err.Error())
+		html.OK(w, r, v.Render("scrape_subscription"))
+		return
+	}
+
+	preview, previewErr := feed.PreviewScrapedFeed(scrapedFeedForm.URL, scrapedFeedForm.UserAgent, scrapedFeedForm.Rules())
+	if previewErr != nil {
+		logger.Error("[UI:PreviewScrapeSubscription] %s", previewErr)
+		v.Set("errorMessage", previewErr)
+	} else {
+		v.Set("preview", preview)
+	}
+
+	html.OK(w, r, v.Render("scrape_subscription"))
+}
@@ -70,6 +70,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 	case n == 0:
 		v.Set("form", subscriptionForm)
 		v.Set("errorMessage", "error.subscription_not_found")
+		v.Set("suggestScraper", true)
 		html.OK(w, r, v.Render("add_subscription"))
 	case n == 1:
 		feed, err := h.feedHandler.CreateFeed(
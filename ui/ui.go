@@ -35,6 +35,9 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/subscribe", handler.showAddSubscriptionPage).Name("addSubscription").Methods("GET")
 	uiRouter.HandleFunc("/subscribe", handler.submitSubscription).Name("submitSubscription").Methods("POST")
 	uiRouter.HandleFunc("/subscriptions", handler.showChooseSubscriptionPage).Name("chooseSubscription").Methods("POST")
+	uiRouter.HandleFunc("/subscribe/scrape", handler.showScrapeSubscriptionPage).Name("scrapeSubscription").Methods("GET")
+	uiRouter.HandleFunc("/subscribe/scrape", handler.submitScrapeSubscription).Name("submitScrapeSubscription").Methods("POST")
+	uiRouter.HandleFunc("/subscribe/scrape/preview", handler.previewScrapeSubscription).Name("previewScrapeSubscription").Methods("POST")
 	uiRouter.HandleFunc("/bookmarklet", handler.bookmarklet).Name("bookmarklet").Methods("GET")
 
 	// Unread page.
@@ -48,6 +51,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/history/flush", handler.flushHistory).Name("flushHistory").Methods("POST")
 
 	// Bookmark pages.
+	uiRouter.HandleFunc("/snoozed", handler.showSnoozedPage).Name("snoozed").Methods("GET")
 	uiRouter.HandleFunc("/starred", handler.showStarredPage).Name("starred").Methods("GET")
 	uiRouter.HandleFunc("/starred/entry/{entryID}", handler.showStarredEntryPage).Name("starredEntry").Methods("GET")
 
@@ -55,6 +59,17 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/search", handler.showSearchEntriesPage).Name("searchEntries").Methods("GET")
 	uiRouter.HandleFunc("/search/entry/{entryID}", handler.showSearchEntryPage).Name("searchEntry").Methods("GET")
 
+	// Saved searches.
+	uiRouter.HandleFunc("/saved-searches", handler.showSavedSearchesPage).Name("savedSearches").Methods("GET")
+	uiRouter.HandleFunc("/saved-searches/create", handler.showCreateSavedSearchPage).Name("createSavedSearch").Methods("GET")
+	uiRouter.HandleFunc("/saved-searches", handler.saveSavedSearch).Name("saveSavedSearch").Methods("POST")
+	uiRouter.HandleFunc("/saved-search/{savedSearchID}/edit", handler.showEditSavedSearchPage).Name("editSavedSearch").Methods("GET")
+	uiRouter.HandleFunc("/saved-search/{savedSearchID}/update", handler.updateSavedSearch).Name("updateSavedSearch").Methods("POST")
+	uiRouter.HandleFunc("/saved-search/{savedSearchID}/remove", handler.removeSavedSearch).Name("removeSavedSearch").Methods("POST")
+	uiRouter.HandleFunc("/saved-search/{savedSearchID}/entries", handler.showSavedSearchEntriesPage).Name("savedSearchEntries").Methods("GET")
+	uiRouter.HandleFunc("/saved-search/{savedSearchID}/entry/{entryID}", handler.showSavedSearchEntryPage).Name("savedSearchEntry").Methods("GET")
+	uiRouter.HandleFunc("/saved-search/{savedSearchID}/mark-all-as-read", handler.markSavedSearchAsRead).Name("markSavedSearchAsRead").Methods("POST")
+
 	// Feed listing pages.
 	uiRouter.HandleFunc("/feeds", handler.showFeedsPage).Name("feeds").Methods("GET")
 	uiRouter.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Name("refreshAllFeeds").Methods("GET")
@@ -68,6 +83,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/feed/{feedID}/entries/all", handler.showFeedEntriesAllPage).Name("feedEntriesAll").Methods("GET")
 	uiRouter.HandleFunc("/feed/{feedID}/entry/{entryID}", handler.showFeedEntryPage).Name("feedEntry").Methods("GET")
 	uiRouter.HandleFunc("/feed/icon/{iconID}", handler.showIcon).Name("icon").Methods("GET")
+	uiRouter.HandleFunc("/newsletter/attachment/{token}", handler.showNewsletterAttachment).Name("newsletterAttachment").Methods("GET")
 
 	// Category pages.
 	uiRouter.HandleFunc("/category/{categoryID}/entry/{entryID}", handler.showCategoryEntryPage).Name("categoryEntry").Methods("GET")
@@ -83,10 +99,22 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 
 	// Entry pages.
 	uiRouter.HandleFunc("/entry/status", handler.updateEntriesStatus).Name("updateEntriesStatus").Methods("POST")
+	uiRouter.HandleFunc("/entry/snooze", handler.snoozeEntries).Name("snoozeEntries").Methods("POST")
+	uiRouter.HandleFunc("/entry/unsnooze", handler.unsnoozeEntries).Name("unsnoozeEntries").Methods("POST")
 	uiRouter.HandleFunc("/entry/save/{entryID}", handler.saveEntry).Name("saveEntry").Methods("POST")
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods("POST")
-	uiRouter.HandleFunc("/proxy/{encodedURL}", handler.imageProxy).Name("proxy").Methods("GET")
+	uiRouter.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", handler.imageProxy).Name("proxy").Methods("GET")
 	uiRouter.HandleFunc("/entry/bookmark/{entryID}", handler.toggleBookmark).Name("toggleBookmark").Methods("POST")
+	uiRouter.HandleFunc("/entry/enclosure/{enclosureID}/progression", handler.saveEnclosureProgression).Name("saveEnclosureProgression").Methods("POST")
+	uiRouter.HandleFunc("/entry/share/{entryID}", handler.createSharedEntry).Name("shareEntry").Methods("POST")
+	uiRouter.HandleFunc("/entry/unshare/{entryID}", handler.unshareEntry).Name("unshareEntry").Methods("POST")
+	uiRouter.HandleFunc("/entry/note/{entryID}", handler.saveEntryNote).Name("saveEntryNote").Methods("POST")
+	uiRouter.HandleFunc("/entry/highlight/{entryID}", handler.createEntryHighlight).Name("createEntryHighlight").Methods("POST")
+	uiRouter.HandleFunc("/entry/highlight/{entryID}/{highlightID}/remove", handler.removeEntryHighlight).Name("removeEntryHighlight").Methods("POST")
+
+	// Shared entries.
+	uiRouter.HandleFunc("/share/{shareCode}", handler.showSharedEntryPage).Name("sharedEntry").Methods("GET")
+	uiRouter.HandleFunc("/shares", handler.showSharedEntriesPage).Name("sharedEntries").Methods("GET")
 
 	// User pages.
 	uiRouter.HandleFunc("/users", handler.showUsersPage).Name("users").Methods("GET")
@@ -103,6 +131,12 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/integration", handler.updateIntegration).Name("updateIntegration").Methods("POST")
 	uiRouter.HandleFunc("/integration/pocket/authorize", handler.pocketAuthorize).Name("pocketAuthorize").Methods("GET")
 	uiRouter.HandleFunc("/integration/pocket/callback", handler.pocketCallback).Name("pocketCallback").Methods("GET")
+	uiRouter.HandleFunc("/integration/delivery/{deliveryID}/retry", handler.retryIntegrationDelivery).Name("retryIntegrationDelivery").Methods("POST")
+	uiRouter.HandleFunc("/digest", handler.showDigestPage).Name("digest").Methods("GET")
+	uiRouter.HandleFunc("/digest", handler.updateDigest).Name("updateDigest").Methods("POST")
+	uiRouter.HandleFunc("/published-feeds", handler.showPublishedFeedsPage).Name("publishedFeeds").Methods("GET")
+	uiRouter.HandleFunc("/published-feeds", handler.createPublishedFeed).Name("createPublishedFeed").Methods("POST")
+	uiRouter.HandleFunc("/published-feeds/{publishedFeedID}/remove", handler.removePublishedFeed).Name("removePublishedFeed").Methods("POST")
 	uiRouter.HandleFunc("/about", handler.showAboutPage).Name("about").Methods("GET")
 
 	// Session pages.
@@ -114,6 +148,10 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool, feedHa
 	uiRouter.HandleFunc("/import", handler.showImportPage).Name("import").Methods("GET")
 	uiRouter.HandleFunc("/upload", handler.uploadOPML).Name("uploadOPML").Methods("POST")
 	uiRouter.HandleFunc("/fetch", handler.fetchOPML).Name("fetchOPML").Methods("POST")
+	uiRouter.HandleFunc("/opml-subscriptions", handler.showOPMLSubscriptionsPage).Name("opmlSubscriptions").Methods("GET")
+	uiRouter.HandleFunc("/opml-subscriptions", handler.createOPMLSubscription).Name("createOPMLSubscription").Methods("POST")
+	uiRouter.HandleFunc("/opml-subscriptions/{subscriptionID}/sync", handler.syncOPMLSubscription).Name("syncOPMLSubscription").Methods("GET")
+	uiRouter.HandleFunc("/opml-subscriptions/{subscriptionID}/remove", handler.removeOPMLSubscription).Name("removeOPMLSubscription").Methods("POST")
 
 	// OAuth2 flow.
 	uiRouter.HandleFunc("/oauth2/{provider}/unlink", handler.oauth2Unlink).Name("oauth2Unlink").Methods("GET")
@@ -0,0 +1,55 @@
+// Copyright 2019 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package worker // import "miniflux.app/worker"
+
+import (
+	"miniflux.app/integration"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// DeliveryPool handles a pool of workers sending entries to third-party services.
+type DeliveryPool struct {
+	queue chan *model.IntegrationDelivery
+}
+
+// Push send a list of deliveries to the queue.
+func (p *DeliveryPool) Push(deliveries model.IntegrationDeliveries) {
+	for _, delivery := range deliveries {
+		p.queue <- delivery
+	}
+}
+
+// NewDeliveryPool creates a pool of background workers for integration deliveries.
+func NewDeliveryPool(store *storage.Storage, nbWorkers int) *DeliveryPool {
+	deliveryPool := &DeliveryPool{
+		queue: make(chan *model.IntegrationDelivery),
+	}
+
+	for i := 0; i < nbWorkers; i++ {
+		worker := &DeliveryWorker{id: i, store: store}
+		go worker.Run(deliveryPool.queue)
+	}
+
+	return deliveryPool
+}
+
+// DeliveryWorker sends queued entries to third-party services in the background.
+type DeliveryWorker struct {
+	id    int
+	store *storage.Storage
+}
+
+// Run wait for a delivery and send the entry to the given service.
+func (w *DeliveryWorker) Run(c chan *model.IntegrationDelivery) {
+	logger.Debug("[DeliveryWorker] #%d started", w.id)
+
+	for {
+		delivery := <-c
+		logger.Debug("[DeliveryWorker #%d] got userID=%d, entryID=%d, service=%s", w.id, delivery.UserID, delivery.EntryID, delivery.Service)
+		integration.ProcessDelivery(w.store, delivery)
+	}
+}